@@ -3,6 +3,7 @@ package binder
 import (
 	"fmt"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/lowerer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
@@ -10,7 +11,8 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/packager"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
-	"os"
+	"sort"
+	"strings"
 )
 
 type Binder struct {
@@ -26,6 +28,15 @@ type Binder struct {
 
 	PreInitialTypeset []symbols.TypeSymbol
 	InClass           bool
+
+	// lambda capture tracking
+	CaptureScope    *Scope
+	EnclosingBinder *Binder
+	Captures        []symbols.VariableSymbol
+	CapturesThis    bool
+
+	// what the type parameters of a generic stand for (only set while binding generic instances)
+	TypeArguments map[string]symbols.TypeSymbol
 }
 
 // helpers for the label stacks
@@ -56,6 +67,11 @@ func (bin *Binder) PopScope() {
 	bin.ActiveScope = bin.ActiveScope.Parent
 }
 
+// lets the language server know what can be seen from inside the given span (for completions)
+func (bin *Binder) RegisterScope(span print.TextSpan) {
+	langserverinterface.RegisterScope(span, *bin.ActiveScope, bin.ClassSymbol)
+}
+
 // constructor
 func CreateBinder(parent Scope, functionSymbol symbols.FunctionSymbol) *Binder {
 	binder := Binder{
@@ -65,6 +81,7 @@ func CreateBinder(parent Scope, functionSymbol symbols.FunctionSymbol) *Binder {
 		LabelCounter:   0,
 		BreakLabels:    make([]boundnodes.BoundLabel, 0),
 		ContinueLabels: make([]boundnodes.BoundLabel, 0),
+		TypeArguments:  functionSymbol.TypeArguments,
 	}
 
 	binder.ActiveScope = &binder.MemberScope
@@ -90,7 +107,8 @@ func (bin *Binder) BindFunctionDeclaration(mem nodes.FunctionDeclarationMember,
 		pType, _ := bin.BindTypeClause(param.TypeClause)
 
 		// check if we've registered this param name before
-		for i, p := range boundParameters {
+		duplicate := false
+		for _, p := range boundParameters {
 			if p.Name == pName {
 				// I haven't done bound nodes yet so I just get the syntax node parameter using the same index
 				print.Error(
@@ -103,12 +121,17 @@ func (bin *Binder) BindFunctionDeclaration(mem nodes.FunctionDeclarationMember,
 					mem.Identifier.Value,
 				)
 
-				// skip this parameter
-				boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, builtins.Error))
-				continue
+				duplicate = true
+				break
 			}
 		}
 
+		// skip this parameter
+		if duplicate {
+			boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, builtins.Error))
+			continue
+		}
+
 		boundParameters = append(boundParameters, symbols.CreateParameterSymbol(pName, i, pType))
 	}
 
@@ -118,6 +141,7 @@ func (bin *Binder) BindFunctionDeclaration(mem nodes.FunctionDeclarationMember,
 	}
 
 	functionSymbol := symbols.CreateFunctionSymbol(mem.Identifier.Value, boundParameters, returnType, mem, mem.IsPublic)
+	functionSymbol.TypeArguments = bin.TypeArguments
 
 	// make sure reserved functions like Constructor() and Die() meet certain requirements
 	if inClass {
@@ -160,6 +184,12 @@ func (bin *Binder) BindFunctionDeclaration(mem nodes.FunctionDeclarationMember,
 		// skip this declaration
 		return
 	}
+
+	// let the language server know where this function and its parameters came from
+	langserverinterface.Declare(functionSymbol, mem.Identifier)
+	for i, param := range functionSymbol.Parameters {
+		langserverinterface.Declare(param, mem.Parameters[i].Identifier)
+	}
 }
 
 func (bin *Binder) BindExternalFunctionDeclaration(mem nodes.ExternalFunctionDeclarationMember, inClass bool) {
@@ -170,7 +200,8 @@ func (bin *Binder) BindExternalFunctionDeclaration(mem nodes.ExternalFunctionDec
 		pType, _ := bin.BindTypeClause(param.TypeClause)
 
 		// check if we've registered this param name before
-		for i, p := range boundParameters {
+		duplicate := false
+		for _, p := range boundParameters {
 			if p.Name == pName {
 				// I haven't done bound nodes yet so I just get the syntax node parameter using the same index
 				print.Error(
@@ -183,12 +214,17 @@ func (bin *Binder) BindExternalFunctionDeclaration(mem nodes.ExternalFunctionDec
 					mem.Identifier.Value,
 				)
 
-				// skip this parameter
-				boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, builtins.Error))
-				continue
+				duplicate = true
+				break
 			}
 		}
 
+		// skip this parameter
+		if duplicate {
+			boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, builtins.Error))
+			continue
+		}
+
 		boundParameters = append(boundParameters, symbols.CreateParameterSymbol(pName, i, pType))
 	}
 
@@ -214,8 +250,7 @@ func (bin *Binder) BindExternalFunctionDeclaration(mem nodes.ExternalFunctionDec
 			"BINDER",
 			print.InvalidExternalFunctionPlacementError,
 			mem.Identifier.Span,
-			"all external functions are required to be in the global scope!",
-			functionSymbol.Name,
+			"external function \"%s\" is required to be in the global scope!",
 			functionSymbol.Name,
 		)
 
@@ -249,6 +284,11 @@ func (bin *Binder) BindExternalFunctionDeclaration(mem nodes.ExternalFunctionDec
 		// skip
 		return
 	}
+
+	langserverinterface.Declare(functionSymbol, mem.Identifier)
+	for i, param := range functionSymbol.Parameters {
+		langserverinterface.Declare(param, mem.Parameters[i].Identifier)
+	}
 }
 
 func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preInitialTypeset []symbols.TypeSymbol) {
@@ -261,7 +301,23 @@ func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preIni
 	// sort all our members into functions and global statements
 	for _, member := range mem.Members {
 		if member.NodeType() == nodes.FunctionDeclaration {
-			functionDeclarations = append(functionDeclarations, member.(nodes.FunctionDeclarationMember))
+			fnc := member.(nodes.FunctionDeclarationMember)
+
+			// class functions cant have type parameters of their own
+			if len(fnc.TypeParameters) != 0 {
+				print.Error(
+					"BINDER",
+					print.IllegalGenericDeclarationError,
+					fnc.Identifier.Span,
+					"Class function \"%s\" can not be generic! Make the class generic instead.",
+					fnc.Identifier.Value,
+				)
+
+				// skip
+				continue
+			}
+
+			functionDeclarations = append(functionDeclarations, fnc)
 		} else if member.NodeType() == nodes.ClassDeclaration {
 			print.Error(
 				"BINDER",
@@ -274,13 +330,26 @@ func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preIni
 			// skip
 			continue
 
+		} else if member.NodeType() == nodes.ExternalFunctionDeclaration {
+			// external functions need to be in the global scope, this will just complain about it
+			bin.BindExternalFunctionDeclaration(member.(nodes.ExternalFunctionDeclarationMember), true)
+
 		} else {
 			globalStatements = append(globalStatements, member.(nodes.GlobalStatementMember))
 		}
 	}
 
+	// find the class we're inheriting from and the interfaces we're implementing
+	parent, interfaces := bin.BindBaseTypes(mem)
+
+	// inherited fields live in the class scope, so redeclaring them is an error
+	if parent != nil {
+		classScope.InsertVariableSymbols(parent.Fields)
+	}
+
 	binder := CreateBinder(classScope, symbols.FunctionSymbol{})
 	binder.PreInitialTypeset = preInitialTypeset
+	binder.TypeArguments = bin.TypeArguments
 
 	hasConstructor := false
 
@@ -299,6 +368,7 @@ func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preIni
 		binder.BindFunctionDeclaration(nodes.CreateFunctionDeclarationMember(
 			lexer.Token{},
 			lexer.Token{Kind: lexer.IdToken, Value: "Constructor"},
+			make([]lexer.Token, 0),
 			make([]nodes.ParameterNode, 0),
 			nodes.TypeClauseNode{},
 			nodes.CreateBlockStatementNode(lexer.Token{}, make([]nodes.StatementNode, 0), lexer.Token{}),
@@ -345,7 +415,16 @@ func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preIni
 	vars := binder.MemberScope.GetAllVariables()
 	funcs := binder.MemberScope.GetAllFunctions()
 
-	classSym := symbols.CreateClassSymbol(mem.Identifier.Value, mem, funcs, vars, symbols.PackageSymbol{})
+	if parent != nil {
+		vars, funcs = bin.InheritClassMembers(*parent, vars, funcs)
+	}
+
+	// make sure we actually implement everything we promised to
+	for _, iface := range interfaces {
+		bin.CheckInterfaceConformance(mem, iface, funcs)
+	}
+
+	classSym := symbols.CreateClassSymbol(mem.Identifier.Value, mem, funcs, vars, parent, interfaces, symbols.PackageSymbol{})
 
 	if !bin.ActiveScope.TryDeclareSymbol(classSym) {
 		print.Error(
@@ -356,6 +435,206 @@ func (bin *Binder) BindClassDeclaration(mem nodes.ClassDeclarationMember, preIni
 			classSym.Name,
 			classSym.Name,
 		)
+	} else {
+		langserverinterface.Declare(classSym, mem.Identifier)
+	}
+}
+
+func (bin *Binder) BindBaseTypes(mem nodes.ClassDeclarationMember) (*symbols.ClassSymbol, []symbols.InterfaceSymbol) {
+	var parent *symbols.ClassSymbol
+	interfaces := make([]symbols.InterfaceSymbol, 0)
+
+	// instances of generics (Box[int]) are made on the fly, nothing can inherit from them
+	for _, baseType := range mem.GenericBases {
+		print.Error(
+			"BINDER",
+			print.IllegalInheritanceError,
+			baseType.Span(),
+			"Class \"%s\" can not inherit from \"%s[...]\"! Generic classes can't be used as base classes (and nothing else takes type arguments).",
+			mem.Identifier.Value,
+			baseType.TypeIdentifier.Value,
+		)
+	}
+
+	for _, baseType := range mem.BaseTypes {
+		// is this an interface?
+		iface, ok := bin.LookupInterface(baseType.Value)
+		if ok {
+			interfaces = append(interfaces, iface)
+			langserverinterface.MapSymbol(baseType, iface)
+			continue
+		}
+
+		// if not it has to be a class
+		base, ok := bin.LookupClass(baseType.Value, false, baseType.Span)
+		if !ok {
+			continue
+		}
+
+		langserverinterface.MapSymbol(baseType, base)
+
+		// there can only be one
+		if parent != nil {
+			print.Error(
+				"BINDER",
+				print.IllegalInheritanceError,
+				baseType.Span,
+				"Class \"%s\" can not inherit from \"%s\" as it already inherits from \"%s\"! Classes can only have one base class.",
+				mem.Identifier.Value,
+				base.Name,
+				parent.Name,
+			)
+			continue
+		}
+
+		// package classes are already compiled, we cant change their vTables anymore
+		if base.Package.Exists {
+			print.Error(
+				"BINDER",
+				print.IllegalInheritanceError,
+				baseType.Span,
+				"Class \"%s\" can not inherit from \"%s\" as it comes from package \"%s\"!",
+				mem.Identifier.Value,
+				base.Name,
+				base.Package.Name,
+			)
+			continue
+		}
+
+		parent = &base
+	}
+
+	return parent, interfaces
+}
+
+func (bin *Binder) InheritClassMembers(parent symbols.ClassSymbol, ownVars []symbols.VariableSymbol, ownFuncs []symbols.FunctionSymbol) ([]symbols.VariableSymbol, []symbols.FunctionSymbol) {
+	// inherited fields come first and keep their order, that way
+	// they end up in the same spot as they are in the base class
+	vars := make([]symbols.VariableSymbol, 0)
+	vars = append(vars, parent.Fields...)
+
+	for _, own := range ownVars {
+		inherited := false
+		for _, fld := range parent.Fields {
+			if fld.Fingerprint() == own.Fingerprint() {
+				inherited = true
+				break
+			}
+		}
+
+		if !inherited {
+			vars = append(vars, own)
+		}
+	}
+
+	// functions with the same name override the base's version
+	funcs := ownFuncs
+	for _, inherited := range parent.Functions {
+		// every class gets its own constructor
+		if inherited.Name == "Constructor" {
+			continue
+		}
+
+		overridden := false
+		for _, own := range ownFuncs {
+			if own.Name != inherited.Name {
+				continue
+			}
+
+			overridden = true
+
+			// overrides need to look exactly like the function they're replacing
+			if own.Fingerprint() != inherited.Fingerprint() || own.Public != inherited.Public {
+				print.Error(
+					"BINDER",
+					print.IllegalOverrideError,
+					own.Declaration.Identifier.Span,
+					"Function \"%s\" does not match the signature of the function it overrides in class \"%s\"!",
+					own.Name,
+					parent.Name,
+				)
+			}
+		}
+
+		if !overridden {
+			funcs = append(funcs, inherited)
+		}
+	}
+
+	return vars, funcs
+}
+
+func (bin *Binder) CheckInterfaceConformance(mem nodes.ClassDeclarationMember, iface symbols.InterfaceSymbol, funcs []symbols.FunctionSymbol) {
+	for _, required := range iface.Functions {
+		found := false
+
+		for _, fnc := range funcs {
+			if fnc.Name != required.Name {
+				continue
+			}
+
+			found = true
+
+			// the implementation needs to look exactly like the interface's version
+			if fnc.Fingerprint() != required.Fingerprint() || !fnc.Public {
+				print.Error(
+					"BINDER",
+					print.InterfaceConformanceError,
+					mem.Identifier.Span,
+					"Function \"%s\" of class \"%s\" does not match the signature required by interface \"%s\"! (Interface functions need to be public)",
+					fnc.Name,
+					mem.Identifier.Value,
+					iface.Name,
+				)
+			}
+		}
+
+		if !found {
+			print.Error(
+				"BINDER",
+				print.InterfaceConformanceError,
+				mem.Identifier.Span,
+				"Class \"%s\" does not implement function \"%s\" of interface \"%s\"!",
+				mem.Identifier.Value,
+				required.Name,
+				iface.Name,
+			)
+		}
+	}
+}
+
+func (bin *Binder) BindInterfaceDeclaration(mem nodes.InterfaceDeclarationMember, preInitialTypeset []symbols.TypeSymbol) {
+	rootScope := BindRootScope()
+	interfaceScope := CreateScope(&rootScope)
+
+	binder := CreateBinder(interfaceScope, symbols.FunctionSymbol{})
+	binder.PreInitialTypeset = preInitialTypeset
+
+	// bind all function signatures
+	// (the order matters here, it decides the layout of the interface's function tables)
+	functions := make([]symbols.FunctionSymbol, 0)
+	for _, fnc := range mem.Functions {
+		binder.BindFunctionDeclaration(fnc, true)
+
+		sym := binder.MemberScope.TryLookupSymbol(fnc.Identifier.Value)
+		if sym != nil && sym.SymbolType() == symbols.Function && sym.(symbols.FunctionSymbol).Declaration.Identifier == fnc.Identifier {
+			functions = append(functions, sym.(symbols.FunctionSymbol))
+		}
+	}
+
+	ifaceSym := symbols.CreateInterfaceSymbol(mem.Identifier.Value, mem, functions)
+
+	if !bin.ActiveScope.TryDeclareSymbol(ifaceSym) {
+		print.Error(
+			"BINDER",
+			print.DuplicateFunctionError,
+			mem.Span(),
+			"A member with the name \"%s\" already exists! \"%s\" could not be defined!",
+			ifaceSym.Name,
+			ifaceSym.Name,
+		)
+	} else {
+		langserverinterface.Declare(ifaceSym, mem.Identifier)
 	}
 }
 
@@ -392,7 +671,9 @@ func (bin *Binder) BindStructDeclaration(mem nodes.StructDeclarationMember, preI
 		}
 
 		// store this field
-		fields = append(fields, symbols.CreateGlobalVariableSymbol(fld.Identifier.Value, false, fldType))
+		field := symbols.CreateGlobalVariableSymbol(fld.Identifier.Value, false, fldType)
+		fields = append(fields, field)
+		langserverinterface.Declare(field, fld.Identifier)
 	}
 
 	// Build the StructSymbol
@@ -409,6 +690,8 @@ func (bin *Binder) BindStructDeclaration(mem nodes.StructDeclarationMember, preI
 			structSym.Name,
 			structSym.Name,
 		)
+	} else {
+		langserverinterface.Declare(structSym, mem.Identifier)
 	}
 }
 
@@ -480,6 +763,8 @@ func (bin *Binder) BindEnumDeclaration(mem nodes.EnumDeclarationMember) {
 			enumSym.Name,
 			enumSym.Name,
 		)
+	} else {
+		langserverinterface.Declare(enumSym, mem.Identifier)
 	}
 }
 
@@ -498,6 +783,8 @@ func (bin *Binder) BindPackageReference(mem nodes.PackageReferenceMember) {
 			pack.Name,
 			pack.Name,
 		)
+	} else {
+		langserverinterface.Declare(pack, mem.Package)
 	}
 }
 
@@ -542,6 +829,9 @@ func (bin *Binder) BindPackageAlias(mem nodes.PackageAliasMember) {
 			"a member with the name \"%s\" has already been loaded! Alias could not be created!",
 			packageSym.Name,
 		)
+	} else {
+		langserverinterface.MapSymbol(mem.Package, original)
+		langserverinterface.Declare(packageSym, mem.Alias)
 	}
 }
 
@@ -559,11 +849,16 @@ func (bin *Binder) BindPackageUse(mem nodes.PackageUseMember) {
 		return
 	}
 
+	langserverinterface.MapSymbol(mem.Package, symbol)
 	PackageUseList = append(PackageUseList, symbol.(symbols.PackageSymbol))
 }
 
 // </MEMBERS> ----------------------------------------------------------------
 // <STATEMENTS> ---------------------------------------------------------------
+
+// lets any expression be used as a statement in the main body (not in functions though)
+var AllowExpressionStatements = false
+
 func (bin *Binder) BindStatement(stmt nodes.StatementNode) boundnodes.BoundStatementNode {
 	result := bin.BindStatementInternal(stmt)
 
@@ -580,6 +875,11 @@ func (bin *Binder) BindStatement(stmt nodes.StatementNode) boundnodes.BoundState
 			exprStmt.Expression.NodeType() == boundnodes.BoundArrayAssignmentExpression ||
 			exprStmt.Expression.NodeType() == boundnodes.BoundClassFieldAssignmentExpression
 
+		// the repl wants to show what anything at the top comes out as
+		if AllowExpressionStatements && !bin.FunctionSymbol.Exists {
+			allowed = true
+		}
+
 		if !allowed {
 			//print.PrintC(print.Red, "Only call and assignment expressions are allowed to be used as statements!")
 			print.Error(
@@ -612,12 +912,20 @@ func (bin *Binder) BindStatementInternal(stmt nodes.StatementNode) boundnodes.Bo
 		return bin.BindWhileStatement(stmt.(nodes.WhileStatementNode))
 	case nodes.FromToStatement:
 		return bin.BindFromToStatement(stmt.(nodes.FromToStatementNode))
+	case nodes.ForEachStatement:
+		return bin.BindForEachStatement(stmt.(nodes.ForEachStatementNode))
 	case nodes.BreakStatement:
 		return bin.BindBreakStatement(stmt.(nodes.BreakStatementNode))
 	case nodes.ContinueStatement:
 		return bin.BindContinueStatement(stmt.(nodes.ContinueStatementNode))
 	case nodes.ExpressionStatement:
 		return bin.BindExpressionStatement(stmt.(nodes.ExpressionStatementNode))
+	case nodes.TryStatement:
+		return bin.BindTryStatement(stmt.(nodes.TryStatementNode))
+	case nodes.ThrowStatement:
+		return bin.BindThrowStatement(stmt.(nodes.ThrowStatementNode))
+	case nodes.SwitchStatement:
+		return bin.BindSwitchStatement(stmt.(nodes.SwitchStatementNode))
 	}
 
 	// print.PrintC(print.Red, "Unexpected statement node! Got: '"+string(stmt.NodeType())+"'")
@@ -626,8 +934,9 @@ func (bin *Binder) BindStatementInternal(stmt nodes.StatementNode) boundnodes.Bo
 		print.UnknownStatementError,
 		stmt.Span(),
 		"\"%s\" Statement found. This was unexpected!",
+		stmt.NodeType(),
 	)
-	os.Exit(-1) // we crashin
+	print.Crash(-1) // we crashin
 	return nil
 }
 
@@ -734,11 +1043,18 @@ func (bin *Binder) BindReturnStatement(stmt nodes.ReturnStatementNode) boundnode
 		return boundnodes.CreateBoundExpressionStatementNode(boundnodes.CreateBoundErrorExpressionNode(stmt), stmt)
 	}
 
+	// whatever we return has to be what the function says it returns
+	// (this is also where subclasses get turned into their base class)
+	if expression != nil {
+		expression = bin.BindConversion(expression, bin.FunctionSymbol.Type, false, stmt.Expression.Span())
+	}
+
 	return boundnodes.CreateBoundReturnStatementNode(expression, stmt)
 }
 
 func (bin *Binder) BindForStatement(stmt nodes.ForStatementNode) boundnodes.BoundStatementNode {
 	bin.PushScope(CreateScope(bin.ActiveScope))
+	bin.RegisterScope(stmt.Span())
 
 	variable := bin.BindVariableDeclaration(stmt.Initaliser)
 
@@ -774,6 +1090,7 @@ func (bin *Binder) BindLoopBody(stmt nodes.StatementNode) (boundnodes.BoundState
 
 func (bin *Binder) BindWhileStatement(stmt nodes.WhileStatementNode) boundnodes.BoundWhileStatementNode {
 	bin.PushScope(CreateScope(bin.ActiveScope))
+	bin.RegisterScope(stmt.Span())
 
 	condition := bin.BindExpression(stmt.Condition)
 	convertedCondition := bin.BindConversion(condition, builtins.Bool, false, stmt.Condition.Span())
@@ -786,6 +1103,7 @@ func (bin *Binder) BindWhileStatement(stmt nodes.WhileStatementNode) boundnodes.
 
 func (bin *Binder) BindFromToStatement(stmt nodes.FromToStatementNode) boundnodes.BoundStatementNode {
 	bin.PushScope(CreateScope(bin.ActiveScope))
+	bin.RegisterScope(stmt.Span())
 
 	variable := bin.BindVariableCreation(stmt.Identifier, true, false, builtins.Int)
 	lowerBound := bin.BindExpression(stmt.LowerBound)
@@ -817,6 +1135,226 @@ func (bin *Binder) BindFromToStatement(stmt nodes.FromToStatementNode) boundnode
 	return boundnodes.CreateBoundFromToStatementNode(variable, lowerBound, upperBound, body, breakLabel, continueLabel, stmt)
 }
 
+func (bin *Binder) BindForEachStatement(stmt nodes.ForEachStatementNode) boundnodes.BoundStatementNode {
+	collection := bin.BindExpression(stmt.Collection)
+
+	// figure out what type our elements are going to be
+	var elementType symbols.TypeSymbol
+
+	switch collection.Type().Name {
+	case builtins.Array.Name:
+		elementType = collection.Type().SubTypes[0]
+
+	case builtins.String.Name:
+		// strings are gone through byte by byte
+		elementType = builtins.Byte
+
+	case builtins.Map.Name:
+		// maps are gone through key by key
+		elementType = collection.Type().SubTypes[0]
+
+	case builtins.Error.Name:
+		// already reported, but the body still gets looked at
+		elementType = builtins.Error
+
+	default:
+		print.Error(
+			"BINDER",
+			print.UnexpectedNonArrayValueError,
+			stmt.Collection.Span(),
+			"ForEach statement can only loop over arrays, strings and maps but got \"%s\"!",
+			collection.Type().Name,
+		)
+		return boundnodes.CreateBoundExpressionStatementNode(boundnodes.CreateBoundErrorExpressionNode(stmt), stmt)
+	}
+
+	bin.PushScope(CreateScope(bin.ActiveScope))
+	bin.RegisterScope(stmt.Span())
+
+	variable := bin.BindVariableCreation(stmt.Identifier, true, false, elementType)
+	body, breakLabel, continueLabel := bin.BindLoopBody(stmt.Statement)
+
+	bin.PopScope()
+	return boundnodes.CreateBoundForEachStatementNode(variable, collection, body, breakLabel, continueLabel, stmt)
+}
+
+func (bin *Binder) BindSwitchStatement(stmt nodes.SwitchStatementNode) boundnodes.BoundStatementNode {
+	expression := bin.BindExpression(stmt.Expression)
+
+	// the value only gets evaluated once, all cases compare against this variable
+	variable := symbols.CreateLocalVariableSymbol(symbols.GetTempName(), true, expression.Type())
+
+	conditions := make([]boundnodes.BoundExpressionNode, 0)
+	bodies := make([]boundnodes.BoundStatementNode, 0)
+	var defaultBody boundnodes.BoundStatementNode = nil
+
+	// enum fields we've already got a case for
+	covered := make(map[int]bool)
+
+	// same thing for every constant value (enum fields included), nothing should have two cases
+	seen := make(map[string]bool)
+
+	for _, clause := range stmt.Cases {
+		if clause.IsDefault() {
+			if defaultBody != nil {
+				print.Error(
+					"BINDER",
+					print.IllegalCaseClauseError,
+					clause.Keyword.Span,
+					"A switch statement can only have one default case!",
+				)
+				continue
+			}
+
+			defaultBody = bin.BindStatement(clause.Statement)
+			continue
+		}
+
+		condition := bin.BindCaseCondition(variable, clause, covered, seen)
+		body := bin.BindStatement(clause.Statement)
+
+		if condition == nil {
+			continue
+		}
+
+		conditions = append(conditions, condition)
+		bodies = append(bodies, body)
+	}
+
+	// if we're switching on an enum, every field should have a case (or there should be a default)
+	missing := MissingEnumFields(expression.Type(), covered)
+	if defaultBody == nil && len(missing) != 0 {
+		print.Warning(
+			"BINDER",
+			print.NonExhaustiveSwitchWarning,
+			stmt.Keyword.Span.SpanBetween(stmt.Expression.Span()),
+			"Switch over enum \"%s\" does not handle %s and has no default case!",
+			expression.Type().Name,
+			strings.Join(missing, ", "),
+		)
+	}
+
+	return boundnodes.CreateBoundSwitchStatementNode(variable, expression, conditions, bodies, defaultBody, stmt)
+}
+
+// binds all values of a case and turns them into one condition (variable = a || variable = b || ...)
+// returns nil if none of the values could be bound
+func (bin *Binder) BindCaseCondition(variable symbols.LocalVariableSymbol, clause nodes.CaseClauseNode, covered map[int]bool, seen map[string]bool) boundnodes.BoundExpressionNode {
+	var condition boundnodes.BoundExpressionNode = nil
+
+	for _, value := range clause.Values {
+		boundValue := bin.BindExpression(value)
+		convertedValue := bin.BindConversion(boundValue, variable.VarType(), false, value.Span())
+
+		if convertedValue.NodeType() == boundnodes.BoundErrorExpression {
+			continue
+		}
+
+		// if an earlier case already has this value, this one can never be reached
+		if key, name, ok := CaseValueKey(convertedValue); ok {
+			if seen[key] {
+				print.Error(
+					"BINDER",
+					print.IllegalCaseClauseError,
+					value.Span(),
+					"Case %s is already handled further up, so this one can never be reached!",
+					name,
+				)
+				continue
+			}
+
+			seen[key] = true
+		}
+
+		// keep track of which enum fields are being handled
+		if convertedValue.NodeType() == boundnodes.BoundEnumExpression {
+			covered[convertedValue.(boundnodes.BoundEnumExpressionNode).Value] = true
+		}
+
+		variableExpression := boundnodes.CreateBoundVariableExpressionNode(variable, false, value)
+		comparison := bin.BindBinaryExpressionInternal(value, variableExpression, convertedValue, lexer.EqualsToken)
+
+		if comparison.NodeType() == boundnodes.BoundErrorExpression {
+			continue
+		}
+
+		if condition == nil {
+			condition = comparison
+		} else {
+			condition = boundnodes.CreateBoundBinaryExpressionNode(
+				condition,
+				boundnodes.BindBinaryOperator(lexer.PipesToken, builtins.Bool, builtins.Bool),
+				comparison,
+				value,
+			)
+		}
+	}
+
+	return condition
+}
+
+// gives back what a constant case value is (to find duplicates) and how to call it in an error message
+// anything that isn't a constant can't be checked, so that's not ok
+func CaseValueKey(value boundnodes.BoundExpressionNode) (string, string, bool) {
+	switch value.NodeType() {
+	case boundnodes.BoundEnumExpression:
+		enm := value.(boundnodes.BoundEnumExpressionNode)
+		for name, fieldValue := range enm.Enum.Fields {
+			if fieldValue == enm.Value {
+				return fmt.Sprint(enm.Value), enm.Enum.Name + "->" + name, true
+			}
+		}
+
+		return fmt.Sprint(enm.Value), fmt.Sprint(enm.Value), true
+
+	case boundnodes.BoundLiteralExpression:
+		literal := value.(boundnodes.BoundLiteralExpressionNode)
+		if text, ok := literal.Value.(string); ok {
+			return "\"" + text, "\"" + text + "\"", true
+		}
+
+		return fmt.Sprint(literal.Value), fmt.Sprint(literal.Value), true
+
+	case boundnodes.BoundConversionExpression:
+		// every value gets converted the same way, so what it was before is just as good
+		return CaseValueKey(value.(boundnodes.BoundConversionExpressionNode).Expression)
+	}
+
+	return "", "", false
+}
+
+// finds all fields of an enum type which aren't covered yet (in the order they were declared in)
+// non-enum types never have anything missing
+func MissingEnumFields(typ symbols.TypeSymbol, covered map[int]bool) []string {
+	missing := make([]string, 0)
+
+	if !typ.IsEnum {
+		return missing
+	}
+
+	enm, ok := typ.SourceSymbol.(symbols.EnumSymbol)
+	if !ok {
+		return missing
+	}
+
+	for name, value := range enm.Fields {
+		if !covered[value] {
+			missing = append(missing, name)
+		}
+	}
+
+	sort.Slice(missing, func(i, j int) bool {
+		return enm.Fields[missing[i]] < enm.Fields[missing[j]]
+	})
+
+	// quote them for the error message
+	for i, name := range missing {
+		missing[i] = "\"" + name + "\""
+	}
+
+	return missing
+}
+
 func (bin *Binder) BindBreakStatement(stmt nodes.BreakStatementNode) boundnodes.BoundStatementNode {
 	// if we're not in any loop
 	if len(bin.BreakLabels) == 0 {
@@ -853,6 +1391,57 @@ func (bin *Binder) BindContinueStatement(stmt nodes.ContinueStatementNode) bound
 	return boundnodes.CreateBoundGotoStatementNode(continueLabel, stmt)
 }
 
+func (bin *Binder) BindTryStatement(stmt nodes.TryStatementNode) boundnodes.BoundStatementNode {
+	// a try without catch or finally doesn't do anything
+	if !stmt.CatchClause.ClauseIsSet && !stmt.FinallyClause.ClauseIsSet {
+		print.Error(
+			"BINDER",
+			print.IllegalTryStatementError,
+			stmt.Span(),
+			"\"%s\" statement needs either a catch or a finally clause (or both)!",
+			stmt.Keyword.Value,
+		)
+		return boundnodes.CreateBoundExpressionStatementNode(boundnodes.CreateBoundErrorExpressionNode(stmt), stmt)
+	}
+
+	body := bin.BindStatement(stmt.TryStatement)
+
+	var catchVariable symbols.VariableSymbol = nil
+	var catchBody boundnodes.BoundStatementNode = nil
+	var finallyBody boundnodes.BoundStatementNode = nil
+
+	if stmt.CatchClause.ClauseIsSet {
+		bin.PushScope(CreateScope(bin.ActiveScope))
+		bin.RegisterScope(stmt.CatchClause.CatchStatement.Span())
+
+		// if the exception has been given a name, declare it
+		if stmt.CatchClause.Identifier.Kind == lexer.IdToken {
+			catchVariable = bin.BindVariableCreation(stmt.CatchClause.Identifier, true, false, builtins.Exception)
+		}
+
+		catchBody = bin.BindStatement(stmt.CatchClause.CatchStatement)
+
+		bin.PopScope()
+	}
+
+	if stmt.FinallyClause.ClauseIsSet {
+		finallyBody = bin.BindStatement(stmt.FinallyClause.FinallyStatement)
+	}
+
+	return boundnodes.CreateBoundTryStatementNode(body, catchVariable, catchBody, finallyBody, stmt)
+}
+
+func (bin *Binder) BindThrowStatement(stmt nodes.ThrowStatementNode) boundnodes.BoundStatementNode {
+	expression := bin.BindExpression(stmt.Expression)
+
+	// exceptions can be rethrown as they are, everything else has to be a message
+	if expression.Type().Fingerprint() != builtins.Exception.Fingerprint() {
+		expression = bin.BindConversion(expression, builtins.String, false, stmt.Expression.Span())
+	}
+
+	return boundnodes.CreateBoundThrowStatementNode(expression, stmt)
+}
+
 func (bin *Binder) BindExpressionStatement(stmt nodes.ExpressionStatementNode) boundnodes.BoundExpressionStatementNode {
 	expression := bin.BindExpression(stmt.Expression)
 	return boundnodes.CreateBoundExpressionStatementNode(expression, stmt)
@@ -907,6 +1496,13 @@ func (bin *Binder) BindExpression(expr nodes.ExpressionNode) boundnodes.BoundExp
 		return bin.BindLambdaExpression(expr.(nodes.LambdaExpressionNode))
 	case nodes.ThisExpression:
 		return bin.BindThisExpression(expr.(nodes.ThisExpressionNode))
+	case nodes.MatchExpression:
+		return bin.BindMatchExpression(expr.(nodes.MatchExpressionNode))
+	case nodes.InterpolatedStringExpression:
+		return bin.BindInterpolatedStringExpression(expr.(nodes.InterpolatedStringExpressionNode))
+	case nodes.ErrorExpression:
+		// the parser already complained about this one
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
 
 	default:
 		//print.PrintC(print.Red, "Not implemented!")
@@ -917,7 +1513,7 @@ func (bin *Binder) BindExpression(expr nodes.ExpressionNode) boundnodes.BoundExp
 			"\"%s\" is not implemented yet! (cringe)",
 			expr.NodeType(),
 		)
-		os.Exit(-1) // we crashin
+		print.Crash(-1) // we crashin
 		return nil
 	}
 }
@@ -931,6 +1527,11 @@ func (bin *Binder) BindParenthesisedExpression(expr nodes.ParenthesisedExpressio
 }
 
 func (bin *Binder) BindNameExpression(expr nodes.NameExpressionNode) boundnodes.BoundExpressionNode {
+	// the parser already complained about this one
+	if expr.Identifier.Missing {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	searchingScope := *bin.ActiveScope
 	if expr.InMain {
 		searchingScope = MainScope
@@ -1024,8 +1625,13 @@ func (bin *Binder) BindArrayAccessExpression(expr nodes.ArrayAccessExpressionNod
 	// bind the value
 	baseExpression := bin.BindExpression(expr.Base)
 
+	// whatever went wrong with the base has already been reported
+	if baseExpression.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	// check if the variable is an array
-	if baseExpression.Type().Name != "array" && baseExpression.Type().Name != "pointer" {
+	if baseExpression.Type().Name != "array" && baseExpression.Type().Name != "pointer" && baseExpression.Type().Name != "map" {
 		print.Error(
 			"BINDER",
 			print.UnexpectedNonArrayValueError,
@@ -1038,6 +1644,11 @@ func (bin *Binder) BindArrayAccessExpression(expr nodes.ArrayAccessExpressionNod
 	// bind the index expression
 	index := bin.BindExpression(expr.Index)
 
+	// maps are indexed by their key type
+	if baseExpression.Type().Name == "map" {
+		index = bin.BindConversion(index, baseExpression.Type().SubTypes[0], false, expr.Index.Span())
+	}
+
 	// we pointin'?
 	isPointer := baseExpression.Type().Name == "pointer"
 
@@ -1049,8 +1660,13 @@ func (bin *Binder) BindArrayAssignmentExpression(expr nodes.ArrayAssignmentExpre
 	// bind the value
 	baseExpression := bin.BindExpression(expr.Base)
 
+	// whatever went wrong with the base has already been reported
+	if baseExpression.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	// check if the variable is an array
-	if baseExpression.Type().Name != "array" && baseExpression.Type().Name != "pointer" {
+	if baseExpression.Type().Name != "array" && baseExpression.Type().Name != "pointer" && baseExpression.Type().Name != "map" {
 		print.Error(
 			"BINDER",
 			print.UnexpectedNonArrayValueError,
@@ -1066,18 +1682,29 @@ func (bin *Binder) BindArrayAssignmentExpression(expr nodes.ArrayAssignmentExpre
 	// bind the value
 	value := bin.BindExpression(expr.Value)
 
-	// check if the value matches the array's type
-	if value.Type().Fingerprint() != baseExpression.Type().SubTypes[0].Fingerprint() {
+	// maps convert their key and value to whatever types they hold
+	if baseExpression.Type().Name == "map" {
+		index = bin.BindConversion(index, baseExpression.Type().SubTypes[0], false, expr.Index.Span())
+		value = bin.BindConversion(value, baseExpression.Type().SubTypes[1], false, expr.Value.Span())
+
+		return boundnodes.CreateBoundArrayAssignmentExpressionNode(baseExpression, index, value, false, expr)
+	}
+
+	// check if the value matches the array's type (anything that converts implicitly is fine too, like subclasses)
+	elementType := baseExpression.Type().SubTypes[0]
+	if value.Type().Fingerprint() != builtins.Error.Fingerprint() && !ClassifyConversion(value.Type(), elementType).IsImplicit {
 		print.Error(
 			"BINDER",
 			print.ConversionError,
 			expr.Span(),
 			"Array assignment types dont match! (trying to put %s into %s-Array)",
-			value.Type().Name, baseExpression.Type().SubTypes[0].Name,
+			value.Type().Name, elementType.Name,
 		)
 		return boundnodes.CreateBoundErrorExpressionNode(expr)
 	}
 
+	value = bin.BindConversion(value, elementType, false, expr.Value.Span())
+
 	// we pointin'?
 	isPointer := baseExpression.Type().Name == "pointer"
 
@@ -1086,6 +1713,11 @@ func (bin *Binder) BindArrayAssignmentExpression(expr nodes.ArrayAssignmentExpre
 }
 
 func (bin *Binder) BindMakeExpression(expr nodes.MakeExpressionNode) boundnodes.BoundExpressionNode {
+	// maps are built in, they dont have a class to look up (make map[string, int]())
+	if expr.Package == nil && expr.BaseType.Value == builtins.Map.Name {
+		return bin.BindMakeMapExpression(expr)
+	}
+
 	// this is not allowed in a class' global scope
 	// because at the point in time its bound, constructors doesnt exist yet
 	if bin.PreInitialTypeset != nil {
@@ -1109,6 +1741,23 @@ func (bin *Binder) BindMakeExpression(expr nodes.MakeExpressionNode) boundnodes.
 		bType, _ := LookupClassInPackage(expr.BaseType.Value, pack, false, expr.Package.Span.SpanBetween(expr.BaseType.Span))
 		baseType = bType
 
+	} else if _, isGeneric := GenericClasses[expr.BaseType.Value]; isGeneric || len(expr.TypeArguments) != 0 {
+		// generic classes need to be instantiated first (make Stack[int]())
+		typ, _ := bin.LookupType(nodes.CreateTypeClauseNode(nil, expr.BaseType, expr.TypeArguments, expr.ClosingToken), false)
+
+		// if we dont know which class this is, we dont know its constructor either (the arguments can still be wrong though)
+		if typ.Fingerprint() == builtins.Error.Fingerprint() {
+			for _, arg := range expr.Arguments {
+				bin.BindExpression(arg)
+			}
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		bType, ok := bin.LookupClass(typ.Name, false, expr.BaseType.Span)
+		if ok {
+			baseType = bType
+		}
+
 	} else {
 		// resolve the type symbol
 		bType, ok := bin.LookupClass(expr.BaseType.Value, true, expr.BaseType.Span)
@@ -1192,6 +1841,27 @@ func (bin *Binder) BindMakeExpression(expr nodes.MakeExpressionNode) boundnodes.
 	return boundnodes.CreateBoundMakeExpressionNode(baseType, boundArguments, expr)
 }
 
+func (bin *Binder) BindMakeMapExpression(expr nodes.MakeExpressionNode) boundnodes.BoundExpressionNode {
+	typ, _ := bin.LookupType(nodes.CreateTypeClauseNode(nil, expr.BaseType, expr.TypeArguments, expr.ClosingToken), false)
+	if typ.Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
+	// maps always start out empty
+	if len(expr.Arguments) != 0 {
+		print.Error(
+			"BINDER",
+			print.BadNumberOfParametersError,
+			expr.Span(),
+			"Map creation takes in no arguments but got %d!",
+			len(expr.Arguments),
+		)
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
+	return boundnodes.CreateBoundMakeMapExpressionNode(typ, expr)
+}
+
 func (bin *Binder) BindMakeArrayExpression(expr nodes.MakeArrayExpressionNode) boundnodes.BoundMakeArrayExpressionNode {
 	// resolve the type symbol
 	baseType, _ := bin.BindTypeClause(expr.Type)
@@ -1262,6 +1932,11 @@ func (bin *Binder) BindMakeStructExpression(expr nodes.MakeStructExpressionNode)
 func (bin *Binder) BindTypeCallExpression(expr nodes.TypeCallExpressionNode) boundnodes.BoundExpressionNode {
 	baseExpression := bin.BindExpression(expr.Base)
 
+	// whatever went wrong with the base has already been reported
+	if baseExpression.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	// if the base type is a class, redirect to BindClassCallExpression
 	if baseExpression.Type().IsUserDefined {
 		return bin.BindClassCallExpression(expr, baseExpression)
@@ -1274,7 +1949,7 @@ func (bin *Binder) BindTypeCallExpression(expr nodes.TypeCallExpressionNode) bou
 			print.IncorrectTypeFunctionCallError,
 			expr.Span(),
 			"the use of builtin function \"%s\" on \"%s\" datatype is undefined!",
-			function.Name,
+			expr.CallIdentifier.Value,
 			baseExpression.Type().Name,
 		)
 		return boundnodes.CreateBoundErrorExpressionNode(expr)
@@ -1381,6 +2056,11 @@ func (bin *Binder) BindClassFieldAccessExpression(expr nodes.ClassFieldAccessExp
 	// --------------------------
 	baseExpression := bin.BindExpression(expr.Base)
 
+	// if something already went wrong, dont make an even bigger fuss about it
+	if baseExpression.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	// if the base type is not a class (or struct), it cant have any fields
 	if !baseExpression.Type().IsUserDefined {
 		print.Error(
@@ -1410,6 +2090,12 @@ func (bin *Binder) BindClassFieldAccessExpression(expr nodes.ClassFieldAccessExp
 func (bin *Binder) BindClassFieldAssignmentExpression(expr nodes.ClassFieldAssignmentExpressionNode) boundnodes.BoundExpressionNode {
 	baseExpression := bin.BindExpression(expr.Base)
 
+	// same here (the value can still have its own problems)
+	if baseExpression.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		bin.BindExpression(expr.Value)
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	// if the base type is a class, it cant have any fields
 	if !baseExpression.Type().IsUserDefined {
 		print.Error(
@@ -1457,11 +2143,21 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 		// check if it's a class cast
 		classSymbol, exists := bin.LookupClass(expr.Identifier.Value, true, expr.Identifier.Span)
 
-		// if it worked -> create a class conversion
+		// if it worked -> create a class conversion
+		if exists && len(expr.Arguments) == 1 {
+			// bind the expression and return a conversion
+			expression := bin.BindExpression(expr.Arguments[0])
+			return bin.BindConversion(expression, classSymbol.Type, true, expr.Span())
+		}
+
+		// check if it's an interface cast
+		interfaceSymbol, exists := bin.LookupInterface(expr.Identifier.Value)
+
+		// if it worked -> create an interface conversion
 		if exists && len(expr.Arguments) == 1 {
 			// bind the expression and return a conversion
 			expression := bin.BindExpression(expr.Arguments[0])
-			return bin.BindConversion(expression, classSymbol.Type, true, expr.Span())
+			return bin.BindConversion(expression, interfaceSymbol.Type, true, expr.Span())
 		}
 
 		// check if it's a complex cast
@@ -1486,6 +2182,12 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 		}
 	}
 
+	// calls to the base class' constructor
+	// ------------------------------------
+	if bin.InClass && !expr.InMain && expr.Identifier.Value == "base" {
+		return bin.BindBaseConstructorCall(expr)
+	}
+
 	// normal function calling
 	// -----------------------
 
@@ -1517,12 +2219,26 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 		}
 	}
 
+	// if we still didnt find anything, this might be a call to a generic function
+	if symbol == nil && !expr.InMain {
+		if decl, ok := GenericFunctions[expr.Identifier.Value]; ok {
+			funcSymbol, ok := bin.BindGenericFunctionCall(decl, expr, boundArguments)
+			if !ok {
+				return boundnodes.CreateBoundErrorExpressionNode(expr)
+			}
+
+			symbol = funcSymbol
+		}
+	}
+
 	if symbol == nil ||
 		symbol.SymbolType() != symbols.Function {
-		print.Error(
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UndefinedFunctionCallError,
 			expr.Span(),
+			expr.Identifier.Span,
+			print.SuggestNames(expr.Identifier.Value, FunctionNames(searchingScope)),
 			"Function \"%s\" does not exist!",
 			expr.Identifier.Value,
 		)
@@ -1555,7 +2271,7 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 			print.BadNumberOfParametersError,
 			expr.Span(),
 			"type function \"%s\" expects %d arguments but got %d!",
-			expr.Identifier,
+			expr.Identifier.Value,
 			len(functionSymbol.Parameters),
 			len(expr.Arguments),
 		)
@@ -1577,6 +2293,11 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 		return boundnodes.CreateBoundErrorExpressionNode(expr)
 	}
 
+	// calls to our own class' functions need the object we're in
+	if bin.InClass && !expr.InMain && !functionSymbol.BuiltIn && !InPackage.Exists {
+		bin.TryCaptureThis()
+	}
+
 	if InPackage.Exists {
 		return boundnodes.CreateBoundPackageCallExpressionNode(InPackage, functionSymbol, boundArguments, expr)
 	} else {
@@ -1585,6 +2306,60 @@ func (bin *Binder) BindCallExpression(expr nodes.CallExpressionNode) boundnodes.
 
 }
 
+func (bin *Binder) BindBaseConstructorCall(expr nodes.CallExpressionNode) boundnodes.BoundExpressionNode {
+	// base() is only a thing inside of constructors of classes which actually have a base
+	if bin.ClassSymbol.Parent == nil || bin.FunctionSymbol.Name != "Constructor" {
+		print.Error(
+			"BINDER",
+			print.IllegalConstructorCallError,
+			expr.Span(),
+			"base() can only be called inside the constructor of a class inheriting from another class!",
+		)
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
+	parent := *bin.ClassSymbol.Parent
+
+	// find the base's constructor
+	var constructor symbols.FunctionSymbol
+	for _, fnc := range parent.Functions {
+		if fnc.Name == "Constructor" {
+			constructor = fnc
+			break
+		}
+	}
+
+	boundArguments := make([]boundnodes.BoundExpressionNode, 0)
+	for _, arg := range expr.Arguments {
+		boundArguments = append(boundArguments, bin.BindExpression(arg))
+	}
+
+	if len(boundArguments) != len(constructor.Parameters) {
+		print.Error(
+			"BINDER",
+			print.BadNumberOfParametersError,
+			expr.Span(),
+			"Constructor of class \"%s\" expects %d arguments but got %d!",
+			parent.Name,
+			len(constructor.Parameters),
+			len(boundArguments),
+		)
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
+	for i, arg := range boundArguments {
+		boundArguments[i] = bin.BindConversion(arg, constructor.Parameters[i].VarType(), false, expr.Arguments[i].Span())
+	}
+
+	// call the constructor on ourselves, just viewed as our base class
+	return boundnodes.CreateBoundClassCallExpressionNode(
+		boundnodes.CreateBoundThisExpressionNode(parent, expr),
+		constructor,
+		boundArguments,
+		expr,
+	)
+}
+
 func (bin *Binder) BindPackageCallExpression(expr nodes.PackageCallExpressionNode) boundnodes.BoundExpressionNode {
 	// find out what package this is refering to
 	pack, _ := bin.LookupPackage(expr.Package.Value, false, expr.Package.Span)
@@ -1640,6 +2415,12 @@ func (bin *Binder) BindPackageCallExpression(expr nodes.PackageCallExpressionNod
 
 func (bin *Binder) BindUnaryExpression(expr nodes.UnaryExpressionNode) boundnodes.BoundExpressionNode {
 	operand := bin.BindExpression(expr.Operand)
+
+	// if the operand is already broken, there's no point in complaining about it again
+	if operand.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	op := boundnodes.BindUnaryOperator(expr.Operator.Kind, operand.Type())
 
 	if !op.Exists {
@@ -1666,6 +2447,11 @@ func (bin *Binder) BindBinaryExpression(expr nodes.BinaryExpressionNode) boundno
 }
 
 func (bin *Binder) BindBinaryExpressionInternal(expr nodes.SyntaxNode, left boundnodes.BoundExpressionNode, right boundnodes.BoundExpressionNode, opkind lexer.TokenKind) boundnodes.BoundExpressionNode {
+	// if either side is already broken, there's no point in complaining about it again
+	if left.Type().Fingerprint() == builtins.Error.Fingerprint() || right.Type().Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.CreateBoundErrorExpressionNode(expr)
+	}
+
 	op := boundnodes.BindBinaryOperator(opkind, left.Type(), right.Type())
 
 	if !op.Exists {
@@ -1734,6 +2520,149 @@ func (bin *Binder) BindTernaryExpression(expr nodes.TernaryExpressionNode) bound
 	return boundnodes.CreateBoundTernaryExpressionNode(condition, left, right, tmp, expr)
 }
 
+func (bin *Binder) BindMatchExpression(expr nodes.MatchExpressionNode) boundnodes.BoundExpressionNode {
+	expression := bin.BindExpression(expr.Expression)
+
+	// same as in switch statements, the value only gets evaluated once
+	variable := symbols.CreateLocalVariableSymbol(symbols.GetTempName(), true, expression.Type())
+
+	conditions := make([]boundnodes.BoundExpressionNode, 0)
+	values := make([]boundnodes.BoundExpressionNode, 0)
+	var defaultValue boundnodes.BoundExpressionNode = nil
+
+	// whatever the first case gives back is what every case has to give back
+	var resultType symbols.TypeSymbol
+	hasResultType := false
+
+	covered := make(map[int]bool)
+	seen := make(map[string]bool)
+
+	for _, clause := range expr.Cases {
+		value := bin.BindExpression(clause.Value)
+
+		if !hasResultType {
+			resultType = value.Type()
+			hasResultType = true
+
+			if resultType.Fingerprint() == builtins.Void.Fingerprint() {
+				print.Error(
+					"BINDER",
+					print.IllegalCaseClauseError,
+					clause.Value.Span(),
+					"Cases of a match expression need to give back a value!",
+				)
+				return boundnodes.CreateBoundErrorExpressionNode(expr)
+			}
+		}
+
+		value = bin.BindConversion(value, resultType, false, clause.Value.Span())
+		if value.NodeType() == boundnodes.BoundErrorExpression {
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		if clause.IsDefault() {
+			if defaultValue != nil {
+				print.Error(
+					"BINDER",
+					print.IllegalCaseClauseError,
+					clause.Keyword.Span,
+					"A match expression can only have one default case!",
+				)
+				return boundnodes.CreateBoundErrorExpressionNode(expr)
+			}
+
+			defaultValue = value
+			continue
+		}
+
+		condition := bin.BindCaseCondition(variable, clause, covered, seen)
+		if condition == nil {
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		conditions = append(conditions, condition)
+		values = append(values, value)
+	}
+
+	// a match always needs to give back something
+	// this means there has to be a default, unless every field of an enum is handled
+	if defaultValue == nil {
+		missing := MissingEnumFields(expression.Type(), covered)
+
+		if !expression.Type().IsEnum || len(missing) != 0 || len(values) == 0 {
+			if expression.Type().IsEnum && len(missing) != 0 {
+				print.Error(
+					"BINDER",
+					print.NonExhaustiveMatchError,
+					expr.Keyword.Span.SpanBetween(expr.Expression.Span()),
+					"Match over enum \"%s\" does not handle %s and has no default case!",
+					expression.Type().Name,
+					strings.Join(missing, ", "),
+				)
+			} else {
+				print.Error(
+					"BINDER",
+					print.NonExhaustiveMatchError,
+					expr.Keyword.Span.SpanBetween(expr.Expression.Span()),
+					"Match expression needs a default case, otherwise there's nothing to give back if no case fits!",
+				)
+			}
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		// every field is covered -> the last case doesn't need to be checked at all
+		defaultValue = values[len(values)-1]
+		conditions = conditions[:len(conditions)-1]
+		values = values[:len(values)-1]
+	}
+
+	// create a temporary variable symbol to keep track of the result
+	tmp := symbols.CreateLocalVariableSymbol(symbols.GetTempName(), false, resultType)
+
+	return boundnodes.CreateBoundMatchExpressionNode(variable, expression, conditions, values, defaultValue, tmp, expr)
+}
+
+func (bin *Binder) BindInterpolatedStringExpression(expr nodes.InterpolatedStringExpressionNode) boundnodes.BoundExpressionNode {
+	parts := make([]boundnodes.BoundExpressionNode, 0)
+
+	for _, part := range expr.Parts {
+		boundPart := bin.BindExpression(part)
+
+		// every hole gets turned into a string, no cast needed
+		// (only for things that can always be turned into one, anything that needs a check at runtime needs a real cast)
+		conversion := ClassifyConversion(boundPart.Type(), builtins.String)
+		if boundPart.Type().Fingerprint() != builtins.Error.Fingerprint() &&
+			conversion.Exists && !conversion.IsIdentity && boundPart.Type().IsObject {
+			print.Error(
+				"BINDER",
+				print.ConversionError,
+				part.Span(),
+				"Cannot automatically convert type \"%s\" to \"string\" in an interpolated string! (Use string(...) to cast it yourself)",
+				boundPart.Type().Name,
+			)
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		convertedPart := bin.BindConversion(boundPart, builtins.String, true, part.Span())
+		if convertedPart.NodeType() == boundnodes.BoundErrorExpression {
+			return boundnodes.CreateBoundErrorExpressionNode(expr)
+		}
+
+		parts = append(parts, convertedPart)
+	}
+
+	// no holes at all -> this is just a normal string
+	if len(parts) == 0 {
+		return boundnodes.CreateBoundLiteralExpressionNodeFromValue("", expr)
+	}
+
+	if len(parts) == 1 && parts[0].NodeType() == boundnodes.BoundLiteralExpression {
+		return parts[0]
+	}
+
+	return boundnodes.CreateBoundInterpolatedStringExpressionNode(parts, expr)
+}
+
 func (bin *Binder) BindReferenceExpression(expr nodes.ReferenceExpressionNode) boundnodes.BoundReferenceExpressionNode {
 	// bind the source variable
 	variable := bin.BindNameExpression(expr.Expression)
@@ -1766,7 +2695,8 @@ func (bin *Binder) BindLambdaExpression(expr nodes.LambdaExpressionNode) boundno
 		pType, _ := bin.BindTypeClause(param.TypeClause)
 
 		// check if we've registered this param name before
-		for i, p := range boundParameters {
+		duplicate := false
+		for _, p := range boundParameters {
 			if p.Name == pName {
 				// I haven't done bound nodes yet so I just get the syntax node parameter using the same index
 				print.Error(
@@ -1778,12 +2708,17 @@ func (bin *Binder) BindLambdaExpression(expr nodes.LambdaExpressionNode) boundno
 					pName,
 				)
 
-				// skip
-				boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, pType))
-				continue
+				duplicate = true
+				break
 			}
 		}
 
+		// skip this parameter
+		if duplicate {
+			boundParameters = append(boundParameters, symbols.CreateParameterSymbol(fmt.Sprintf("P_%d", i), i, pType))
+			continue
+		}
+
 		boundParameters = append(boundParameters, symbols.CreateParameterSymbol(pName, i, pType))
 	}
 
@@ -1792,15 +2727,37 @@ func (bin *Binder) BindLambdaExpression(expr nodes.LambdaExpressionNode) boundno
 		returnType = builtins.Void
 	}
 
+	for i, param := range boundParameters {
+		langserverinterface.Declare(param, expr.Parameters[i].Identifier)
+	}
+
 	// cool symbol
 	functionSymbol := symbols.CreateFunctionSymbol(symbols.GetLambdaName(), boundParameters, returnType, nodes.FunctionDeclarationMember{}, false)
+	functionSymbol.TypeArguments = bin.TypeArguments
+
+	// everything the lambda could capture from us
+	captureScope := bin.CollectCapturableVariables()
 
 	// b o i n d   f u n c t i o n
-	binder := CreateBinder(MainScope, functionSymbol)
+	binder := CreateBinder(captureScope, functionSymbol)
+	binder.CaptureScope = &captureScope
+	binder.EnclosingBinder = bin
+
+	// lambdas written inside of a class can use its fields and functions too
+	binder.InClass = bin.InClass
+	binder.ClassSymbol = bin.ClassSymbol
+
+	// lambda parameters are allowed to shadow anything they could capture
+	for _, param := range functionSymbol.Parameters {
+		binder.MemberScope.Symbols[param.Name] = param
+	}
+
+	binder.RegisterScope(expr.Body.Span())
+
 	body := binder.BindBlockStatement(expr.Body)
 	loweredBody := lowerer.Lower(functionSymbol, body)
 
-	return boundnodes.CreateBoundLambdaExpressionNode(functionSymbol, loweredBody, expr)
+	return boundnodes.CreateBoundLambdaExpressionNode(functionSymbol, loweredBody, binder.Captures, binder.CapturesThis, expr)
 }
 
 func (bin *Binder) BindThisExpression(expr nodes.ThisExpressionNode) boundnodes.BoundExpressionNode {
@@ -1815,6 +2772,7 @@ func (bin *Binder) BindThisExpression(expr nodes.ThisExpressionNode) boundnodes.
 		return boundnodes.CreateBoundErrorExpressionNode(expr)
 	}
 
+	bin.TryCaptureThis()
 	return boundnodes.CreateBoundThisExpressionNode(bin.ClassSymbol, expr)
 }
 
@@ -1844,6 +2802,7 @@ func (bin *Binder) BindVariableCreation(id lexer.Token, isReadOnly bool, isGloba
 		return symbols.CreateLocalVariableSymbol(id.Value, false, varType)
 	}
 
+	langserverinterface.Declare(variable, id)
 	return variable
 }
 
@@ -1860,10 +2819,12 @@ func (bin *Binder) BindVariableReference(name string, errorLocation print.TextSp
 			variable.SymbolType() == symbols.LocalVariable ||
 			variable.SymbolType() == symbols.Parameter) {
 		//print.PrintC(print.Red, "Could not find variable '"+name+"'!")
-		print.Error(
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UndefinedVariableReferenceError,
 			errorLocation,
+			errorLocation,
+			print.SuggestNames(name, VariableNames(searchingScope)),
 			"Could not find variable \"%s\"! Are you sure it exists?",
 			name,
 		)
@@ -1885,9 +2846,105 @@ func (bin *Binder) BindVariableReference(name string, errorLocation print.TextSp
 		return symbols.CreateLocalVariableSymbol("err", false, builtins.Error)
 	}
 
+	// if we're inside a lambda this might be a variable from the outside
+	bin.TryCaptureVariable(variable.(symbols.VariableSymbol))
+
+	// (or a field of the class it was written in)
+	if bin.InClass && !inMain && variable.SymbolType() == symbols.GlobalVariable {
+		bin.TryCaptureThis()
+	}
+
 	return variable.(symbols.VariableSymbol)
 }
 
+// CollectCapturableVariables builds a scope of all locals and parameters a lambda created right here could capture
+// (that's everything from our own function and, if we're a lambda ourselves, everything we could capture too)
+func (bin *Binder) CollectCapturableVariables() Scope {
+	// a lambda can see everything we can see from outside of our function (like the class we're in)
+	// main's own scope also holds its functions and classes, so that one is kept whole
+	outside := bin.MemberScope.Parent
+	if bin.CaptureScope != nil {
+		outside = bin.CaptureScope.Parent
+	} else if !bin.FunctionSymbol.Exists {
+		outside = &MainScope
+	}
+
+	captureScope := CreateScope(outside)
+
+	collect := func(scope *Scope) {
+		for name, sym := range scope.Symbols {
+			if sym.SymbolType() != symbols.LocalVariable && sym.SymbolType() != symbols.Parameter {
+				continue
+			}
+
+			// inner scopes come first, so dont let outer ones overwrite them
+			if _, ok := captureScope.Symbols[name]; !ok {
+				captureScope.Symbols[name] = sym
+			}
+		}
+	}
+
+	// walk up until we've left our own function
+	// (main keeps going, in the repl the lines before this one are part of it too)
+	for scope := bin.ActiveScope; scope != nil; scope = scope.Parent {
+		collect(scope)
+
+		if scope == &bin.MemberScope && bin.FunctionSymbol.Exists {
+			break
+		}
+	}
+
+	if bin.CaptureScope != nil {
+		collect(bin.CaptureScope)
+	}
+
+	return captureScope
+}
+
+// TryCaptureVariable marks a variable as captured if it comes from outside the lambda we're binding
+func (bin *Binder) TryCaptureVariable(variable symbols.VariableSymbol) {
+	// not a lambda -> nothing to capture
+	if bin.CaptureScope == nil {
+		return
+	}
+
+	// only things from our capture scope need capturing, everything else is either ours or a global
+	sym, ok := bin.CaptureScope.Symbols[variable.SymbolName()]
+	if !ok || sym.Fingerprint() != variable.Fingerprint() {
+		return
+	}
+
+	// already got it
+	for _, captured := range bin.Captures {
+		if captured.Fingerprint() == variable.Fingerprint() {
+			return
+		}
+	}
+
+	bin.Captures = append(bin.Captures, variable)
+	CapturedVariables[variable.Fingerprint()] = true
+
+	// if we're nested in another lambda it needs to pass this along to us, so it has to capture it as well
+	if bin.EnclosingBinder != nil {
+		bin.EnclosingBinder.TryCaptureVariable(variable)
+	}
+}
+
+// TryCaptureThis marks the object we're in as captured if we're a lambda inside of a class
+func (bin *Binder) TryCaptureThis() {
+	// class functions have "this" anyways
+	if bin.CaptureScope == nil || bin.CapturesThis {
+		return
+	}
+
+	bin.CapturesThis = true
+
+	// same as with variables, whoever is around us has to hand it down
+	if bin.EnclosingBinder != nil {
+		bin.EnclosingBinder.TryCaptureThis()
+	}
+}
+
 // </SYMBOLS> -----------------------------------------------------------------
 // <IDEK> ---------------------------------------------------------------------
 
@@ -1904,10 +2961,12 @@ func (bin *Binder) BindTypeClause(tc nodes.TypeClauseNode) (symbols.TypeSymbol,
 
 		// get the class
 		bType, _ := LookupClassInPackage(tc.TypeIdentifier.Value, pack, false, tc.Span())
+		langserverinterface.MapGenericType(tc, bType.Type)
 		return bType.Type, true
 	}
 
 	typ, _ := bin.LookupType(tc, false)
+	langserverinterface.MapGenericType(tc, typ)
 	return typ, true
 }
 
@@ -1917,6 +2976,9 @@ func (bin *Binder) LookupTypeFunction(name string, baseType symbols.TypeSymbol,
 		if baseType.Fingerprint() == builtins.String.Fingerprint() {
 			// string length
 			return builtins.GetLength
+		} else if baseType.Name == builtins.Map.Name {
+			// number of entries in a map
+			return builtins.MapGetLength
 		} else {
 			// array length
 			return builtins.GetArrayLength
@@ -1926,7 +2988,7 @@ func (bin *Binder) LookupTypeFunction(name string, baseType symbols.TypeSymbol,
 	case "Substring":
 		return builtins.Substring
 	case "Push":
-		if baseType.Name == builtins.Array.Name {
+		if baseType.Name == builtins.Array.Name && len(baseType.SubTypes) == 1 {
 			if baseType.SubTypes[0].IsObject {
 				// push function for object arrays
 				return builtins.Push
@@ -1937,11 +2999,37 @@ func (bin *Binder) LookupTypeFunction(name string, baseType symbols.TypeSymbol,
 		}
 	//case "Start":
 	//	return builtins.Start // handled by RunThread()
+	case "GetMessage":
+		return builtins.GetMessage
 	case "Join":
 		return builtins.Join
 	case "Kill":
 		return builtins.Kill
+	case "Has", "Remove":
+		if baseType.Name == builtins.Map.Name && len(baseType.SubTypes) == 2 {
+			sym := builtins.Has
+			if name == "Remove" {
+				sym = builtins.Remove
+			}
+
+			// these take in a key of the map's key type
+			sym.Parameters = []symbols.ParameterSymbol{symbols.CreateParameterSymbol("key", 0, baseType.SubTypes[0])}
+			return sym
+		}
+	case "Keys":
+		if baseType.Name == builtins.Map.Name && len(baseType.SubTypes) == 2 {
+			sym := builtins.Keys
+
+			// gives you an array of the map's key type
+			sym.Type = symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{baseType.SubTypes[0]}, true, false, false, symbols.PackageSymbol{}, nil)
+			return sym
+		}
 	case "Run":
+		// only actions can be run (and those always have at least their return type)
+		if baseType.Name != builtins.Action.Name || len(baseType.SubTypes) == 0 {
+			break
+		}
+
 		// oh boy
 		sym := builtins.Run
 
@@ -1957,6 +3045,10 @@ func (bin *Binder) LookupTypeFunction(name string, baseType symbols.TypeSymbol,
 		return sym
 
 	case "RunThread":
+		if baseType.Name != builtins.Action.Name || len(baseType.SubTypes) == 0 {
+			break
+		}
+
 		// recycling :)
 		sym := builtins.RunThread
 
@@ -1989,6 +3081,26 @@ func (bin *Binder) LookupClassFunction(name string, baseType symbols.TypeSymbol,
 	// try locating the class
 	clsSym := bin.ActiveScope.TryLookupSymbol(baseType.Name)
 
+	// interfaces only know their function signatures, the class behind them gets figured out at runtime
+	if clsSym != nil && clsSym.SymbolType() == symbols.Interface {
+		for _, fnc := range clsSym.(symbols.InterfaceSymbol).Functions {
+			if fnc.Name == name {
+				return fnc
+			}
+		}
+
+		print.Error(
+			"BINDER",
+			print.TypeFunctionDoesNotExistError,
+			errorLocation,
+			"Could not find function \"%s\" in interface \"%s\", does the function exist?",
+			name,
+			baseType.Name,
+		)
+
+		return symbols.FunctionSymbol{}
+	}
+
 	// if that failed -> look through packages
 	if clsSym == nil || clsSym.SymbolType() != symbols.Class {
 		for _, pck := range bin.ActiveScope.GetAllPackages() {
@@ -2035,10 +3147,12 @@ func (bin *Binder) LookupClassFunction(name string, baseType symbols.TypeSymbol,
 		}
 	}
 
-	print.Error(
+	print.ErrorWithSuggestions(
 		"BINDER",
 		print.TypeFunctionDoesNotExistError,
 		errorLocation,
+		TrailingSpan(errorLocation, name),
+		print.SuggestNames(name, PublicFunctionNames(cls.Functions)),
 		"Could not find function \"%s\" in class \"%s\", does the function exist?",
 		name,
 		baseType.Name,
@@ -2086,10 +3200,12 @@ func (bin *Binder) LookupClassField(name string, baseType symbols.TypeSymbol, er
 		}
 	}
 
-	print.Error(
+	print.ErrorWithSuggestions(
 		"BINDER",
 		print.UnknownFieldError,
 		errorLocation,
+		TrailingSpan(errorLocation, name),
+		print.SuggestNames(name, FieldNames(cls.Fields)),
 		"Could not find field \"%s\" in class \"%s\", does the field exist?",
 		name,
 		baseType.Name,
@@ -2127,10 +3243,12 @@ func (bin *Binder) LookupStructField(name string, baseType symbols.TypeSymbol, e
 		}
 	}
 
-	print.Error(
+	print.ErrorWithSuggestions(
 		"BINDER",
 		print.UnknownFieldError,
 		errorLocation,
+		TrailingSpan(errorLocation, name),
+		print.SuggestNames(name, FieldNames(stc.Fields)),
 		"Could not find field \"%s\" in struct \"%s\", does the field exist?",
 		name,
 		baseType.Name,
@@ -2144,6 +3262,11 @@ func (bin *Binder) LookupStructField(name string, baseType symbols.TypeSymbol, e
 // <TYPES> --------------------------------------------------------------------
 
 func (bin *Binder) BindConversion(expr boundnodes.BoundExpressionNode, to symbols.TypeSymbol, allowExplicit bool, errorLocation print.TextSpan) boundnodes.BoundExpressionNode {
+	// if something already went wrong, dont make an even bigger fuss about it
+	if expr.Type().Fingerprint() == builtins.Error.Fingerprint() || to.Fingerprint() == builtins.Error.Fingerprint() {
+		return boundnodes.BoundErrorExpressionNode{}
+	}
+
 	conversionType := ClassifyConversion(expr.Type(), to)
 
 	nameFrom := expr.Type().Name
@@ -2189,10 +3312,15 @@ func (bin *Binder) BindConversion(expr boundnodes.BoundExpressionNode, to symbol
 }
 
 func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (symbols.TypeSymbol, bool) {
+	// the parser made this one up because it was missing (and already complained about it)
+	if typeClause.TypeIdentifier.Missing {
+		return builtins.Error, true
+	}
+
 	// this do be a package type ig
 	if typeClause.Package != nil {
 		// find the package
-		pck, ok := bin.LookupPackage(typeClause.Package.Value, canFail, typeClause.Span())
+		pck, ok := bin.LookupPackage(typeClause.Package.Value, canFail, typeClause.Package.Span)
 		if ok {
 			// find the class
 			cls, ok := LookupClassInPackage(typeClause.TypeIdentifier.Value, pck, canFail, typeClause.Span())
@@ -2229,6 +3357,8 @@ func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (sym
 		return builtins.String, true
 	case "thread":
 		return builtins.Thread, true
+	case "exception":
+		return builtins.Exception, true
 	case "any":
 		return builtins.Any, true
 	case "array":
@@ -2247,6 +3377,23 @@ func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (sym
 		baseType, _ := bin.LookupType(typeClause.SubClauses[0], false)
 		return symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{baseType}, true, false, false, symbols.PackageSymbol{}, nil), true
 
+	case "map":
+		if len(typeClause.SubClauses) != 2 {
+			print.Error(
+				"BINDER",
+				print.InvalidNumberOfSubtypesError,
+				typeClause.Span(),
+				"Datatype \"%s\" takes in exactly two subtypes! (key and value)",
+				typeClause.TypeIdentifier.Value,
+			)
+
+			return builtins.Error, true
+		}
+
+		keyType, _ := bin.LookupType(typeClause.SubClauses[0], false)
+		valueType, _ := bin.LookupType(typeClause.SubClauses[1], false)
+		return symbols.CreateTypeSymbol("map", []symbols.TypeSymbol{keyType, valueType}, true, false, false, symbols.PackageSymbol{}, nil), true
+
 	case "pointer":
 		if len(typeClause.SubClauses) != 1 {
 			print.Error(
@@ -2284,6 +3431,21 @@ func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (sym
 		return symbols.CreateTypeSymbol("action", subTypes, false, false, false, symbols.PackageSymbol{}, nil), true
 
 	default:
+		// check if this is one of the type parameters of the generic we're in
+		if typ, ok := bin.TypeArguments[typeClause.TypeIdentifier.Value]; ok && len(typeClause.SubClauses) == 0 {
+			return typ, true
+		}
+
+		// check if this might be a generic class
+		if decl, ok := GenericClasses[typeClause.TypeIdentifier.Value]; ok {
+			typeArgs, ok := bin.BindTypeArguments(decl.Identifier.Value, decl.TypeParameters, typeClause.SubClauses, typeClause.Span())
+			if !ok {
+				return builtins.Error, true
+			}
+
+			return bin.InstantiateGenericClass(decl, typeArgs), true
+		}
+
 		// check if this might be a class
 		cls, ok := bin.LookupClass(typeClause.TypeIdentifier.Value, true, typeClause.TypeIdentifier.Span)
 		if ok {
@@ -2296,6 +3458,12 @@ func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (sym
 			return stc.Type, true
 		}
 
+		// check if this might be an interface
+		iface, ok := bin.LookupInterface(typeClause.TypeIdentifier.Value)
+		if ok {
+			return iface.Type, true
+		}
+
 		// check if this might be an enum
 		enm, ok := bin.LookupEnum(typeClause.TypeIdentifier.Value)
 		if ok {
@@ -2317,10 +3485,12 @@ func (bin Binder) LookupType(typeClause nodes.TypeClauseNode, canFail bool) (sym
 
 		// otherwise, die()
 		if !canFail {
-			print.Error(
+			print.ErrorWithSuggestions(
 				"BINDER",
 				print.UnknownDataTypeError,
 				typeClause.Span(),
+				typeClause.TypeIdentifier.Span,
+				print.SuggestNames(typeClause.TypeIdentifier.Value, bin.TypeNames()),
 				"Couldn't find datatype \"%s\"! Are you sure it exists?",
 				typeClause.TypeIdentifier.Value,
 			)
@@ -2355,15 +3525,19 @@ func LookupPrimitiveType(name string, canFail bool, errorLocation print.TextSpan
 		return builtins.String, true
 	case "thread":
 		return builtins.Thread, true
+	case "exception":
+		return builtins.Exception, true
 	case "any":
 		return builtins.Any, true
 	default:
 		if !canFail {
 			//print.PrintC(print.Red, "Couldnt find Datatype '"+name+"'!")
-			print.Error(
+			print.ErrorWithSuggestions(
 				"BINDER",
 				print.UnknownDataTypeError,
 				errorLocation,
+				errorLocation,
+				print.SuggestNames(name, BuiltinTypeNames),
 				"Couldn't find primitive datatype \"%s\"! Are you sure it exists?",
 				name,
 			)
@@ -2377,16 +3551,25 @@ func LookupPrimitiveType(name string, canFail bool, errorLocation print.TextSpan
 func (bin Binder) LookupClass(name string, canFail bool, errorLocaton print.TextSpan) (symbols.ClassSymbol, bool) {
 	cls := bin.ActiveScope.TryLookupSymbol(name)
 	if cls == nil {
-		return FailClassLookup(name, canFail, errorLocaton)
+		return FailClassLookup(name, canFail, errorLocaton, ClassNames(*bin.ActiveScope))
 	}
 
 	if cls.SymbolType() != symbols.Class {
-		return FailClassLookup(name, canFail, errorLocaton)
+		return FailClassLookup(name, canFail, errorLocaton, ClassNames(*bin.ActiveScope))
 	}
 
 	return cls.(symbols.ClassSymbol), true
 }
 
+func (bin Binder) LookupInterface(name string) (symbols.InterfaceSymbol, bool) {
+	iface := bin.ActiveScope.TryLookupSymbol(name)
+	if iface == nil || iface.SymbolType() != symbols.Interface {
+		return symbols.InterfaceSymbol{}, false
+	}
+
+	return iface.(symbols.InterfaceSymbol), true
+}
+
 func (bin Binder) LookupEnum(name string) (symbols.EnumSymbol, bool) {
 	// enums are *always* declared in the global scope
 	enm := MainScope.TryLookupSymbol(name)
@@ -2418,11 +3601,11 @@ func (bin Binder) LookupStruct(name string, canFail bool, errorLocaton print.Tex
 func (bin Binder) LookupPackage(name string, canFail bool, errorLocaton print.TextSpan) (symbols.PackageSymbol, bool) {
 	pck := bin.ActiveScope.TryLookupSymbol(name)
 	if pck == nil {
-		return FailPackageLookup(name, canFail, errorLocaton)
+		return FailPackageLookup(name, canFail, errorLocaton, PackageNames(*bin.ActiveScope))
 	}
 
 	if pck.SymbolType() != symbols.Package {
-		return FailPackageLookup(name, canFail, errorLocaton)
+		return FailPackageLookup(name, canFail, errorLocaton, PackageNames(*bin.ActiveScope))
 	}
 
 	return pck.(symbols.PackageSymbol), true
@@ -2436,10 +3619,17 @@ func LookupClassInPackage(name string, pack symbols.PackageSymbol, canFail bool,
 	}
 
 	if !canFail {
-		print.Error(
+		classes := make([]string, 0)
+		for _, cls := range pack.Classes {
+			classes = append(classes, cls.Name)
+		}
+
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UnknownClassError,
 			errorLocation,
+			TrailingSpan(errorLocation, name),
+			print.SuggestNames(name, classes),
 			"Couldn't find class \"%s\" in package \"%s\"! Are you sure it exists?",
 			name,
 			pack.Name,
@@ -2458,10 +3648,17 @@ func LookupFunctionInPackage(name string, pack symbols.PackageSymbol, canFail bo
 	}
 
 	if !canFail {
-		print.Error(
+		functions := make([]string, 0)
+		for _, fnc := range pack.Functions {
+			functions = append(functions, fnc.Name)
+		}
+
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UndefinedFunctionCallError,
 			errorLocation,
+			TrailingSpan(errorLocation, name),
+			print.SuggestNames(name, functions),
 			"Couldn't find function \"%s\" in package \"%s\"! Are you sure it exists?",
 			name,
 			pack.Name,
@@ -2472,13 +3669,15 @@ func LookupFunctionInPackage(name string, pack symbols.PackageSymbol, canFail bo
 	return symbols.FunctionSymbol{}, false
 }
 
-func FailClassLookup(name string, canFail bool, errorLocation print.TextSpan) (symbols.ClassSymbol, bool) {
+func FailClassLookup(name string, canFail bool, errorLocation print.TextSpan, candidates []string) (symbols.ClassSymbol, bool) {
 	if !canFail {
 		//print.PrintC(print.Red, "Couldnt find Datatype '"+name+"'!")
-		print.Error(
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UnknownClassError,
 			errorLocation,
+			errorLocation,
+			print.SuggestNames(name, candidates),
 			"Couldn't find class \"%s\"! Are you sure it exists?",
 			name,
 		)
@@ -2504,13 +3703,15 @@ func FailStructLookup(name string, canFail bool, errorLocation print.TextSpan) (
 	return symbols.StructSymbol{}, false
 }
 
-func FailPackageLookup(name string, canFail bool, errorLocation print.TextSpan) (symbols.PackageSymbol, bool) {
+func FailPackageLookup(name string, canFail bool, errorLocation print.TextSpan, candidates []string) (symbols.PackageSymbol, bool) {
 	if !canFail {
 		//print.PrintC(print.Red, "Couldnt find Datatype '"+name+"'!")
-		print.Error(
+		print.ErrorWithSuggestions(
 			"BINDER",
 			print.UnknownPackageError,
 			errorLocation,
+			errorLocation,
+			print.SuggestNames(name, candidates),
 			"Couldn't find package \"%s\"! Are you sure it was imported?",
 			name,
 		)
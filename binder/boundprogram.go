@@ -3,6 +3,7 @@ package binder
 import (
 	"fmt"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/lowerer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
@@ -13,14 +14,19 @@ import (
 var MainScope Scope
 var PackageUseList []symbols.PackageSymbol
 
+// fingerprints of all variables that are captured by a lambda somewhere
+var CapturedVariables = make(map[string]bool)
+
 type BoundProgram struct {
 	GlobalScope       *GlobalScope
 	MainFunction      symbols.FunctionSymbol
 	Functions         []BoundFunction
 	ExternalFunctions []symbols.FunctionSymbol
 	Classes           []BoundClass
+	Interfaces        []symbols.InterfaceSymbol
 	Structs           []symbols.StructSymbol
 	Packages          []symbols.PackageSymbol
+	CapturedVariables map[string]bool
 }
 
 type BoundFunction struct {
@@ -34,6 +40,9 @@ type BoundClass struct {
 }
 
 func BindProgram(members []nodes.MemberNode) BoundProgram {
+	// forget whatever the last program captured (main's lambdas get bound with the global scope already)
+	CapturedVariables = make(map[string]bool)
+
 	globalScope := BindGlobalScope(members)
 	parentScope := BindParentScope(globalScope)
 	functionBodies := make([]BoundFunction, 0)
@@ -57,89 +66,205 @@ func BindProgram(members []nodes.MemberNode) BoundProgram {
 			continue
 		}
 
-		binder := CreateBinder(parentScope, fnc)
-		body := binder.BindBlockStatement(fnc.Declaration.Body)
-		loweredBody := lowerer.Lower(fnc, body)
-		langserverinterface.Map(fnc, body)
-
-		functionBodies = append(functionBodies, BoundFunction{
-			Symbol: fnc,
-			Body:   loweredBody,
-		})
+		functionBodies = append(functionBodies, BindFunctionBody(parentScope, fnc))
 	}
 
 	for _, cls := range globalScope.Classes {
-		classScope := BindParentScope(globalScope)
-		classScope.InsertVariableSymbols(cls.Fields)
-		classScope.InsertFunctionSymbols(cls.Functions)
+		classes = append(classes, BindClassBodies(globalScope, cls))
+	}
 
-		classFunctionBodies := make([]BoundFunction, 0)
+	// binding all these bodies might have created instances of generics, those need their bodies too
+	// (which can create even more instances, so keep going until we run out)
+	boundFunctionInstances := 0
+	boundClassInstances := 0
 
-		constructorNeedsInjection := false
-		constructorInjection := make([]nodes.StatementNode, 0)
+	for boundFunctionInstances < len(GenericFunctionInstances) || boundClassInstances < len(GenericClassInstances) {
+		for ; boundFunctionInstances < len(GenericFunctionInstances); boundFunctionInstances++ {
+			functionBodies = append(functionBodies, BindFunctionBody(parentScope, GenericFunctionInstances[boundFunctionInstances]))
+		}
 
-		// assemble an injection for the constructor to initialize fields
-		for _, mem := range cls.Declaration.Members {
-			if mem.NodeType() != nodes.GlobalStatement {
-				continue
-			}
+		for ; boundClassInstances < len(GenericClassInstances); boundClassInstances++ {
+			classes = append(classes, BindClassBodies(globalScope, GenericClassInstances[boundClassInstances]))
+		}
+	}
 
-			// all of these should be variable declarations
-			// anything else should have been filtered out by the binder
-			declaration := mem.(nodes.GlobalStatementMember).Statement.(nodes.VariableDeclarationStatementNode)
-			if declaration.Initializer != nil {
-				constructorNeedsInjection = true
-
-				// find the field associated with this declaration
-				for _, fld := range cls.Fields {
-
-					// when found, fabricate an assignment expression
-					if fld.SymbolName() == declaration.Identifier.Value {
-						constructorInjection = append(constructorInjection,
-							nodes.CreateExpressionStatementNode(
-								nodes.CreateAssignmentExpressionNode(declaration.Identifier, declaration.Initializer),
-							),
-						)
-					}
-				}
+	// the generics themselves need a look too, even if nobody ended up using them
+	CheckGenericDeclarations(globalScope, parentScope)
 
+	return BoundProgram{
+		GlobalScope:       &globalScope,
+		MainFunction:      globalScope.MainFunction,
+		Functions:         functionBodies,
+		ExternalFunctions: functionReferences,
+		Classes:           classes,
+		Interfaces:        globalScope.Interfaces,
+		Structs:           globalScope.Structs,
+		Packages:          globalScope.Packages,
+		CapturedVariables: CapturedVariables,
+	}
+}
+
+func BindFunctionBody(parentScope Scope, fnc symbols.FunctionSymbol) BoundFunction {
+	binder := CreateBinder(parentScope, fnc)
+	binder.RegisterScope(fnc.Declaration.Body.Span())
+
+	body := binder.BindBlockStatement(fnc.Declaration.Body)
+	loweredBody := lowerer.Lower(fnc, body)
+	langserverinterface.Map(fnc, body)
+
+	return BoundFunction{
+		Symbol: fnc,
+		Body:   loweredBody,
+	}
+}
+
+func BindClassBodies(globalScope GlobalScope, cls symbols.ClassSymbol) BoundClass {
+	classScope := BindParentScope(globalScope)
+	classScope.InsertVariableSymbols(cls.Fields)
+	classScope.InsertFunctionSymbols(cls.Functions)
+
+	classFunctionBodies := make([]BoundFunction, 0)
+
+	constructorNeedsInjection := false
+	constructorInjection := make([]nodes.StatementNode, 0)
+
+	// assemble an injection for the constructor to initialize fields
+	for _, mem := range cls.Declaration.Members {
+		if mem.NodeType() != nodes.GlobalStatement {
+			continue
+		}
+
+		// all of these should be variable declarations
+		// anything else has already been reported by the binder, so we just skip it
+		declaration, ok := mem.(nodes.GlobalStatementMember).Statement.(nodes.VariableDeclarationStatementNode)
+		if !ok {
+			continue
+		}
+
+		if declaration.Initializer != nil {
+			constructorNeedsInjection = true
+
+			// find the field associated with this declaration
+			for _, fld := range cls.Fields {
+
+				// when found, fabricate an assignment expression
+				if fld.SymbolName() == declaration.Identifier.Value {
+					constructorInjection = append(constructorInjection,
+						nodes.CreateExpressionStatementNode(
+							nodes.CreateAssignmentExpressionNode(declaration.Identifier, declaration.Initializer),
+						),
+					)
+				}
 			}
+
 		}
+	}
+
+	for _, fnc := range cls.Functions {
+		// inherited functions have already been bound with their own class
+		if !IsOwnClassFunction(cls, fnc) {
+			continue
+		}
+
+		if fnc.Name == "Constructor" {
+			body := fnc.Declaration.Body.Statements
+
+			// if there's a base class, its constructor needs to run before anything else
+			baseCall := make([]nodes.StatementNode, 0)
+			if cls.Parent != nil {
+				baseCall, body = SplitBaseConstructorCall(cls, body)
+			}
 
-		for _, fnc := range cls.Functions {
 			// if this class has fields with assignments -> put those in the constructor
-			if constructorNeedsInjection && fnc.Name == "Constructor" {
-				fnc.Declaration.Body.Statements = append(constructorInjection, fnc.Declaration.Body.Statements...)
+			if constructorNeedsInjection {
+				body = append(constructorInjection, body...)
 			}
 
-			binder := CreateBinder(classScope, fnc)
-			binder.InClass = true
-			binder.ClassSymbol = cls
-			body := binder.BindBlockStatement(fnc.Declaration.Body)
-			loweredBody := lowerer.Lower(fnc, body)
-			langserverinterface.Map(fnc, body)
-
-			classFunctionBodies = append(classFunctionBodies, BoundFunction{
-				Symbol: fnc,
-				Body:   loweredBody,
-			})
+			fnc.Declaration.Body.Statements = append(baseCall, body...)
 		}
 
-		classes = append(classes, BoundClass{
-			Symbol:    cls,
-			Functions: classFunctionBodies,
+		binder := CreateBinder(classScope, fnc)
+		binder.InClass = true
+		binder.ClassSymbol = cls
+		binder.RegisterScope(fnc.Declaration.Body.Span())
+
+		body := binder.BindBlockStatement(fnc.Declaration.Body)
+		loweredBody := lowerer.Lower(fnc, body)
+		langserverinterface.Map(fnc, body)
+
+		classFunctionBodies = append(classFunctionBodies, BoundFunction{
+			Symbol: fnc,
+			Body:   loweredBody,
 		})
 	}
 
-	return BoundProgram{
-		GlobalScope:       &globalScope,
-		MainFunction:      globalScope.MainFunction,
-		Functions:         functionBodies,
-		ExternalFunctions: functionReferences,
-		Classes:           classes,
-		Structs:           globalScope.Structs,
-		Packages:          globalScope.Packages,
+	return BoundClass{
+		Symbol:    cls,
+		Functions: classFunctionBodies,
+	}
+}
+
+// checks if a function was declared in the given class (and not inherited from a base)
+func IsOwnClassFunction(cls symbols.ClassSymbol, fnc symbols.FunctionSymbol) bool {
+	if cls.Parent == nil || fnc.Name == "Constructor" {
+		return true
 	}
+
+	for _, mem := range cls.Declaration.Members {
+		if mem.NodeType() == nodes.FunctionDeclaration &&
+			mem.(nodes.FunctionDeclarationMember).Identifier.Value == fnc.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pulls an explicit base(...) call off the front of a constructor body
+// if there is none, an implicit one is fabricated (if the base constructor allows it)
+func SplitBaseConstructorCall(cls symbols.ClassSymbol, body []nodes.StatementNode) ([]nodes.StatementNode, []nodes.StatementNode) {
+	if len(body) > 0 && IsBaseConstructorCall(body[0]) {
+		return body[:1], body[1:]
+	}
+
+	// find the base's constructor
+	for _, fnc := range cls.Parent.Functions {
+		if fnc.Name == "Constructor" && len(fnc.Parameters) > 0 {
+			print.Error(
+				"BINDER",
+				print.IllegalInheritanceError,
+				cls.Declaration.Identifier.Span,
+				"Constructor of class \"%s\" needs to start with a base(...) call, the constructor of \"%s\" expects %d arguments!",
+				cls.Name,
+				cls.Parent.Name,
+				len(fnc.Parameters),
+			)
+			return make([]nodes.StatementNode, 0), body
+		}
+	}
+
+	// fabricate a base() call
+	implicitCall := nodes.CreateExpressionStatementNode(
+		nodes.CreateCallExpressionNode(
+			lexer.Token{Kind: lexer.IdToken, Value: "base", Span: cls.Declaration.Identifier.Span},
+			make([]nodes.ExpressionNode, 0),
+			nodes.TypeClauseNode{},
+			lexer.Token{Span: cls.Declaration.Identifier.Span},
+		),
+	)
+
+	return []nodes.StatementNode{implicitCall}, body
+}
+
+func IsBaseConstructorCall(stmt nodes.StatementNode) bool {
+	if stmt.NodeType() != nodes.ExpressionStatement {
+		return false
+	}
+
+	expr := stmt.(nodes.ExpressionStatementNode).Expression
+	return expr.NodeType() == nodes.CallExpression &&
+		!expr.(nodes.CallExpressionNode).InMain &&
+		expr.(nodes.CallExpressionNode).Identifier.Value == "base"
 }
 
 func (b *BoundProgram) Print() {
@@ -68,6 +68,12 @@ func ClassifyConversion(from symbols.TypeSymbol, to symbols.TypeSymbol) Conversi
 		return ExplicitConversion
 	}
 
+	// converting from an exception to a string (gives you the message)
+	if from.Fingerprint() == builtins.Exception.Fingerprint() &&
+		to.Fingerprint() == builtins.String.Fingerprint() {
+		return ExplicitConversion
+	}
+
 	// converting from a string to a bool, int, long, float, double
 	if from.Fingerprint() == builtins.String.Fingerprint() &&
 		(to.Fingerprint() == builtins.Bool.Fingerprint() ||
@@ -253,5 +259,64 @@ func ClassifyConversion(from symbols.TypeSymbol, to symbols.TypeSymbol) Conversi
 		return IdentityConversion
 	}
 
+	// upcasting a class to one of its bases is always fine,
+	// going back down needs a cast (which gets checked at runtime)
+	if from.IsObject && from.IsUserDefined &&
+		to.IsObject && to.IsUserDefined {
+		fromClass, fromOk := LookupClassOfType(from)
+		toClass, toOk := LookupClassOfType(to)
+
+		// classes can be turned into any interface they implement
+		// getting the class back out of an interface needs to be checked at runtime
+		toIface, toIfaceOk := LookupInterfaceOfType(to)
+		if fromOk && toIfaceOk && fromClass.Implements(toIface) {
+			return ImplicitConversion
+		}
+
+		fromIface, fromIfaceOk := LookupInterfaceOfType(from)
+		if fromIfaceOk && toOk && toClass.Implements(fromIface) {
+			return ExplicitConversion
+		}
+
+		if fromOk && toOk {
+			if fromClass.InheritsFrom(toClass) {
+				return ImplicitConversion
+			}
+
+			if toClass.InheritsFrom(fromClass) {
+				return ExplicitConversion
+			}
+		}
+	}
+
 	return NoConversion
 }
+
+// finds the class symbol belonging to a (local) class type
+func LookupClassOfType(typ symbols.TypeSymbol) (symbols.ClassSymbol, bool) {
+	// package classes cant inherit anything, no need to look for those
+	if typ.Package.Exists {
+		return symbols.ClassSymbol{}, false
+	}
+
+	sym := MainScope.TryLookupSymbol(typ.Name)
+	if sym == nil || sym.SymbolType() != symbols.Class {
+		return symbols.ClassSymbol{}, false
+	}
+
+	return sym.(symbols.ClassSymbol), true
+}
+
+// finds the interface symbol belonging to an interface type
+func LookupInterfaceOfType(typ symbols.TypeSymbol) (symbols.InterfaceSymbol, bool) {
+	if typ.Package.Exists {
+		return symbols.InterfaceSymbol{}, false
+	}
+
+	sym := MainScope.TryLookupSymbol(typ.Name)
+	if sym == nil || sym.SymbolType() != symbols.Interface {
+		return symbols.InterfaceSymbol{}, false
+	}
+
+	return sym.(symbols.InterfaceSymbol), true
+}
@@ -0,0 +1,403 @@
+package binder
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
+)
+
+// generic classes and functions dont get bound right away
+// every time they're used with a new set of types, a copy of them gets bound with those types
+// filled in (Stack[int], Stack[string], ...) -> after that they're just normal classes and functions
+
+var GenericClasses map[string]nodes.ClassDeclarationMember
+var GenericFunctions map[string]nodes.FunctionDeclarationMember
+
+// every instance lives in here, scopes look in here once they run out of parents
+var GenericScope Scope
+
+// instances in the order they were created in, BindProgram works its way through these
+var GenericClassInstances []symbols.ClassSymbol
+var GenericFunctionInstances []symbols.FunctionSymbol
+
+// types of class instances which are currently being bound (so they can refer to themselves)
+var GenericTypesInProgress map[string]symbols.TypeSymbol
+
+// the loose list of types our classes get bound with, instances need it too
+var GenericTypeset []symbols.TypeSymbol
+
+func ResetGenerics() {
+	GenericClasses = make(map[string]nodes.ClassDeclarationMember)
+	GenericFunctions = make(map[string]nodes.FunctionDeclarationMember)
+	GenericScope = CreateScope(nil)
+	GenericClassInstances = make([]symbols.ClassSymbol, 0)
+	GenericFunctionInstances = make([]symbols.FunctionSymbol, 0)
+	GenericTypesInProgress = make(map[string]symbols.TypeSymbol)
+	GenericTypeset = nil
+}
+
+// checks if there already is a generic with this name (and complains if there is)
+func GenericNameTaken(id lexer.Token) bool {
+	_, isClass := GenericClasses[id.Value]
+	_, isFunction := GenericFunctions[id.Value]
+
+	if isClass || isFunction {
+		print.Error(
+			"BINDER",
+			print.DuplicateFunctionError,
+			id.Span,
+			"A member with the name \"%s\" already exists! \"%s\" could not be defined!",
+			id.Value,
+			id.Value,
+		)
+
+		return true
+	}
+
+	return false
+}
+
+// the name of an instance, Stack + [int] -> Stack[int]
+func GenericInstanceName(name string, typeArgs []symbols.TypeSymbol) string {
+	name += "["
+
+	for i, arg := range typeArgs {
+		if i != 0 {
+			name += ","
+		}
+
+		if len(arg.SubTypes) != 0 {
+			name += GenericInstanceName(arg.Name, arg.SubTypes)
+		} else {
+			name += arg.Name
+		}
+	}
+
+	return name + "]"
+}
+
+func CreateTypeArgumentMap(params []lexer.Token, typeArgs []symbols.TypeSymbol) map[string]symbols.TypeSymbol {
+	typeArgMap := make(map[string]symbols.TypeSymbol)
+
+	for i, param := range params {
+		typeArgMap[param.Value] = typeArgs[i]
+	}
+
+	return typeArgMap
+}
+
+func (bin *Binder) BindTypeArguments(name string, params []lexer.Token, clauses []nodes.TypeClauseNode, errorLocation print.TextSpan) ([]symbols.TypeSymbol, bool) {
+	if len(clauses) != len(params) {
+		print.Error(
+			"BINDER",
+			print.InvalidNumberOfSubtypesError,
+			errorLocation,
+			"Generic \"%s\" takes in exactly %d type arguments but got %d!",
+			name,
+			len(params),
+			len(clauses),
+		)
+
+		return nil, false
+	}
+
+	typeArgs := make([]symbols.TypeSymbol, 0)
+	for _, clause := range clauses {
+		typ, _ := bin.LookupType(clause, false)
+		typeArgs = append(typeArgs, typ)
+	}
+
+	return typeArgs, CheckGenericDepth(name, typeArgs, errorLocation)
+}
+
+// types can only be nested so deep
+// generics which keep asking for bigger versions of themselves (Box[T] using Box[Box[T]]) would never stop otherwise
+const MaxGenericDepth = 32
+
+func CheckGenericDepth(name string, typeArgs []symbols.TypeSymbol, errorLocation print.TextSpan) bool {
+	// instances dont keep their type arguments around as subtypes, so just count the brackets in the name (Box[Box[int]] -> 2)
+	depth := 0
+	deepest := 0
+	for _, char := range GenericInstanceName(name, typeArgs) {
+		if char == '[' {
+			depth++
+			if depth > deepest {
+				deepest = depth
+			}
+		} else if char == ']' {
+			depth--
+		}
+	}
+
+	if deepest <= MaxGenericDepth {
+		return true
+	}
+
+	print.Error(
+		"BINDER",
+		print.IllegalGenericDeclarationError,
+		errorLocation,
+		"Generic \"%s\" keeps creating bigger and bigger versions of itself! Its type arguments can't be nested more than %d levels deep.",
+		name,
+		MaxGenericDepth,
+	)
+
+	return false
+}
+
+func (bin *Binder) InstantiateGenericClass(decl nodes.ClassDeclarationMember, typeArgs []symbols.TypeSymbol) symbols.TypeSymbol {
+	// no instances for types we dont know
+	if ContainsErrorType(typeArgs) {
+		return builtins.Error
+	}
+
+	name := GenericInstanceName(decl.Identifier.Value, typeArgs)
+
+	// if this instance already exists, we're done here
+	sym := GenericScope.TryLookupSymbol(name)
+	if sym != nil && sym.SymbolType() == symbols.Class {
+		return sym.(symbols.ClassSymbol).Type
+	}
+
+	// if it's still being bound, just hand out its type
+	typ, ok := GenericTypesInProgress[name]
+	if ok {
+		return typ
+	}
+
+	GenericTypesInProgress[name] = symbols.CreateTypeSymbol(name, make([]symbols.TypeSymbol, 0), true, true, false, symbols.PackageSymbol{}, nil)
+
+	// bind a copy of the class with our types filled in
+	instance := decl
+	instance.Identifier.Value = name
+
+	binder := CreateBinder(MainScope, symbols.FunctionSymbol{})
+	binder.TypeArguments = CreateTypeArgumentMap(decl.TypeParameters, typeArgs)
+	binder.BindClassDeclaration(instance, GenericTypeset)
+
+	delete(GenericTypesInProgress, name)
+
+	sym = binder.MemberScope.Symbols[name]
+	if sym == nil || sym.SymbolType() != symbols.Class {
+		return builtins.Error
+	}
+
+	cls := sym.(symbols.ClassSymbol)
+	GenericScope.TryDeclareSymbol(cls)
+	GenericClassInstances = append(GenericClassInstances, cls)
+
+	return cls.Type
+}
+
+func (bin *Binder) InstantiateGenericFunction(decl nodes.FunctionDeclarationMember, typeArgs []symbols.TypeSymbol) (symbols.FunctionSymbol, bool) {
+	// same goes for functions
+	if ContainsErrorType(typeArgs) {
+		return symbols.FunctionSymbol{}, false
+	}
+
+	name := GenericInstanceName(decl.Identifier.Value, typeArgs)
+
+	// if this instance already exists, we're done here
+	sym := GenericScope.TryLookupSymbol(name)
+	if sym != nil && sym.SymbolType() == symbols.Function {
+		return sym.(symbols.FunctionSymbol), true
+	}
+
+	// bind a copy of the function with our types filled in
+	instance := decl
+	instance.Identifier.Value = name
+
+	binder := CreateBinder(MainScope, symbols.FunctionSymbol{})
+	binder.TypeArguments = CreateTypeArgumentMap(decl.TypeParameters, typeArgs)
+	binder.BindFunctionDeclaration(instance, false)
+
+	sym = binder.MemberScope.Symbols[name]
+	if sym == nil || sym.SymbolType() != symbols.Function {
+		return symbols.FunctionSymbol{}, false
+	}
+
+	fnc := sym.(symbols.FunctionSymbol)
+	GenericScope.TryDeclareSymbol(fnc)
+	GenericFunctionInstances = append(GenericFunctionInstances, fnc)
+
+	return fnc, true
+}
+
+// checks if any of these types (or their subtypes) is the error type
+// (that's either because something already went wrong, or because we're only checking a generic)
+func ContainsErrorType(types []symbols.TypeSymbol) bool {
+	for _, typ := range types {
+		if typ.Fingerprint() == builtins.Error.Fingerprint() || ContainsErrorType(typ.SubTypes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generics that nobody uses never get bound, so any mistakes in them would go unnoticed
+// this binds every one of them once with their type parameters set to the error type
+// the binder doesn't complain about anything involving those (whether that works is up to the instances)
+// so only mistakes that'd be there no matter what types get filled in are reported, the rest is thrown away
+func CheckGenericDeclarations(globalScope GlobalScope, parentScope Scope) {
+	// none of this is supposed to show up anywhere
+	snapshot := langserverinterface.TakeSnapshot()
+	captured := make(map[string]bool)
+	for fingerprint, isCaptured := range CapturedVariables {
+		captured[fingerprint] = isCaptured
+	}
+
+	for _, decl := range SortedGenericClasses() {
+		binder := CreateBinder(MainScope, symbols.FunctionSymbol{})
+		binder.TypeArguments = PlaceholderTypeArguments(decl.TypeParameters)
+		binder.BindClassDeclaration(decl, GenericTypeset)
+
+		sym := binder.MemberScope.Symbols[decl.Identifier.Value]
+		if sym != nil && sym.SymbolType() == symbols.Class {
+			BindClassBodies(globalScope, sym.(symbols.ClassSymbol))
+		}
+	}
+
+	for _, decl := range SortedGenericFunctions() {
+		binder := CreateBinder(MainScope, symbols.FunctionSymbol{})
+		binder.TypeArguments = PlaceholderTypeArguments(decl.TypeParameters)
+		binder.BindFunctionDeclaration(decl, false)
+
+		sym := binder.MemberScope.Symbols[decl.Identifier.Value]
+		if sym != nil && sym.SymbolType() == symbols.Function {
+			BindFunctionBody(parentScope, sym.(symbols.FunctionSymbol))
+		}
+	}
+
+	langserverinterface.RestoreSnapshot(snapshot)
+	CapturedVariables = captured
+}
+
+func PlaceholderTypeArguments(params []lexer.Token) map[string]symbols.TypeSymbol {
+	typeArgMap := make(map[string]symbols.TypeSymbol)
+
+	for _, param := range params {
+		typeArgMap[param.Value] = builtins.Error
+	}
+
+	return typeArgMap
+}
+
+// generics in the order they were declared in (so their errors come out in that order too)
+func SortedGenericClasses() []nodes.ClassDeclarationMember {
+	declarations := make([]nodes.ClassDeclarationMember, 0, len(GenericClasses))
+	for _, decl := range GenericClasses {
+		declarations = append(declarations, decl)
+	}
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return SpanComesFirst(declarations[i].Identifier.Span, declarations[j].Identifier.Span)
+	})
+
+	return declarations
+}
+
+func SortedGenericFunctions() []nodes.FunctionDeclarationMember {
+	declarations := make([]nodes.FunctionDeclarationMember, 0, len(GenericFunctions))
+	for _, decl := range GenericFunctions {
+		declarations = append(declarations, decl)
+	}
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return SpanComesFirst(declarations[i].Identifier.Span, declarations[j].Identifier.Span)
+	})
+
+	return declarations
+}
+
+func SpanComesFirst(a print.TextSpan, b print.TextSpan) bool {
+	if a.File != b.File {
+		return a.File < b.File
+	}
+	if a.StartLine != b.StartLine {
+		return a.StartLine < b.StartLine
+	}
+
+	return a.StartColumn < b.StartColumn
+}
+
+// finds (or creates) the instance of a generic function a call is referring to
+// type arguments are either given explicitly (Max[int](a, b)) or figured out from the arguments (Max(a, b))
+func (bin *Binder) BindGenericFunctionCall(decl nodes.FunctionDeclarationMember, expr nodes.CallExpressionNode, args []boundnodes.BoundExpressionNode) (symbols.FunctionSymbol, bool) {
+	var typeArgs []symbols.TypeSymbol
+	ok := true
+
+	if expr.CastingType.ClauseIsSet {
+		typeArgs, ok = bin.BindTypeArguments(decl.Identifier.Value, decl.TypeParameters, expr.CastingType.SubClauses, expr.CastingType.Span())
+	} else {
+		typeArgs, ok = bin.InferTypeArguments(decl, args, expr.Span())
+	}
+
+	if !ok {
+		return symbols.FunctionSymbol{}, false
+	}
+
+	return bin.InstantiateGenericFunction(decl, typeArgs)
+}
+
+func (bin *Binder) InferTypeArguments(decl nodes.FunctionDeclarationMember, args []boundnodes.BoundExpressionNode, errorLocation print.TextSpan) ([]symbols.TypeSymbol, bool) {
+	typeArgs := make([]symbols.TypeSymbol, 0)
+
+	for _, typeParam := range decl.TypeParameters {
+		found := false
+
+		// look for the first parameter which tells us what this type is
+		for i, param := range decl.Parameters {
+			if i >= len(args) {
+				break
+			}
+
+			typ, ok := InferTypeArgument(param.TypeClause, args[i].Type(), typeParam.Value)
+			if ok {
+				typeArgs = append(typeArgs, typ)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			print.Error(
+				"BINDER",
+				print.GenericTypeInferenceError,
+				errorLocation,
+				"Could not figure out what type \"%s\" of function \"%s\" is supposed to be! Try giving it explicitly (%s[...](...))",
+				typeParam.Value,
+				decl.Identifier.Value,
+				decl.Identifier.Value,
+			)
+
+			return nil, false
+		}
+	}
+
+	return typeArgs, CheckGenericDepth(decl.Identifier.Value, typeArgs, errorLocation)
+}
+
+// matches a parameter's type clause against an argument's type to find out what a type parameter stands for
+// (T <- int gives int, array[T] <- array[string] gives string)
+func InferTypeArgument(clause nodes.TypeClauseNode, typ symbols.TypeSymbol, typeParam string) (symbols.TypeSymbol, bool) {
+	if clause.Package == nil && clause.TypeIdentifier.Value == typeParam && len(clause.SubClauses) == 0 {
+		return typ, true
+	}
+
+	if clause.TypeIdentifier.Value == typ.Name && len(clause.SubClauses) == len(typ.SubTypes) {
+		for i, subClause := range clause.SubClauses {
+			subType, ok := InferTypeArgument(subClause, typ.SubTypes[i], typeParam)
+			if ok {
+				return subType, true
+			}
+		}
+	}
+
+	return symbols.TypeSymbol{}, false
+}
@@ -2,10 +2,13 @@ package binder
 
 import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
 )
 
 type GlobalScope struct {
@@ -14,6 +17,7 @@ type GlobalScope struct {
 	Functions  []symbols.FunctionSymbol
 	Variables  []symbols.VariableSymbol
 	Classes    []symbols.ClassSymbol
+	Interfaces []symbols.InterfaceSymbol
 	Structs    []symbols.StructSymbol
 	Packages   []symbols.PackageSymbol
 	Statements []boundnodes.BoundStatementNode
@@ -39,28 +43,66 @@ func (g GlobalScope) Print() {
 	}
 }
 
+// if this is set, programs get bound on top of it instead of starting from scratch
+// (the repl binds every line on top of the lines before it, so everything declared there sticks around)
+var PreviousScope *Scope
+
 func BindGlobalScope(members []nodes.MemberNode) GlobalScope {
 	rootScope := BindRootScope()
 	mainScope := CreateScope(&rootScope)
 
+	if PreviousScope != nil {
+		mainScope = CreateScope(PreviousScope)
+	}
+
 	packageReferences := make([]nodes.PackageReferenceMember, 0)
 	packageAliases := make([]nodes.PackageAliasMember, 0)
 	packageUses := make([]nodes.PackageUseMember, 0)
 	functionDeclarations := make([]nodes.FunctionDeclarationMember, 0)
 	externalFunctionDeclarations := make([]nodes.ExternalFunctionDeclarationMember, 0)
 	classDeclarations := make([]nodes.ClassDeclarationMember, 0)
+	interfaceDeclarations := make([]nodes.InterfaceDeclarationMember, 0)
 	structDeclarations := make([]nodes.StructDeclarationMember, 0)
 	enumDeclarations := make([]nodes.EnumDeclarationMember, 0)
 	globalStatements := make([]nodes.GlobalStatementMember, 0)
 
+	// forget about any generics and symbol locations from the last time we were here
+	// (unless we're building on top of it)
+	if PreviousScope == nil {
+		ResetGenerics()
+	}
+	langserverinterface.Reset()
+
 	// sort all our members into functions and global statements
 	for _, member := range members {
 		if member.NodeType() == nodes.FunctionDeclaration {
-			functionDeclarations = append(functionDeclarations, member.(nodes.FunctionDeclarationMember))
+			fnc := member.(nodes.FunctionDeclarationMember)
+
+			// generic functions only get bound once somebody uses them
+			if len(fnc.TypeParameters) != 0 {
+				if !GenericNameTaken(fnc.Identifier) {
+					GenericFunctions[fnc.Identifier.Value] = fnc
+				}
+				continue
+			}
+
+			functionDeclarations = append(functionDeclarations, fnc)
 		} else if member.NodeType() == nodes.ExternalFunctionDeclaration {
 			externalFunctionDeclarations = append(externalFunctionDeclarations, member.(nodes.ExternalFunctionDeclarationMember))
 		} else if member.NodeType() == nodes.ClassDeclaration {
-			classDeclarations = append(classDeclarations, member.(nodes.ClassDeclarationMember))
+			cls := member.(nodes.ClassDeclarationMember)
+
+			// same goes for generic classes
+			if len(cls.TypeParameters) != 0 {
+				if !GenericNameTaken(cls.Identifier) {
+					GenericClasses[cls.Identifier.Value] = cls
+				}
+				continue
+			}
+
+			classDeclarations = append(classDeclarations, cls)
+		} else if member.NodeType() == nodes.InterfaceDeclaration {
+			interfaceDeclarations = append(interfaceDeclarations, member.(nodes.InterfaceDeclarationMember))
 		} else if member.NodeType() == nodes.StructDeclaration {
 			structDeclarations = append(structDeclarations, member.(nodes.StructDeclarationMember))
 		} else if member.NodeType() == nodes.EnumDeclaration {
@@ -78,6 +120,9 @@ func BindGlobalScope(members []nodes.MemberNode) GlobalScope {
 
 	binder := CreateBinder(mainScope, symbols.FunctionSymbol{})
 
+	// global statements can be anywhere, so this scope doesn't get a span
+	binder.RegisterScope(print.TextSpan{})
+
 	// first load all enums, this is cool because it doenst require anything else to be set up first
 	for _, enm := range enumDeclarations {
 		binder.BindEnumDeclaration(enm)
@@ -107,6 +152,9 @@ func BindGlobalScope(members []nodes.MemberNode) GlobalScope {
 	for _, cls := range classDeclarations {
 		preInitialTypeset = append(preInitialTypeset, symbols.CreateTypeSymbol(cls.Identifier.Value, make([]symbols.TypeSymbol, 0), true, true, false, symbols.PackageSymbol{}, nil))
 	}
+	for _, iface := range interfaceDeclarations {
+		preInitialTypeset = append(preInitialTypeset, symbols.CreateTypeSymbol(iface.Identifier.Value, make([]symbols.TypeSymbol, 0), true, true, false, symbols.PackageSymbol{}, nil))
+	}
 	for _, stc := range structDeclarations {
 		preInitialTypeset = append(preInitialTypeset, symbols.CreateTypeSymbol(stc.Identifier.Value, make([]symbols.TypeSymbol, 0), false, true, false, symbols.PackageSymbol{}, nil))
 	}
@@ -114,13 +162,38 @@ func BindGlobalScope(members []nodes.MemberNode) GlobalScope {
 		preInitialTypeset = append(preInitialTypeset, symbols.CreateTypeSymbol(enm.Identifier.Value, make([]symbols.TypeSymbol, 0), false, false, true, symbols.PackageSymbol{}, nil))
 	}
 
+	// whatever was declared before us is already done, those types can go in as they are
+	if PreviousScope != nil {
+		for _, cls := range PreviousScope.GetAllClasses() {
+			preInitialTypeset = append(preInitialTypeset, cls.Type)
+		}
+		for _, iface := range PreviousScope.GetAllInterfaces() {
+			preInitialTypeset = append(preInitialTypeset, iface.Type)
+		}
+		for _, stc := range PreviousScope.GetAllStructs() {
+			preInitialTypeset = append(preInitialTypeset, stc.Type)
+		}
+		for _, enm := range PreviousScope.GetAllEnums() {
+			preInitialTypeset = append(preInitialTypeset, enm.Type)
+		}
+	}
+
+	// generic instances get bound later on, they need this list too
+	GenericTypeset = preInitialTypeset
+
+	// declare all our interfaces, classes need these to check if they're implemented properly
+	for _, iface := range interfaceDeclarations {
+		binder.BindInterfaceDeclaration(iface, preInitialTypeset)
+	}
+
 	// declare all our structs and their fields
 	for _, stc := range structDeclarations {
 		binder.BindStructDeclaration(stc, preInitialTypeset)
 	}
 
 	// declare all our classes and their members
-	for _, cls := range classDeclarations {
+	// (base classes need to be declared before anything inheriting from them)
+	for _, cls := range SortClassDeclarations(classDeclarations) {
 		binder.BindClassDeclaration(cls, preInitialTypeset)
 	}
 
@@ -146,17 +219,102 @@ func BindGlobalScope(members []nodes.MemberNode) GlobalScope {
 	// this is now the even globaler parent scope node (updated)
 	MainScope = *binder.ActiveScope
 
+	// keep base classes in front of their children, the emitter needs them in that order
+	classes := binder.ActiveScope.GetAllClasses()
+	sort.SliceStable(classes, func(i, j int) bool {
+		return ClassDepth(classes[i]) < ClassDepth(classes[j])
+	})
+
 	return GlobalScope{
 		MainFunction: symbols.CreateFunctionSymbol("main", make([]symbols.ParameterSymbol, 0), builtins.Void, nodes.FunctionDeclarationMember{}, true),
 		Functions:    binder.ActiveScope.GetAllFunctions(),
 		Variables:    binder.ActiveScope.GetAllVariables(),
-		Classes:      binder.ActiveScope.GetAllClasses(),
+		Classes:      classes,
+		Interfaces:   binder.ActiveScope.GetAllInterfaces(),
 		Structs:      binder.ActiveScope.GetAllStructs(),
 		Packages:     binder.ActiveScope.GetAllPackages(),
 		Statements:   boundStatements,
 	}
 }
 
+// orders class declarations so every base class comes before the classes inheriting from it
+// any inheritance cycles get reported and broken up here
+func SortClassDeclarations(declarations []nodes.ClassDeclarationMember) []nodes.ClassDeclarationMember {
+	byName := make(map[string]int)
+	for i, cls := range declarations {
+		byName[cls.Identifier.Value] = i
+	}
+
+	sorted := make([]nodes.ClassDeclarationMember, 0)
+	state := make([]int, len(declarations)) // 0 = not visited, 1 = in progress, 2 = done
+
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = 1
+		cls := declarations[i]
+
+		// only keep the base types which dont cause any trouble
+		bases := make([]lexer.Token, 0)
+		for _, baseType := range cls.BaseTypes {
+			base, ok := byName[baseType.Value]
+
+			// if this isnt a class declared in here, its the binders job to deal with it
+			if !ok {
+				bases = append(bases, baseType)
+				continue
+			}
+
+			if base == i {
+				print.Error(
+					"BINDER",
+					print.IllegalInheritanceError,
+					baseType.Span,
+					"Class \"%s\" can not inherit from itself!",
+					cls.Identifier.Value,
+				)
+			} else if state[base] == 1 {
+				print.Error(
+					"BINDER",
+					print.IllegalInheritanceError,
+					baseType.Span,
+					"Class \"%s\" can not inherit from \"%s\" as that would create an inheritance cycle!",
+					cls.Identifier.Value,
+					baseType.Value,
+				)
+			} else {
+				if state[base] == 0 {
+					visit(base)
+				}
+
+				bases = append(bases, baseType)
+			}
+		}
+
+		cls.BaseTypes = bases
+
+		state[i] = 2
+		sorted = append(sorted, cls)
+	}
+
+	for i := range declarations {
+		if state[i] == 0 {
+			visit(i)
+		}
+	}
+
+	return sorted
+}
+
+// how many classes deep in the inheritance chain this class is
+func ClassDepth(cls symbols.ClassSymbol) int {
+	depth := 0
+	for parent := cls.Parent; parent != nil; parent = parent.Parent {
+		depth++
+	}
+
+	return depth
+}
+
 func BindParentScope(globalScope GlobalScope) Scope {
 	parent := BindRootScope()
 	workingScope := CreateScope(&parent)
@@ -169,6 +327,10 @@ func BindParentScope(globalScope GlobalScope) Scope {
 		workingScope.TryDeclareSymbol(cls)
 	}
 
+	for _, iface := range globalScope.Interfaces {
+		workingScope.TryDeclareSymbol(iface)
+	}
+
 	for _, stc := range globalScope.Structs {
 		workingScope.TryDeclareSymbol(stc)
 	}
@@ -30,6 +30,12 @@ func (s Scope) TryLookupSymbol(name string) symbols.Symbol {
 		return s.Parent.TryLookupSymbol(name)
 	}
 
+	// instances of generics are visible from everywhere
+	sym, found = GenericScope.Symbols[name]
+	if found {
+		return sym
+	}
+
 	return nil
 }
 
@@ -104,6 +110,25 @@ func (s Scope) GetAllClasses() []symbols.ClassSymbol {
 	return classes
 }
 
+func (s Scope) GetAllInterfaces() []symbols.InterfaceSymbol {
+	interfaces := make([]symbols.InterfaceSymbol, 0)
+
+	for _, sym := range s.Symbols {
+		if sym.SymbolType() == symbols.Interface {
+			interfaces = append(interfaces, sym.(symbols.InterfaceSymbol))
+		}
+	}
+
+	moreInterfaces := make([]symbols.InterfaceSymbol, 0)
+	if s.Parent != nil {
+		moreInterfaces = s.Parent.GetAllInterfaces()
+	}
+
+	interfaces = append(interfaces, moreInterfaces...)
+
+	return interfaces
+}
+
 func (s Scope) GetAllStructs() []symbols.StructSymbol {
 	structs := make([]symbols.StructSymbol, 0)
 
@@ -123,6 +148,25 @@ func (s Scope) GetAllStructs() []symbols.StructSymbol {
 	return structs
 }
 
+func (s Scope) GetAllEnums() []symbols.EnumSymbol {
+	enums := make([]symbols.EnumSymbol, 0)
+
+	for _, sym := range s.Symbols {
+		if sym.SymbolType() == symbols.Enum {
+			enums = append(enums, sym.(symbols.EnumSymbol))
+		}
+	}
+
+	moreEnums := make([]symbols.EnumSymbol, 0)
+	if s.Parent != nil {
+		moreEnums = s.Parent.GetAllEnums()
+	}
+
+	enums = append(enums, moreEnums...)
+
+	return enums
+}
+
 func (s Scope) GetAllPackages() []symbols.PackageSymbol {
 	packages := make([]symbols.PackageSymbol, 0)
 
@@ -0,0 +1,139 @@
+package binder
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// "did you mean" candidates
+// these collect all the names that could have been meant when a lookup fails
+// (print.SuggestNames picks the ones that are actually close to what was written)
+
+// all the datatypes that are always around
+var BuiltinTypeNames = []string{
+	"void", "bool", "byte", "int", "long", "float", "uint", "ulong", "double",
+	"string", "thread", "exception", "any", "array", "map", "pointer", "action",
+}
+
+// all variables (and parameters) visible from a scope
+func VariableNames(scope Scope) []string {
+	names := make([]string, 0)
+	for _, variable := range scope.GetAllVariables() {
+		names = append(names, variable.SymbolName())
+	}
+
+	return names
+}
+
+// all functions visible from a scope, including the ones in used packages
+func FunctionNames(scope Scope) []string {
+	names := make([]string, 0)
+	for _, function := range scope.GetAllFunctions() {
+		names = append(names, function.Name)
+	}
+
+	for name := range GenericFunctions {
+		names = append(names, name)
+	}
+
+	for _, pck := range PackageUseList {
+		for _, function := range pck.Functions {
+			names = append(names, function.Name)
+		}
+	}
+
+	return names
+}
+
+// all classes visible from a scope, including the ones in used packages
+func ClassNames(scope Scope) []string {
+	names := make([]string, 0)
+	for _, cls := range scope.GetAllClasses() {
+		names = append(names, cls.Name)
+	}
+
+	for name := range GenericClasses {
+		names = append(names, name)
+	}
+
+	for _, pck := range PackageUseList {
+		for _, cls := range pck.Classes {
+			names = append(names, cls.Name)
+		}
+	}
+
+	return names
+}
+
+// every datatype the binder could resolve right now
+func (bin Binder) TypeNames() []string {
+	names := append([]string{}, BuiltinTypeNames...)
+	names = append(names, ClassNames(*bin.ActiveScope)...)
+
+	for _, stc := range bin.ActiveScope.GetAllStructs() {
+		names = append(names, stc.Name)
+	}
+
+	for _, iface := range bin.ActiveScope.GetAllInterfaces() {
+		names = append(names, iface.Name)
+	}
+
+	for _, enm := range MainScope.GetAllEnums() {
+		names = append(names, enm.Name)
+	}
+
+	for name := range bin.TypeArguments {
+		names = append(names, name)
+	}
+
+	for _, typ := range bin.PreInitialTypeset {
+		names = append(names, typ.Name)
+	}
+
+	return names
+}
+
+// all packages that have been loaded
+func PackageNames(scope Scope) []string {
+	names := make([]string, 0)
+	for _, pck := range scope.GetAllPackages() {
+		names = append(names, pck.Name)
+	}
+
+	return names
+}
+
+// all fields of a class or struct
+func FieldNames(fields []symbols.VariableSymbol) []string {
+	names := make([]string, 0)
+	for _, fld := range fields {
+		names = append(names, fld.SymbolName())
+	}
+
+	return names
+}
+
+// all functions of a class that can be called from the outside
+func PublicFunctionNames(functions []symbols.FunctionSymbol) []string {
+	names := make([]string, 0)
+	for _, fnc := range functions {
+		if fnc.Public {
+			names = append(names, fnc.Name)
+		}
+	}
+
+	return names
+}
+
+// TrailingSpan cuts a span down to the name at the very end of it
+// (lots of lookups report the whole "base->name" span, but only the name should get replaced)
+func TrailingSpan(span print.TextSpan, name string) print.TextSpan {
+	length := len([]rune(name))
+	if span.StartLine != span.EndLine || span.EndIndex-span.StartIndex < length {
+		return span
+	}
+
+	span.StartIndex = span.EndIndex - length
+	span.StartColumn = span.EndColumn - length
+	return span
+}
@@ -85,6 +85,14 @@ var (
 		Thread,
 	)
 
+	GetMessage = symbols.CreateBuiltInTypeFunctionSymbol(
+		"GetMessage",
+		[]symbols.ParameterSymbol{},
+		String,
+		nodes.FunctionDeclarationMember{},
+		Exception,
+	)
+
 	Run = symbols.CreateBuiltInTypeFunctionSymbol(
 		"Run",
 		[]symbols.ParameterSymbol{}, // ---> these two get filled in on a case by case basis by the binder
@@ -100,4 +108,36 @@ var (
 		nodes.FunctionDeclarationMember{},
 		Action,
 	)
+
+	MapGetLength = symbols.CreateBuiltInTypeFunctionSymbol(
+		"GetLength",
+		[]symbols.ParameterSymbol{},
+		Int,
+		nodes.FunctionDeclarationMember{},
+		Map,
+	)
+
+	Has = symbols.CreateBuiltInTypeFunctionSymbol(
+		"Has",
+		[]symbols.ParameterSymbol{}, // ---> gets filled in on a case by case basis by the binder
+		Bool,
+		nodes.FunctionDeclarationMember{},
+		Map,
+	)
+
+	Remove = symbols.CreateBuiltInTypeFunctionSymbol(
+		"Remove",
+		[]symbols.ParameterSymbol{}, // ---> gets filled in on a case by case basis by the binder
+		Void,
+		nodes.FunctionDeclarationMember{},
+		Map,
+	)
+
+	Keys = symbols.CreateBuiltInTypeFunctionSymbol(
+		"Keys",
+		[]symbols.ParameterSymbol{},
+		AnyArr, // ---> gets filled in on a case by case basis by the binder
+		nodes.FunctionDeclarationMember{},
+		Map,
+	)
 )
@@ -21,10 +21,16 @@ var (
 	// threads
 	Thread = symbols.CreateTypeSymbol("thread", make([]symbols.TypeSymbol, 0), true, false, false, symbols.PackageSymbol{}, nil)
 
+	// exceptions (the thing you get in a catch block)
+	Exception = symbols.CreateTypeSymbol("exception", make([]symbols.TypeSymbol, 0), true, false, false, symbols.PackageSymbol{}, nil)
+
 	// generic array types so the emitter has something to work with
 	Array  = symbols.CreateTypeSymbol("array", make([]symbols.TypeSymbol, 0), true, false, false, symbols.PackageSymbol{}, nil)
 	PArray = symbols.CreateTypeSymbol("parray", make([]symbols.TypeSymbol, 0), true, false, false, symbols.PackageSymbol{}, nil)
 
+	// same thing for maps (map[K, V])
+	Map = symbols.CreateTypeSymbol("map", make([]symbols.TypeSymbol, 0), true, false, false, symbols.PackageSymbol{}, nil)
+
 	// lazy shortcut
 	AnyArr = symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{Any}, true, false, false, symbols.PackageSymbol{}, nil)
 
@@ -39,6 +45,6 @@ var (
 	Identity = symbols.CreateTypeSymbol("¯\\_(ツ)_/¯", make([]symbols.TypeSymbol, 0), false, false, false, symbols.PackageSymbol{}, nil)
 
 	Types = []symbols.TypeSymbol{
-		Void, Bool, Byte, Int, Long, UInt, ULong, Float, Double, String, Any, Action, Array, PArray, Pointer, Thread, Enum,
+		Void, Bool, Byte, Int, Long, UInt, ULong, Float, Double, String, Any, Action, Array, PArray, Map, Pointer, Thread, Exception, Enum,
 	}
 )
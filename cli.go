@@ -7,17 +7,22 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/emitter"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/formatter"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserver"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/packager"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/parser"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/preprocessor"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/repl"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/vm"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -32,16 +37,22 @@ import (
 // The values are set using the flag library, but they are also commented below
 var helpFlag bool      // false -h
 var interpretFlag bool // false  -i
+var vmFlag bool        // false -vm
 var showVersion bool   // false -v
 var fileLog bool       // false -l
 var debug bool         // -xx
 var tests bool         // Just for running test file like test.rct ( -t )
 var files []string
-var lookup int // For looking up error details
+var lookup string       // For looking up error details (a code or "all")
+var lookupExport string // markdown or json -lookup-export
 var outputPath string
 var llvm bool
 var optimize bool
 var packageIncludePath string
+var diagnosticsFormat string // text (default) -diagnostics
+var diagnosticsOutput string // stdout (default) -diagnostics-out
+var warningsAsErrors bool    // -Werror
+var disabledWarnings string  // -nowarn
 
 var CompileAsPackage bool
 var PackageName string
@@ -56,17 +67,23 @@ const currentVersion string = "1.1"
 func Init() {
 	flag.BoolVar(&helpFlag, "h", false, "Shows this help message")
 	flag.BoolVar(&interpretFlag, "i", false, "Enables interpreter mode, source code will be interpreted instead of compiled.")
+	flag.BoolVar(&vmFlag, "vm", false, "Interprets the source code on the bytecode virtual machine (usually a lot faster than -i)")
 	flag.BoolVar(&showVersion, "v", false, "Shows current ReCT version the compiler supports")
 	flag.BoolVar(&fileLog, "l", false, "Logs process information in a log file")
 	flag.BoolVar(&debug, "xx", false, "Shows brief process information in the command line")
 	// Test (-t) will not be in the help message as it's only really going ot be used for testing compiler features.
 	flag.BoolVar(&tests, "t", false, "For compiler test files (developers only)")
-	flag.IntVar(&lookup, "lookup", 0, "Displays further detail and examples of Errors")
+	flag.StringVar(&lookup, "lookup", "", "Displays further detail and examples of Errors (or lists all of them with \"all\")")
+	flag.StringVar(&lookupExport, "lookup-export", "", "Exports the whole error catalogue as markdown or json (to -o or stdout)")
 	flag.StringVar(&outputPath, "o", "", "Output file")
 	flag.BoolVar(&llvm, "llvm", false, "Compile to LLVM Module")
 	flag.StringVar(&PackageName, "package", "", "Compile as a package with the given name")
 	flag.BoolVar(&optimize, "O", false, "Use compiler optimizations")
 	flag.StringVar(&packageIncludePath, "pi", "", "Custom package include path")
+	flag.StringVar(&diagnosticsFormat, "diagnostics", "text", "How errors and warnings are reported (text, json or sarif)")
+	flag.StringVar(&diagnosticsOutput, "diagnostics-out", "", "File the json / sarif diagnostics get written to (stdout if not set)")
+	flag.BoolVar(&warningsAsErrors, "Werror", false, "Treats all warnings as errors")
+	flag.StringVar(&disabledWarnings, "nowarn", "", "Comma separated list of warning codes to turn off")
 	flag.Parse()
 
 	// needs to be called after flag.Parse() or it'll be empty lol
@@ -75,6 +92,31 @@ func Init() {
 
 // ProcessFlags goes through each flag and decides how they have an effect on the output of the compiler
 func ProcessFlags() {
+	// set up how we report errors before anything can go wrong
+	err := print.SetDiagnosticsFormat(diagnosticsFormat)
+	if err != nil {
+		print.PrintC(print.Red, err.Error())
+		os.Exit(1)
+	}
+
+	// the compiler moves around between directories, so we better remember where this goes right away
+	if diagnosticsOutput != "" {
+		diagnosticsOutput, _ = filepath.Abs(diagnosticsOutput)
+	}
+
+	print.DiagnosticsOutput = diagnosticsOutput
+	print.DiagnosticsVersion = currentVersion
+
+	// same goes for which warnings we care about
+	codes, invalid := print.ParseWarningCodes(disabledWarnings)
+	if invalid != "" {
+		print.PrintC(print.Red, fmt.Sprintf("\"%s\" is not a warning code! (use: %s -lookup <code> to check)", invalid, executableName))
+		os.Exit(1)
+	}
+
+	print.DisableWarnings(codes)
+	print.WarningsAsErrors = warningsAsErrors
+
 	// Mmm test has the highest priority
 	if tests {
 		RunTests()
@@ -87,9 +129,12 @@ func ProcessFlags() {
 		// Show the help menu because they're obviously insane.
 		Help()
 
-	} else if lookup != 0 { // 0 = No look up (default value)
+	} else if lookupExport != "" {
+		ExportErrorCatalogue()
+
+	} else if lookup != "" { // "" = No look up (default value)
 		// If you user requests error code look up
-		print.LookUp(print.ErrorCode(lookup))
+		LookUpError()
 
 	} else {
 
@@ -98,7 +143,23 @@ func ProcessFlags() {
 			Help()
 			return
 
-		} else if interpretFlag {
+		} else if files[0] == "lsp" {
+			RunLanguageServer()
+
+		} else if files[0] == "rename" {
+			RunRename(files[1:])
+
+		} else if files[0] == "fmt" {
+			RunFormat(files[1:])
+
+		} else if files[0] == "repl" {
+			RunRepl()
+
+		} else if files[0] == "cst" {
+			RunConcreteTree(files[1:])
+
+		} else if interpretFlag || vmFlag {
+			SetPackagePaths()
 			InterpretFile(files[0])
 
 		} else {
@@ -107,28 +168,207 @@ func ProcessFlags() {
 				emitter.PackageName = PackageName
 			}
 
-			// get the rgoc executable path
-			ex, err := os.Executable()
-			if err != nil {
-				panic(err)
-			}
-			exPath := filepath.Dir(ex)
+			SetPackagePaths()
+			CompileFiles(files)
+		}
+	}
+}
+
+// SetPackagePaths tells the packager where to look for packages
+func SetPackagePaths() {
+	// get the rgoc executable path
+	ex, err := os.Executable()
+	if err != nil {
+		panic(err)
+	}
+	exPath := filepath.Dir(ex)
+
+	// append the executable path as a valid package location
+	packager.PackagePaths = append(packager.PackagePaths, exPath+"/packages") // standard package dir
+
+	if packageIncludePath != "" {
+		packager.PackagePaths = append(packager.PackagePaths, packageIncludePath)
+	}
+}
+
+// RunLanguageServer starts a language server on stdin/stdout (rgoc lsp)
+func RunLanguageServer() {
+	SetPackagePaths()
+
+	// stdout belongs to the client now, anything else that wants to print goes to stderr
+	out := os.Stdout
+	os.Stdout = os.Stderr
+
+	// errors get sent to the client instead of being printed, and they shouldnt kill us either
+	print.OutputErrorMessages = false
+	print.PanicOnCrash = true
+
+	server := langserver.CreateServer(os.Stdin, out, currentVersion)
+	os.Exit(server.Serve())
+}
+
+// RunRepl starts an interactive session (rgoc repl)
+func RunRepl() {
+	SetPackagePaths()
+	repl.CreateRepl(os.Stdin).Run()
+}
+
+// RunRename renames a symbol across all files it's used in (rgoc rename <file> <line>:<column> <new name>)
+func RunRename(args []string) {
+	if len(args) != 3 {
+		print.PrintC(print.Red, "Usage: rgoc rename <file> <line>:<column> <new name>")
+		os.Exit(1)
+	}
 
-			// append the executable path as a valid package location
-			packager.PackagePaths = append(packager.PackagePaths, exPath+"/packages") // standard package dir
+	position := strings.Split(args[1], ":")
+	line, lineErr := strconv.Atoi(position[0])
+	column, columnErr := 0, fmt.Errorf("missing column")
+	if len(position) == 2 {
+		column, columnErr = strconv.Atoi(position[1])
+	}
+
+	if lineErr != nil || columnErr != nil {
+		print.PrintC(print.Red, "'"+args[1]+"' is not a valid position, it should look like <line>:<column> (both starting at 1)")
+		os.Exit(1)
+	}
+
+	SetPackagePaths()
+
+	// we only want to know if there's errors, not see them
+	print.OutputErrorMessages = false
+	print.PanicOnCrash = true
+
+	changed, err := langserver.RenameOnDisk(args[0], line, column, args[2])
+	if err != nil {
+		print.PrintC(print.Red, "Could not rename: "+err.Error())
+		os.Exit(1)
+	}
+
+	for _, file := range changed {
+		fmt.Println("renamed in " + file)
+	}
+}
+
+// RunFormat formats source files (rgoc fmt [-w] [-check] <files>)
+func RunFormat(args []string) {
+	flags := flag.NewFlagSet("fmt", flag.ExitOnError)
+	write := flags.Bool("w", false, "write the formatted code back into the files")
+	check := flags.Bool("check", false, "only check if the files are formatted")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		print.PrintC(print.Red, "Usage: rgoc fmt [-w] [-check] <files>")
+		os.Exit(1)
+	}
+
+	failed := false
+	unformatted := false
+
+	for _, file := range flags.Args() {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			print.PrintC(print.Red, "Could not read '"+file+"': "+err.Error())
+			failed = true
+			continue
+		}
+
+		formatted, err := formatter.Format(string(source), file)
+		if err != nil {
+			print.PrintC(print.Red, "Could not format: "+err.Error())
+			failed = true
+			continue
+		}
+
+		changed := formatted != string(source)
 
-			if packageIncludePath != "" {
-				packager.PackagePaths = append(packager.PackagePaths, packageIncludePath)
+		if *check {
+			if changed {
+				fmt.Println(file)
+				unformatted = true
+			}
+		} else if *write {
+			if changed {
+				info, _ := os.Stat(file)
+				err = os.WriteFile(file, []byte(formatted), info.Mode().Perm())
+				if err != nil {
+					print.PrintC(print.Red, "Could not write '"+file+"': "+err.Error())
+					failed = true
+				}
 			}
+		} else {
+			fmt.Print(formatted)
+		}
+	}
 
-			CompileFiles(files)
+	if failed {
+		os.Exit(2)
+	}
+
+	if unformatted {
+		os.Exit(1)
+	}
+}
+
+// RunConcreteTree prints the concrete syntax tree of some files (rgoc cst [-check] <files>)
+func RunConcreteTree(args []string) {
+	flags := flag.NewFlagSet("cst", flag.ExitOnError)
+	check := flags.Bool("check", false, "only check if the tree gives back the exact source code")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		print.PrintC(print.Red, "Usage: rgoc cst [-check] <files>")
+		os.Exit(1)
+	}
+
+	// syntax errors end up in the tree as well, so they don't matter for the check
+	if *check {
+		print.OutputErrorMessages = false
+	}
+
+	failed := false
+
+	for _, file := range flags.Args() {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			print.PrintC(print.Red, "Could not read '"+file+"': "+err.Error())
+			failed = true
+			continue
+		}
+
+		// unlike when compiling, any \r stays right where it is (it's just whitespace to the lexer)
+		code := string(source)
+		_, tree := parser.ParseConcrete(lexer.Lex([]rune(code), file))
+
+		if !*check {
+			tree.Print("")
+			continue
 		}
+
+		// every single character has to end up in the tree somewhere
+		if tree.Text() != code {
+			fmt.Println(file)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
 	}
 }
 
 // InterpretFile runs everything to interpret the files, currently only supports up to one file
 func InterpretFile(file string) {
 	boundProgram := Prepare(file)
+
+	// the program is ready to go, so is our report
+	print.WriteDiagnostics()
+
+	// the vm is a lot faster, the tree walker is a lot simpler
+	if vmFlag {
+		vm.Run(boundProgram, debug)
+		return
+	}
+
 	//print.PrintC(print.Cyan, "-> Evaluating!")
 	evaluator.Evaluate(boundProgram)
 }
@@ -159,10 +399,13 @@ func CompileFiles(files []string) {
 	// any errors after emitting? -> die();
 	print.CrashIfErrorsFound()
 
+	// that's all the errors and warnings we'll find, report them
+	print.WriteDiagnostics()
+
 	//fmt.Println(module)
 	output := module.String()
 
-	print.PrintC(print.Green, "Compiled module successfully!")
+	status(print.Green, "Compiled module successfully!")
 
 	// if we're just after the LL Module
 	if llvm {
@@ -314,65 +557,22 @@ func CompileFiles(files []string) {
 	// utterly destroy the temp dir
 	os.RemoveAll("./.tmp")
 
-	print.PrintC(print.Cyan, "Compiled executable successfully!")
+	status(print.Cyan, "Compiled executable successfully!")
 }
 
-// Prepare runs the lexer, parser, binder, and lowerer. This is used before evaluation or emitting.
-func Prepare(file string) binder.BoundProgram {
-	if debug {
-		print.WriteC(print.Green, "-> Lexing...  ")
-	}
-
-	code := lexer.ReadFile(file)
-	tokens := lexer.Lex(code, file)
-	// any errors after lexing? -> die();
-	print.CrashIfErrorsFound()
-
-	if debug {
-		print.PrintC(print.Green, "Done!")
-	}
-
-	if debug {
-		print.WriteC(print.Yellow, "-> Parsing... ")
-	}
-
-	members := parser.Parse(tokens)
-
-	// any errors after parsing? -> die();
-	print.CrashIfErrorsFound()
-
-	if debug {
-		print.PrintC(print.Green, "Done!")
-		for _, mem := range members {
-			mem.Print("")
-		}
-	}
-
-	// change the current working directory
-	ex, err := os.Executable()
-	if err != nil {
-		panic(err)
-	}
-	exPath := filepath.Dir(ex)
-	os.Chdir(exPath)
-
-	if debug {
-		print.WriteC(print.Red, "-> Binding... ")
-	}
-
-	boundProgram := binder.BindProgram(members)
-
-	// any errors after binding? -> die();
-	print.CrashIfErrorsFound()
-
-	if debug {
-		print.PrintC(print.Green, "Done!")
-		boundProgram.Print()
+// status prints a progress message, unless that would end up in the middle of a diagnostics document
+func status(color string, message string) {
+	if print.DiagnosticsFormat != "" && print.DiagnosticsOutput == "" {
+		return
 	}
 
-	//boundProgram.Print()
-	//boundProgram.PrintStatements()
+	print.PrintC(color, message)
+}
 
+// Prepare runs the preprocessor, lexer, parser, binder, and lowerer. This is used before evaluation or emitting.
+// (it's the same as PrepareMultifile, so things like #nowarn and #source work in the interpreter too)
+func Prepare(file string) binder.BoundProgram {
+	boundProgram, _ := PrepareMultifile([]string{file})
 	return boundProgram
 }
 
@@ -395,8 +595,7 @@ func PrepareMultifile(files []string) (binder.BoundProgram, []string) {
 
 		//code := lexer.ReadFile(file)
 		tokens := lexer.Lex(code, file)
-		// any errors after lexing? -> die();
-		print.CrashIfErrorsFound()
+		// any errors after lexing? -> keep going, the parser and binder can still find more
 
 		lexes = append(lexes, tokens)
 
@@ -421,8 +620,8 @@ func PrepareMultifile(files []string) (binder.BoundProgram, []string) {
 		memberList = append(members, memberList...)
 	}
 
-	// any errors after parsing? -> die();
-	print.CrashIfErrorsFound()
+	// any errors after parsing? -> keep going anyways
+	// the parser recovers from syntax errors, so the binder can report everything it finds too
 
 	if debug {
 		print.PrintC(print.Green, "Done!")
@@ -493,20 +692,36 @@ func Help() {
 	fmt.Println(lines)
 
 	fmt.Print("\nUsage: ")
-	print.PrintC(print.Green, "rgoc <file> [options]\n")
+	print.PrintC(print.Green, "rgoc <file> [options]")
+	print.PrintC(print.Green, "rgoc lsp")
+	print.PrintC(print.Green, "rgoc rename <file> <line>:<column> <new name>")
+	print.PrintC(print.Green, "rgoc fmt [-w] [-check] <files>")
+	print.PrintC(print.Green, "rgoc repl")
+	print.PrintC(print.Green, "rgoc cst [-check] <files>\n")
 	fmt.Println("<file> can be the path to any ReCT file (.rct)")
+	fmt.Println("lsp starts a language server on stdin/stdout (for editors like VS Code or Neovim)")
+	fmt.Println("rename renames a symbol everywhere it's used (only in code without errors)")
+	fmt.Println("fmt prints the files formatted, -w rewrites them in place, -check lists the ones that aren't formatted (and fails if there are any)")
+	fmt.Println("repl runs code as you type it, everything you declare sticks around")
+	fmt.Println("cst prints the concrete syntax tree of the files, -check makes sure it gives back the exact source code (and fails if it doesn't)")
 	fmt.Println("\n[Options]")
 
 	helpSegments := []HelpSegment{
 		{"Help", executableName + " -h", "disabled (default)", "Shows this help message!"},
 		//{"Interpret", executableName + " -i", "disabled (default)", "Enables interpreter mode, source code will be interpreted instead of compiled."},
 		//{"File logging", executableName + " -l", "disabled (default)", "Logs process information in a log file"},
+		{"VM", executableName + " -vm", "disabled (default)", "Runs the program on the bytecode virtual machine instead of compiling it (-xx shows the bytecode)"},
 		{"Output", executableName + " -o", "altered source path", "Sets the compiler's output path"},
 		{"LLVM", executableName + " --llvm", "disabled (default)", "Output a LLVM Module (.ll) file instead of an executable"},
 		{"Optimize", executableName + " -O", "disabled (default)", "Compile the executable with -O2 compiler optimizations enabled"},
 		{"Package", executableName + " --package", "none (default)", "Compile as a package with the given name"},
 		{"Debug", executableName + " -xx", "disabled (default)", "Shows brief process information in the command line and enable verbose ARC"},
-		{"Look up", executableName + " -lookup", "no code (default)", "Shows further detail about errors you may have encountered"},
+		{"Look up", executableName + " -lookup", "no code (default)", "Shows further detail about errors you may have encountered (\"all\" lists every code)"},
+		{"Export look up", executableName + " -lookup-export", "none (default)", "Writes every error code's details as markdown or json (to -o or stdout)"},
+		{"Diagnostics", executableName + " -diagnostics", "text (default)", "Reports errors and warnings as a json or sarif document instead"},
+		{"Diagnostics file", executableName + " -diagnostics-out", "stdout (default)", "Writes the json or sarif document to the given file"},
+		{"Warnings as errors", executableName + " -Werror", "disabled (default)", "Treats all warnings as errors (the build fails if there are any)"},
+		{"No warnings", executableName + " -nowarn", "none (default)", "Turns off the given warning codes (comma separated, e.g. 3058,4053)"},
 	}
 
 	p0, p1, p2, p3 := findPaddings(helpSegments)
@@ -520,6 +735,42 @@ func Help() {
 	print.WriteCF(print.DarkBlue, "%s!\n", discordInvite) // Moved so link is now blue
 }
 
+// LookUpError shows the details of an error code (or lists all of them)
+func LookUpError() {
+	if lookup == "all" {
+		print.LookUpAll()
+		return
+	}
+
+	code, err := strconv.Atoi(lookup)
+	if err != nil {
+		print.PrintC(print.Red, fmt.Sprintf("\"%s\" is not an error code! (use: %s -lookup all, to see all of them)", lookup, executableName))
+		os.Exit(1)
+	}
+
+	print.LookUp(print.ErrorCode(code))
+}
+
+// ExportErrorCatalogue writes the whole error catalogue to -o (or stdout), for publishing it with the docs
+func ExportErrorCatalogue() {
+	output, err := print.ExportCatalogue(lookupExport)
+	if err != nil {
+		print.PrintC(print.Red, err.Error())
+		os.Exit(1)
+	}
+
+	if outputPath == "" {
+		fmt.Print(output)
+		return
+	}
+
+	err = os.WriteFile(outputPath, []byte(output), 0644)
+	if err != nil {
+		print.PrintC(print.Red, fmt.Sprintf("Could not write the error catalogue to '%s': %s", outputPath, err.Error()))
+		os.Exit(1)
+	}
+}
+
 // Version Shows the current compiler version
 func Version() {
 	fmt.Println("ReCT Go Compiler")
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/enum"
 	"github.com/llir/llvm/ir/types"
 )
 
@@ -61,12 +62,21 @@ func (emt *Emitter) EmitCLibReferences() {
 
 	gc_realloc := emt.Module.NewFunc("GC_realloc", types.I8Ptr, ir.NewParam("ptr", types.I8Ptr), ir.NewParam("len", types.I32))
 	emt.CFuncs["gc_realloc"] = gc_realloc
+
+	setjmp := emt.Module.NewFunc("_setjmp", types.I32, ir.NewParam("env", types.I8Ptr))
+	setjmp.FuncAttrs = append(setjmp.FuncAttrs, enum.FuncAttrReturnsTwice)
+	emt.CFuncs["setjmp"] = setjmp
 }
 
 func (emt *Emitter) EmitClassAndArcReferences(module *ir.Module) {
 	// load module
 	emt.LoadAndReferenceClasses(module)
 
+	// the types try blocks need for their handlers
+	emt.ImportType(FindType(module, "struct.__sigset_t"))
+	emt.ImportType(FindType(module, "struct.__jmp_buf_tag"))
+	emt.ImportType(FindType(module, "struct.exc_Handler"))
+
 	// reference exc functions
 	excFuncs := irtools.FindFunctionsWithPrefix(module, "exc_")
 
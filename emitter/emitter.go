@@ -8,6 +8,7 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
 	"strings"
 
 	"github.com/llir/llvm/ir"
@@ -29,6 +30,9 @@ type Emitter struct {
 	// referenced classes
 	Classes map[string]*Class
 
+	// referenced interfaces
+	Interfaces map[string]*Interface
+
 	// referenced structs
 	Structs map[string]*Struct
 
@@ -40,6 +44,7 @@ type Emitter struct {
 	Functions        map[string]Function
 	FunctionWrappers map[string]*ir.Func
 	Lambdas          map[string]*ir.Func
+	ActionConstants  map[string]*ir.Global
 	FunctionLocals   map[string]map[string]Local
 	StrConstants     map[string]value.Value
 	StrNameCounter   int
@@ -55,6 +60,12 @@ type Emitter struct {
 	Temps       []string
 	Labels      map[string]*ir.Block
 
+	// the object we're in (class functions get it as their first parameter, lambdas from their environment)
+	This value.Value
+
+	// if this function has any try blocks, locals need to survive a longjmp
+	HasExceptionHandlers bool
+
 	// flags
 	IsInClass bool
 }
@@ -77,9 +88,11 @@ func Emit(program binder.BoundProgram, useFingerprints bool) *ir.Module {
 		FunctionLocals:   make(map[string]map[string]Local),
 		StrConstants:     make(map[string]value.Value),
 		Classes:          make(map[string]*Class),
+		Interfaces:       make(map[string]*Interface),
 		Structs:          make(map[string]*Struct),
 		FunctionWrappers: make(map[string]*ir.Func),
 		Lambdas:          make(map[string]*ir.Func),
+		ActionConstants:  make(map[string]*ir.Global),
 		Temps:            make([]string, 0),
 		Packages:         make(map[string]*Package),
 	}
@@ -100,6 +113,11 @@ func Emit(program binder.BoundProgram, useFingerprints bool) *ir.Module {
 		emitter.EmitStruct(stc)
 	}
 
+	// declare all interfaces
+	for i, iface := range emitter.Program.Interfaces {
+		emitter.EmitInterface(iface, i)
+	}
+
 	// declare all class structs
 	for _, cls := range emitter.Program.Classes {
 		emitter.EmitClass(cls)
@@ -125,6 +143,9 @@ func Emit(program binder.BoundProgram, useFingerprints bool) *ir.Module {
 				}
 			}
 		}
+
+		// now that all functions exist, fill in the vTable
+		emitter.PopulateClassVTable(emitter.Classes[emitter.Id(cls.Symbol.Type)], cls)
 	}
 
 	// declare all function names
@@ -174,21 +195,36 @@ func Emit(program binder.BoundProgram, useFingerprints bool) *ir.Module {
 			emitter.IsInClass = true
 
 			// find out if this is the constructor
+			function := emitter.Classes[emitter.Id(cls.Symbol.Type)].Functions[emitter.Id(fnc.Symbol)]
 			if fnc.Symbol.Name == "Constructor" {
 				// if it is, hand it the already prepared constructor function
-				emitter.EmitBlockStatement(fnc.Symbol, emitter.Classes[emitter.Id(cls.Symbol.Type)].Constructor, fnc.Body)
-
-			} else {
-				// if not, emit the function like normal
-				emitter.EmitBlockStatement(fnc.Symbol, emitter.Classes[emitter.Id(cls.Symbol.Type)].Functions[emitter.Id(fnc.Symbol)], fnc.Body)
+				function = emitter.Classes[emitter.Id(cls.Symbol.Type)].Constructor
 			}
 
+			emitter.This = function.Params[0]
+			emitter.EmitBlockStatement(fnc.Symbol, function, fnc.Body)
+
 		}
 	}
 
 	return emitter.Module
 }
 
+// <INTERFACES>----------------------------------------------------------------
+func (emt *Emitter) EmitInterface(iface symbols.InterfaceSymbol, index int) {
+	methods := make([]string, 0)
+	for _, fnc := range iface.Functions {
+		methods = append(methods, emt.Id(fnc))
+	}
+
+	emt.Interfaces[emt.Id(iface.Type)] = &Interface{
+		Name:    iface.Name,
+		Methods: methods,
+		Index:   index,
+		Symbol:  iface,
+	}
+}
+
 // <STRUCTS>-------------------------------------------------------------------
 func (emt *Emitter) EmitStruct(stc symbols.StructSymbol) {
 	// create the class object to keep track of things
@@ -231,6 +267,17 @@ func (emt *Emitter) EmitClass(cls binder.BoundClass) {
 		Type: &types.StructType{},
 	}
 
+	// link up the base class (bases always get emitted before their children)
+	if cls.Symbol.Parent != nil {
+		parent := emt.Classes[emt.Id(cls.Symbol.Parent.Type)]
+		emt.Classes[emt.Id(cls.Symbol.Type)].Parent = parent
+
+		// all classes up the chain now need to dispatch their calls through the vTable
+		for ; parent != nil; parent = parent.Parent {
+			parent.Virtual = true
+		}
+	}
+
 	// if this is a package
 	if CompileAsPackage {
 		// create a constant which holds all the field names
@@ -245,14 +292,53 @@ func (emt *Emitter) EmitClass(cls binder.BoundClass) {
 }
 
 func (emt *Emitter) PopulateClass(cls *Class, bcls binder.BoundClass) {
+	// =====================================================================
 	// create the class' vTable type
-	//clsvTable := emt.Module.NewTypeDef("struct."+bcls.Symbol.Name+"_vTable",
-	//	types.NewStruct(
-	//		types.NewPointer(emt.Classes[emt.Id(builtins.Any)].vTable),
-	//		types.I8Ptr,
-	//		types.NewPointer(types.NewFunc(types.Void, types.I8Ptr)),
-	//	),
-	//)
+	// ---------------------------------------------------------------------
+	// vTable format:
+	// --------------
+	// [0] parent vTable (NULL if there is no base class)
+	// [1] class name
+	// [2] fingerprint (always NULL, the instances have the real one)
+	// [3] list of iTables, one per interface (NULL if nothing is implemented)
+	// ... one i8* per method, base methods keep their slots
+	// =====================================================================
+
+	methods := make([]string, 0)
+	if cls.Parent != nil {
+		methods = append(methods, cls.Parent.Methods...)
+	}
+
+	// any new methods get appended at the end
+	newMethods := make([]string, 0)
+	for _, fnc := range bcls.Symbol.Functions {
+		if fnc.BuiltIn || fnc.Name == "Constructor" || fnc.Name == "Die" {
+			continue
+		}
+
+		overrides := false
+		for _, method := range methods {
+			if method == emt.Id(fnc) {
+				overrides = true
+				break
+			}
+		}
+
+		if !overrides {
+			newMethods = append(newMethods, emt.Id(fnc))
+		}
+	}
+
+	sort.Strings(newMethods)
+	methods = append(methods, newMethods...)
+
+	clsvTableFields := []types.Type{types.I8Ptr, types.I8Ptr, types.I8Ptr, types.I8Ptr}
+	for range methods {
+		clsvTableFields = append(clsvTableFields, types.I8Ptr)
+	}
+
+	clsvTable := types.NewStruct(clsvTableFields...)
+	emt.Module.NewTypeDef("struct."+bcls.Symbol.Name+"_vTable", clsvTable)
 
 	// =====================================================================
 	// create the llvm type
@@ -283,14 +369,8 @@ func (emt *Emitter) PopulateClass(cls *Class, bcls binder.BoundClass) {
 	emt.Module.NewTypeDef("struct.class_"+bcls.Symbol.Name, cls.Type)
 
 	// create the vTable constant
-	vtc := constant.NewStruct(
-		emt.Classes[emt.Id(builtins.Any)].vTable.(*types.StructType), //clsvtable
-
-		constant.NewNull(types.I8Ptr),
-		emt.GetConstantStringConstant(bcls.Symbol.Name),
-		constant.NewNull(types.I8Ptr),
-	)
-	clsvConstant := emt.Module.NewGlobalDef(bcls.Symbol.Name+"_vTable_Const", vtc)
+	// (it only gets filled in once all the class' functions have been declared)
+	clsvConstant := emt.Module.NewGlobal(bcls.Symbol.Name+"_vTable_Const", clsvTable)
 
 	// ---------------------------------------------------------------------
 	// create the constructor
@@ -305,6 +385,74 @@ func (emt *Emitter) PopulateClass(cls *Class, bcls binder.BoundClass) {
 
 	emt.Classes[emt.Id(bcls.Symbol.Type)].Functions = make(map[string]*ir.Func)
 	emt.Classes[emt.Id(bcls.Symbol.Type)].Fields = clsFieldMap
+
+	emt.Classes[emt.Id(bcls.Symbol.Type)].MethodTable = clsvTable
+	emt.Classes[emt.Id(bcls.Symbol.Type)].Methods = methods
+}
+
+func (emt *Emitter) PopulateClassVTable(cls *Class, bcls binder.BoundClass) {
+	var parentvTable constant.Constant = constant.NewNull(types.I8Ptr)
+
+	if cls.Parent != nil {
+		parentvTable = constant.NewBitCast(cls.Parent.vConstant, types.I8Ptr)
+
+		// anything not overridden is taken straight from the base
+		for name, fnc := range cls.Parent.Functions {
+			if _, ok := cls.Functions[name]; !ok {
+				cls.Functions[name] = fnc
+			}
+		}
+	}
+
+	fields := []constant.Constant{
+		parentvTable,
+		emt.GetConstantStringConstant(bcls.Symbol.Name),
+		constant.NewNull(types.I8Ptr),
+		emt.EmitClassITables(cls, bcls),
+	}
+
+	for _, method := range cls.Methods {
+		fields = append(fields, constant.NewBitCast(cls.Functions[method], types.I8Ptr))
+	}
+
+	cls.vConstant.Init = constant.NewStruct(cls.MethodTable, fields...)
+}
+
+func (emt *Emitter) EmitClassITables(cls *Class, bcls binder.BoundClass) constant.Constant {
+	// =====================================================================
+	// iTable format:
+	// --------------
+	// one i8* per interface function, in the order they were declared in
+	// the class' vTable points to a list of these, indexed by interface
+	// =====================================================================
+
+	iTables := make([]constant.Constant, len(emt.Program.Interfaces))
+	implementsAny := false
+
+	for i, iface := range emt.Program.Interfaces {
+		iTables[i] = constant.NewNull(types.I8Ptr)
+
+		if !bcls.Symbol.Implements(iface) {
+			continue
+		}
+
+		implementsAny = true
+
+		methods := make([]constant.Constant, 0)
+		for _, method := range emt.Interfaces[emt.Id(iface.Type)].Methods {
+			methods = append(methods, constant.NewBitCast(cls.Functions[method], types.I8Ptr))
+		}
+
+		iTable := emt.Module.NewGlobalDef(bcls.Symbol.Name+"_"+iface.Name+"_iTable_Const", constant.NewArray(types.NewArray(uint64(len(methods)), types.I8Ptr), methods...))
+		iTables[i] = constant.NewBitCast(iTable, types.I8Ptr)
+	}
+
+	if !implementsAny {
+		return constant.NewNull(types.I8Ptr)
+	}
+
+	iTableList := emt.Module.NewGlobalDef(bcls.Symbol.Name+"_iTables_Const", constant.NewArray(types.NewArray(uint64(len(iTables)), types.I8Ptr), iTables...))
+	return constant.NewBitCast(iTableList, types.I8Ptr)
 }
 
 func (emt *Emitter) EmitClassConstructor(cls *Class, bcls binder.BoundClass, clsvConstant value.Value, clsFieldMap map[string]int) *ir.Func {
@@ -372,14 +520,13 @@ func (emt *Emitter) EmitClassConstructor(cls *Class, bcls binder.BoundClass, cls
 			varName := emt.Id(param)
 
 			// create local variable
-			local := croot.NewAlloca(emt.IRTypes(param.VarType()))
-			local.SetName("L" + varName)
+			local := emt.EmitLocalStorage(croot, param, "L"+varName, false)
 
 			// store the parameters value
-			croot.NewStore(constructor.Params[param.Ordinal+1], local)
+			croot.NewStore(constructor.Params[param.Ordinal+1], local.IRLocal)
 
 			// save it for referencing later
-			locals[varName] = Local{IRLocal: local, IRBlock: croot, Type: param.VarType()}
+			locals[varName] = local
 
 		}
 
@@ -395,11 +542,10 @@ func (emt *Emitter) EmitClassConstructor(cls *Class, bcls binder.BoundClass, cls
 				varName := emt.Id(declStatement.Variable)
 
 				// create local variable
-				local := croot.NewAlloca(emt.IRTypes(declStatement.Variable.VarType()))
-				local.SetName(varName)
+				local := emt.EmitLocalStorage(croot, declStatement.Variable, varName, false)
 
 				// save it for referencing later
-				locals[varName] = Local{IRLocal: local, IRBlock: croot, Type: declStatement.Variable.VarType()}
+				locals[varName] = local
 			}
 		}
 
@@ -416,7 +562,7 @@ func (emt *Emitter) EmitClassConstructor(cls *Class, bcls binder.BoundClass, cls
 // </CLASSES>------------------------------------------------------------------
 // <FUNCTIONS>-----------------------------------------------------------------
 
-func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.BoundBlockStatementNode) *ir.Func {
+func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.BoundBlockStatementNode, extraParams ...*ir.Param) *ir.Func {
 	// figure out all parameters and their types
 	params := make([]*ir.Param, 0)
 	for _, param := range sym.Parameters {
@@ -427,6 +573,9 @@ func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.Bou
 		params = append(params, ir.NewParam(paramName, emt.IRTypes(param.Type)))
 	}
 
+	// anything that doesnt come from the symbol (like a lambda's environment) goes last
+	params = append(params, extraParams...)
+
 	// figure out the return type
 	returnType := emt.IRTypes(sym.Type)
 
@@ -440,6 +589,12 @@ func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.Bou
 	// create a root block
 	root := function.NewBlock("")
 
+	// if this is the main function (entry point)
+	if irName == "main" {
+		// gc_init! (this needs to happen before any captured variables get their heap cells)
+		root.NewCall(emt.CFuncs["gc_init"])
+	}
+
 	// create locals array
 	locals := make(map[string]Local)
 
@@ -449,18 +604,13 @@ func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.Bou
 		varName := emt.Id(param)
 
 		// create local variable
-		local := root.NewAlloca(emt.IRTypes(param.VarType()))
-		local.SetName("L" + varName)
-
-		if param.VarType().IsUserDefined && !param.VarType().IsObject {
-			local.Align = 1
-		}
+		local := emt.EmitLocalStorage(root, param, "L"+varName, true)
 
 		// store the parameters value
-		root.NewStore(function.Params[param.Ordinal], local)
+		root.NewStore(function.Params[param.Ordinal], local.IRLocal)
 
 		// save it for referencing later
-		locals[varName] = Local{IRLocal: local, IRBlock: root, Type: param.VarType()}
+		locals[varName] = local
 
 	}
 
@@ -476,15 +626,10 @@ func (emt *Emitter) EmitFunction(sym symbols.FunctionSymbol, body boundnodes.Bou
 			varName := emt.Id(declStatement.Variable)
 
 			// create local variable
-			local := root.NewAlloca(emt.IRTypes(declStatement.Variable.VarType()))
-			local.SetName(varName)
-
-			if declStatement.Variable.VarType().IsUserDefined && !declStatement.Variable.VarType().IsObject {
-				local.Align = 1
-			}
+			local := emt.EmitLocalStorage(root, declStatement.Variable, varName, true)
 
 			// save it for referencing later
-			locals[varName] = Local{IRLocal: local, IRBlock: root, Type: declStatement.Variable.VarType()}
+			locals[varName] = local
 		}
 	}
 
@@ -616,14 +761,13 @@ func (emt *Emitter) EmitClassFunction(cls symbols.ClassSymbol, sym symbols.Funct
 		varName := emt.Id(param)
 
 		// create local variable
-		local := root.NewAlloca(emt.IRTypes(param.VarType()))
-		local.SetName("L" + varName)
+		local := emt.EmitLocalStorage(root, param, "L"+varName, false)
 
 		// store the parameters value
-		root.NewStore(function.Params[param.Ordinal+1], local)
+		root.NewStore(function.Params[param.Ordinal+1], local.IRLocal)
 
 		// save it for referencing later
-		locals[varName] = Local{IRLocal: local, IRBlock: root, Type: param.VarType()}
+		locals[varName] = local
 
 	}
 
@@ -639,11 +783,10 @@ func (emt *Emitter) EmitClassFunction(cls symbols.ClassSymbol, sym symbols.Funct
 			varName := emt.Id(declStatement.Variable)
 
 			// create local variable
-			local := root.NewAlloca(emt.IRTypes(declStatement.Variable.VarType()))
-			local.SetName(varName)
+			local := emt.EmitLocalStorage(root, declStatement.Variable, varName, false)
 
 			// save it for referencing later
-			locals[varName] = Local{IRLocal: local, IRBlock: root, Type: declStatement.Variable.VarType()}
+			locals[varName] = local
 		}
 	}
 
@@ -674,11 +817,7 @@ func (emt *Emitter) EmitBlockStatement(sym symbols.FunctionSymbol, fnc *ir.Func,
 	currentBlock.NewBr(semiroot)
 	currentBlock = semiroot
 
-	// if this is the main function (entry point)
-	if sym.Name == "main" {
-		// gc_init!
-		currentBlock.NewCall(emt.CFuncs["gc_init"])
-	}
+	emt.HasExceptionHandlers = false
 
 	// go through the body and register all label blocks
 	for _, stmt := range body.Statements {
@@ -687,6 +826,10 @@ func (emt *Emitter) EmitBlockStatement(sym symbols.FunctionSymbol, fnc *ir.Func,
 			labelStatement := stmt.(boundnodes.BoundLabelStatementNode)
 			emt.Labels[string(labelStatement.Label)] = fnc.NewBlock(string(labelStatement.Label))
 		}
+
+		if stmt.NodeType() == boundnodes.BoundTryStartStatement {
+			emt.HasExceptionHandlers = true
+		}
 	}
 
 	skipToNextBlock := false
@@ -722,9 +865,28 @@ func (emt *Emitter) EmitBlockStatement(sym symbols.FunctionSymbol, fnc *ir.Func,
 				emt.EmitReturnStatement(&currentBlock, stmt.(boundnodes.BoundReturnStatementNode))
 				// skip forward until we either hit a new block or the end of the function
 				skipToNextBlock = true
+
+			case boundnodes.BoundTryStartStatement:
+				emt.EmitTryStartStatement(&currentBlock, stmt.(boundnodes.BoundTryStartStatementNode))
+				skipToNextBlock = true
+
+			case boundnodes.BoundTryEndStatement:
+				emt.EmitTryEndStatement(&currentBlock, stmt.(boundnodes.BoundTryEndStatementNode))
+
+			case boundnodes.BoundThrowStatement:
+				emt.EmitThrowStatement(&currentBlock, stmt.(boundnodes.BoundThrowStatementNode))
+				skipToNextBlock = true
 			}
 		}
 	}
+
+	// any block that's still open can't be reached by falling through
+	// (for example the end of an if where both branches return)
+	for _, block := range fnc.Blocks {
+		if block.Term == nil {
+			block.NewUnreachable()
+		}
+	}
 }
 
 // </FUNCTIONS>----------------------------------------------------------------
@@ -766,8 +928,13 @@ func (emt *Emitter) EmitVariableDeclarationStatement(blk **ir.Block, stmt boundn
 		local.IsSet = true
 		emt.Locals[varName] = local
 
+		// captured locals get a new cell every time they're declared
+		// (otherwise every loop iteration would share the same one)
+		emt.EmitNewCell(blk, local)
+
 		// emit its assignemnt
-		(*blk).NewStore(expression, local.IRLocal)
+		store := (*blk).NewStore(expression, emt.LocalPtr(blk, varName))
+		store.Volatile = emt.HasExceptionHandlers
 	}
 }
 
@@ -803,6 +970,39 @@ func (emt *Emitter) EmitReturnStatement(blk **ir.Block, stmt boundnodes.BoundRet
 	}
 }
 
+func (emt *Emitter) EmitTryStartStatement(blk **ir.Block, stmt boundnodes.BoundTryStartStatementNode) {
+	// every try block gets its own handler, stored in the root block so it doesnt pile up in loops
+	handlerType := emt.ExcFuncs["PushHandler"].Params[0].Type().(*types.PointerType).ElemType
+	handler := emt.Function.Blocks[0].NewAlloca(handlerType)
+
+	// make it the innermost handler
+	(*blk).NewCall(emt.ExcFuncs["PushHandler"], handler)
+
+	// remember where we are
+	// if anything gets thrown we'll come back here with a non-zero result
+	buffer := (*blk).NewGetElementPtr(handlerType, handler, CI32(0), CI32(1))
+	result := (*blk).NewCall(emt.CFuncs["setjmp"], (*blk).NewBitCast(buffer, types.I8Ptr))
+	thrown := (*blk).NewICmp(enum.IPredNE, result, CI32(0))
+
+	(*blk).NewCondBr(thrown, emt.Labels[string(stmt.CatchLabel)], emt.Labels[string(stmt.BodyLabel)])
+}
+
+func (emt *Emitter) EmitTryEndStatement(blk **ir.Block, stmt boundnodes.BoundTryEndStatementNode) {
+	(*blk).NewCall(emt.ExcFuncs["PopHandler"])
+}
+
+func (emt *Emitter) EmitThrowStatement(blk **ir.Block, stmt boundnodes.BoundThrowStatementNode) {
+	// exceptions are just strings wearing a fancy hat
+	exception := emt.EmitExpression(blk, stmt.Expression)
+	(*blk).NewCall(emt.ExcFuncs["ThrowIfNull"], (*blk).NewBitCast(exception, types.I8Ptr))
+
+	message := (*blk).NewCall(emt.Classes[emt.Id(builtins.String)].Functions["GetBuffer"], exception)
+	(*blk).NewCall(emt.ExcFuncs["Throw"], message)
+
+	// we're not coming back from that
+	(*blk).NewUnreachable()
+}
+
 // </STATEMENTS>---------------------------------------------------------------
 // <EXPRESSIONS>---------------------------------------------------------------
 
@@ -835,6 +1035,9 @@ func (emt *Emitter) EmitExpression(blk **ir.Block, expr boundnodes.BoundExpressi
 	case boundnodes.BoundMakeArrayExpression:
 		val = emt.EmitMakeArrayExpression(blk, expr.(boundnodes.BoundMakeArrayExpressionNode))
 
+	case boundnodes.BoundMakeMapExpression:
+		val = emt.EmitMakeMapExpression(blk, expr.(boundnodes.BoundMakeMapExpressionNode))
+
 	case boundnodes.BoundMakeStructExpression:
 		val = emt.EmitMakeStructExpression(blk, expr.(boundnodes.BoundMakeStructExpressionNode))
 
@@ -852,6 +1055,10 @@ func (emt *Emitter) EmitExpression(blk **ir.Block, expr boundnodes.BoundExpressi
 
 	case boundnodes.BoundTernaryExpression:
 		val = emt.EmitTernaryExpression(blk, expr.(boundnodes.BoundTernaryExpressionNode))
+	case boundnodes.BoundMatchExpression:
+		val = emt.EmitMatchExpression(blk, expr.(boundnodes.BoundMatchExpressionNode))
+	case boundnodes.BoundInterpolatedStringExpression:
+		val = emt.EmitInterpolatedStringExpression(blk, expr.(boundnodes.BoundInterpolatedStringExpressionNode))
 
 	case boundnodes.BoundCallExpression:
 		val = emt.EmitCallExpression(blk, expr.(boundnodes.BoundCallExpressionNode))
@@ -892,6 +1099,9 @@ func (emt *Emitter) EmitExpression(blk **ir.Block, expr boundnodes.BoundExpressi
 	case boundnodes.BoundEnumExpression:
 		val = emt.EmitEnumExpression(blk, expr.(boundnodes.BoundEnumExpressionNode))
 
+	case boundnodes.BoundCaughtExceptionExpression:
+		val = emt.EmitCaughtExceptionExpression(blk, expr.(boundnodes.BoundCaughtExceptionExpressionNode))
+
 	default:
 		fmt.Println("Unimplemented node: " + expr.NodeType())
 		return nil
@@ -986,8 +1196,8 @@ func (emt *Emitter) EmitVariable(blk **ir.Block, variable symbols.VariableSymbol
 
 	if variable.IsGlobal() {
 		// if we're in a class we need to load from the struct instead of a global
-		if emt.IsInClass && !inMain {
-			mePtr := value.Value(emt.Function.Params[0])
+		if emt.This != nil && !inMain {
+			mePtr := emt.This
 
 			ptr := (*blk).NewGetElementPtr(emt.Class.Type, mePtr, CI32(0), CI32(int32(emt.Class.Fields[emt.Id(variable)])))
 
@@ -1013,11 +1223,13 @@ func (emt *Emitter) EmitVariable(blk **ir.Block, variable symbols.VariableSymbol
 	} else {
 		// if what we're accessing is a struct, do not dereference it
 		if variable.VarType().IsUserDefined && !variable.VarType().IsObject {
-			return emt.Locals[varName].IRLocal
+			return emt.LocalPtr(blk, varName)
 		}
 
 		// non-structs
-		return (*blk).NewLoad(emt.IRTypes(emt.Locals[varName].Type), emt.Locals[varName].IRLocal)
+		load := (*blk).NewLoad(emt.IRTypes(emt.Locals[varName].Type), emt.LocalPtr(blk, varName))
+		load.Volatile = emt.HasExceptionHandlers
+		return load
 	}
 }
 
@@ -1037,8 +1249,8 @@ func (emt *Emitter) EmitVariablePtr(blk **ir.Block, variable symbols.VariableSym
 
 	if variable.IsGlobal() {
 		// if we're in a class we need to load from the struct instead of a global
-		if emt.IsInClass && !inMain {
-			mePtr := value.Value(emt.Function.Params[0])
+		if emt.This != nil && !inMain {
+			mePtr := emt.This
 
 			ptr := (*blk).NewGetElementPtr(emt.Class.Type, mePtr, CI32(0), CI32(int32(emt.Class.Fields[emt.Id(variable)])))
 			return ptr
@@ -1048,7 +1260,7 @@ func (emt *Emitter) EmitVariablePtr(blk **ir.Block, variable symbols.VariableSym
 			return emt.Globals[varName].IRGlobal
 		}
 	} else {
-		return emt.Locals[varName].IRLocal
+		return emt.LocalPtr(blk, varName)
 	}
 }
 
@@ -1062,9 +1274,9 @@ func (emt *Emitter) EmitAssignmentExpression(blk **ir.Block, expr boundnodes.Bou
 	}
 
 	if expr.Variable.IsGlobal() {
-		if emt.IsInClass && !expr.InMain {
+		if emt.This != nil && !expr.InMain {
 			// the location we need to store to
-			ptr := (*blk).NewGetElementPtr(emt.Class.Type, emt.Function.Params[0], CI32(0), CI32(int32(emt.Class.Fields[emt.Id(expr.Variable)])))
+			ptr := (*blk).NewGetElementPtr(emt.Class.Type, emt.This, CI32(0), CI32(int32(emt.Class.Fields[emt.Id(expr.Variable)])))
 
 			// assign the value to the structs field
 			(*blk).NewStore(expression, ptr)
@@ -1077,7 +1289,8 @@ func (emt *Emitter) EmitAssignmentExpression(blk **ir.Block, expr boundnodes.Bou
 	} else {
 
 		// assign the value to the local variable
-		(*blk).NewStore(expression, emt.Locals[varName].IRLocal)
+		store := (*blk).NewStore(expression, emt.LocalPtr(blk, varName))
+		store.Volatile = emt.HasExceptionHandlers
 	}
 
 	return expression
@@ -1141,6 +1354,11 @@ func (emt *Emitter) EmitMakeArrayExpression(blk **ir.Block, expr boundnodes.Boun
 	return arrObject
 }
 
+func (emt *Emitter) EmitMakeMapExpression(blk **ir.Block, expr boundnodes.BoundMakeMapExpressionNode) value.Value {
+	// create a new (empty) map object
+	return emt.CreateObject(blk, expr.MapType)
+}
+
 func (emt *Emitter) EmitMakeStructExpression(blk **ir.Block, expr boundnodes.BoundMakeStructExpressionNode) value.Value {
 
 	// create a space to store our values in
@@ -1192,6 +1410,15 @@ func (emt *Emitter) EmitArrayAssignmentExpression(blk **ir.Block, expr boundnode
 	// ----------
 	value := emt.EmitExpression(blk, expr.Value)
 
+	// maps box their key and value and let the runtime do the rest
+	if expr.Base.Type().Name == builtins.Map.Name {
+		key := emt.EmitToAny(blk, index, expr.Index.Type())
+		element := emt.EmitToAny(blk, value, expr.Value.Type())
+
+		(*blk).NewCall(emt.Classes[emt.Id(builtins.Map)].Functions["Set"], base, key, element)
+		return value
+	}
+
 	// if this is a struct we'll have to load it first
 	if expr.Value.Type().IsUserDefined && !expr.Value.Type().IsObject {
 		value = (*blk).NewLoad(emt.IRTypes(expr.Value.Type()), value)
@@ -1242,6 +1469,14 @@ func (emt *Emitter) EmitArrayAccessExpression(blk **ir.Block, expr boundnodes.Bo
 		return emt.EmitPointerAccessExpression(blk, expr, base, index)
 	}
 
+	// maps look up the boxed key and unbox whatever they find
+	if expr.Base.Type().Name == builtins.Map.Name {
+		key := emt.EmitToAny(blk, index, expr.Index.Type())
+		element := (*blk).NewCall(emt.Classes[emt.Id(builtins.Map)].Functions["Get"], base, key)
+
+		return emt.EmitFromAny(blk, element, expr.Type())
+	}
+
 	// bitcast the base into a generic array type
 	if expr.Base.Type().SubTypes[0].IsObject {
 		base = (*blk).NewBitCast(base, types.NewPointer(emt.Classes[emt.Id(builtins.Array)].Type))
@@ -1269,8 +1504,18 @@ func (emt *Emitter) EmitArrayAccessExpression(blk **ir.Block, expr boundnodes.Bo
 			}
 		}
 
+		// maps only have the one class
+		if originalType.Name == builtins.Map.Name {
+			originalType = builtins.Map
+		}
+
 		// bitcast our pointer to its original class
-		value = (*blk).NewBitCast(element, types.NewPointer(emt.Classes[emt.Id(originalType)].Type))
+		// (interfaces dont have a class, they're just objects)
+		if _, ok := emt.Interfaces[emt.Id(originalType)]; ok {
+			value = (*blk).NewBitCast(element, emt.IRTypes(originalType))
+		} else {
+			value = (*blk).NewBitCast(element, types.NewPointer(emt.Classes[emt.Id(originalType)].Type))
+		}
 	} else {
 		// get the elements pointer
 		elementPtr := (*blk).NewCall(emt.Classes[emt.Id(builtins.PArray)].Functions["GetElementPtr"], base, index)
@@ -1812,6 +2057,65 @@ func (emt *Emitter) EmitTernaryExpression(blk **ir.Block, expr boundnodes.BoundT
 	return emt.EmitVariable(blk, expr.Tmp, false)
 }
 
+func (emt *Emitter) EmitMatchExpression(blk **ir.Block, expr boundnodes.BoundMatchExpressionNode) value.Value {
+	// emit our temp variables in the root block of the function
+	// (one for the value we're matching against, one for the result)
+	for _, tmp := range []symbols.LocalVariableSymbol{expr.Variable, expr.Tmp} {
+		varName := emt.Id(tmp)
+		local := emt.Function.Blocks[0].NewAlloca(emt.IRTypes(tmp.VarType()))
+		local.SetName(varName)
+		emt.Locals[varName] = Local{IRLocal: local, IRBlock: (*blk), Type: tmp.VarType()}
+		emt.EmitVariableDeclaration(blk, tmp, true)
+	}
+
+	emt.EmitAssignment(blk, expr.Variable, expr.Expression)
+
+	EndBlock := (*blk).Parent.NewBlock("")
+
+	// go through the cases one by one, the first one that fits gets to assign its value
+	for i, condition := range expr.Conditions {
+		CaseBlock := (*blk).Parent.NewBlock("")
+		NextBlock := (*blk).Parent.NewBlock("")
+
+		cond := emt.EmitExpression(blk, condition)
+		(*blk).NewCondBr(cond, CaseBlock, NextBlock)
+
+		emt.EmitAssignment(&CaseBlock, expr.Tmp, expr.Values[i])
+		CaseBlock.NewBr(EndBlock)
+
+		(*blk) = NextBlock
+	}
+
+	// nothing fit -> use the default
+	emt.EmitAssignment(blk, expr.Tmp, expr.DefaultValue)
+	(*blk).NewBr(EndBlock)
+
+	// set the current working block to the END block
+	(*blk) = EndBlock
+
+	// return the variable as the exressions value
+	return emt.EmitVariable(blk, expr.Tmp, false)
+}
+
+func (emt *Emitter) EmitInterpolatedStringExpression(blk **ir.Block, expr boundnodes.BoundInterpolatedStringExpressionNode) value.Value {
+	// instead of concatenating part by part, all parts get put into a list
+	// and joined together in one go (so the result only gets allocated once)
+	stringType := emt.IRTypes(builtins.String)
+	listType := types.NewArray(uint64(len(expr.Parts)), stringType)
+
+	// the list lives in the root block of the function, so loops don't grow the stack
+	list := emt.Function.Blocks[0].NewAlloca(listType)
+
+	for i, part := range expr.Parts {
+		val := emt.EmitExpression(blk, part)
+		slot := (*blk).NewGetElementPtr(listType, list, CI32(0), CI32(int32(i)))
+		(*blk).NewStore(val, slot)
+	}
+
+	first := (*blk).NewGetElementPtr(listType, list, CI32(0), CI32(0))
+	return (*blk).NewCall(emt.Classes[emt.Id(builtins.String)].Functions["Join"], first, CI32(int32(len(expr.Parts))))
+}
+
 func (emt *Emitter) EmitCallExpression(blk **ir.Block, expr boundnodes.BoundCallExpressionNode) value.Value {
 	arguments := make([]value.Value, 0)
 
@@ -1825,10 +2129,9 @@ func (emt *Emitter) EmitCallExpression(blk **ir.Block, expr boundnodes.BoundCall
 
 	var call *ir.InstCall
 
-	if emt.IsInClass && !expr.InMain && !expr.Function.BuiltIn {
-		// prepend the "$me" parameter to the given arguments
-		arguments = append([]value.Value{emt.Function.Params[0]}, arguments...)
-		call = (*blk).NewCall(emt.Classes[emt.Id(emt.ClassSym.Type)].Functions[functionName], arguments...)
+	if emt.This != nil && !expr.InMain && !expr.Function.BuiltIn {
+		// call the function on "$me"
+		return emt.EmitClassFunctionCall(blk, emt.Classes[emt.Id(emt.ClassSym.Type)], emt.This, functionName, arguments)
 	} else {
 		call = (*blk).NewCall(emt.Functions[functionName].IRFunction, arguments...)
 	}
@@ -1872,6 +2175,10 @@ func (emt *Emitter) EmitTypeCallExpression(blk **ir.Block, expr boundnodes.Bound
 		// call the get length function on the string
 		val = (*blk).NewCall(emt.Classes[emt.Id(builtins.String)].Functions["GetBuffer"], base)
 
+	case builtins.GetMessage.Fingerprint():
+		// the exception already is its message
+		val = base
+
 	case builtins.Substring.Fingerprint():
 		start := emt.EmitExpression(blk, expr.Arguments[0])
 		length := emt.EmitExpression(blk, expr.Arguments[1])
@@ -1922,6 +2229,9 @@ func (emt *Emitter) EmitTypeCallExpression(blk **ir.Block, expr boundnodes.Bound
 		// no need for a return value, this is a void
 		val = nil
 
+	case builtins.MapGetLength.Fingerprint():
+		val = (*blk).NewCall(emt.Classes[emt.Id(builtins.Map)].Functions["GetLength"], base)
+
 	case builtins.Kill.Fingerprint():
 		val = (*blk).NewCall(emt.Classes[emt.Id(builtins.Thread)].Functions["Kill"], base)
 
@@ -1933,7 +2243,23 @@ func (emt *Emitter) EmitTypeCallExpression(blk **ir.Block, expr boundnodes.Bound
 	default:
 		// the funky ones:
 		// (these cant be identified by their fingerprint because its generated procedurally)
-		if expr.Function.Name == builtins.Run.Name {
+		if expr.Function.OriginType.Fingerprint() == builtins.Map.Fingerprint() {
+			if expr.Function.Name == builtins.Keys.Name {
+				val = emt.EmitMapKeys(blk, base, expr.Base.Type())
+				break
+			}
+
+			// Has() and Remove() both just take a boxed key
+			arg := emt.EmitExpression(blk, expr.Arguments[0])
+			key := emt.EmitToAny(blk, arg, expr.Arguments[0].Type())
+
+			val = (*blk).NewCall(emt.Classes[emt.Id(builtins.Map)].Functions[expr.Function.Name], base, key)
+			if expr.Function.Name == builtins.Remove.Name {
+				val = nil
+			}
+
+			break
+		} else if expr.Function.Name == builtins.Run.Name {
 			arguments := make([]value.Value, 0)
 
 			// emit some quirky params
@@ -1944,8 +2270,7 @@ func (emt *Emitter) EmitTypeCallExpression(blk **ir.Block, expr boundnodes.Bound
 			}
 
 			// call the function
-			//fnc := (*blk).NewLoad(emt.IRTypes(expr.Base.Type()), base)
-			val = (*blk).NewCall(base, arguments...)
+			val = emt.EmitActionCall(blk, base, expr.Base.Type(), arguments)
 
 			break
 		} else if expr.Function.Name == builtins.RunThread.Name {
@@ -2007,14 +2332,94 @@ func (emt *Emitter) EmitClassCallExpression(blk **ir.Block, expr boundnodes.Boun
 
 	// emit all arguments
 	args := make([]value.Value, 0)
-	args = append(args, base)
 	for _, arg := range expr.Arguments {
 		args = append(args, emt.EmitExpression(blk, arg))
 	}
 
-	call := (*blk).NewCall(emt.Classes[emt.Id(expr.Base.Type())].Functions[emt.Id(expr.Function)], args...)
+	// calls through an interface need to find out what they're actually calling first
+	iface, ok := emt.Interfaces[emt.Id(expr.Base.Type())]
+	if ok {
+		return emt.EmitInterfaceFunctionCall(blk, iface, base, expr.Function, args)
+	}
 
-	return call
+	cls := emt.Classes[emt.Id(expr.Base.Type())]
+
+	// calls to a base class' constructor
+	if expr.Function.Name == "Constructor" {
+		args = append([]value.Value{(*blk).NewBitCast(base, cls.Constructor.Params[0].Typ)}, args...)
+		return (*blk).NewCall(cls.Constructor, args...)
+	}
+
+	return emt.EmitClassFunctionCall(blk, cls, base, emt.Id(expr.Function), args)
+}
+
+func (emt *Emitter) EmitClassFunctionCall(blk **ir.Block, cls *Class, me value.Value, functionName string, args []value.Value) value.Value {
+	function := cls.Functions[functionName]
+	callee := value.Value(function)
+
+	// if this class is inherited from, the function might have been overridden
+	// -> look it up in the object's class vTable
+	if cls.Virtual {
+		for slot, method := range cls.Methods {
+			if method != functionName {
+				continue
+			}
+
+			// instances of local classes have their class' vTable as the parent
+			clsvTablePtr := (*blk).NewGetElementPtr(cls.Type, me, CI32(0), CI32(0), CI32(0))
+			clsvTable := (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, clsvTablePtr), types.NewPointer(cls.MethodTable))
+
+			methodPtr := (*blk).NewGetElementPtr(cls.MethodTable, clsvTable, CI32(0), CI32(int32(slot+4)))
+			callee = (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, methodPtr), types.NewPointer(function.Sig))
+			break
+		}
+	}
+
+	// inherited functions expect an instance of the class they were declared in
+	if !me.Type().Equal(function.Params[0].Typ) {
+		me = (*blk).NewBitCast(me, function.Params[0].Typ)
+	}
+
+	args = append([]value.Value{me}, args...)
+	return (*blk).NewCall(callee, args...)
+}
+
+func (emt *Emitter) EmitInterfaceFunctionCall(blk **ir.Block, iface *Interface, me value.Value, function symbols.FunctionSymbol, args []value.Value) value.Value {
+	slot := 0
+	for i, method := range iface.Methods {
+		if method == emt.Id(function) {
+			slot = i
+		}
+	}
+
+	// objects implementing interfaces are always local classes,
+	// so their vTable parent is their class' vTable
+	anyType := emt.Classes[emt.Id(builtins.Any)].Type
+	clsvTableType := types.NewStruct(types.I8Ptr, types.I8Ptr, types.I8Ptr, types.I8Ptr)
+
+	clsvTablePtr := (*blk).NewGetElementPtr(anyType, me, CI32(0), CI32(0), CI32(0))
+	clsvTable := (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, clsvTablePtr), types.NewPointer(clsvTableType))
+
+	// find this interface's iTable
+	iTablesPtr := (*blk).NewGetElementPtr(clsvTableType, clsvTable, CI32(0), CI32(3))
+	iTables := (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, iTablesPtr), types.NewPointer(types.I8Ptr))
+	iTablePtr := (*blk).NewGetElementPtr(types.I8Ptr, iTables, CI32(int32(iface.Index)))
+	iTable := (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, iTablePtr), types.NewPointer(types.I8Ptr))
+
+	// find the function
+	methodPtr := (*blk).NewGetElementPtr(types.I8Ptr, iTable, CI32(int32(slot)))
+
+	// the actual class function expects its own class as "me", but pointers are pointers
+	params := []types.Type{me.Type()}
+	for _, param := range function.Parameters {
+		params = append(params, emt.IRTypes(param.Type))
+	}
+
+	sig := types.NewFunc(emt.IRTypes(function.Type), params...)
+	callee := (*blk).NewBitCast((*blk).NewLoad(types.I8Ptr, methodPtr), types.NewPointer(sig))
+
+	args = append([]value.Value{me}, args...)
+	return (*blk).NewCall(callee, args...)
 }
 
 func (emt *Emitter) EmitClassFieldAccessExpression(blk **ir.Block, expr boundnodes.BoundClassFieldAccessExpressionNode) value.Value {
@@ -2153,6 +2558,9 @@ func (emt *Emitter) EmitConversionExpression(blk **ir.Block, expr boundnodes.Bou
 		// to string conversion
 	} else if expr.ToType.Fingerprint() == builtins.String.Fingerprint() {
 		switch expr.Expression.Type().Fingerprint() {
+		case builtins.Exception.Fingerprint():
+			// exceptions are strings under the hood
+			return value
 		case builtins.Any.Fingerprint():
 			// make sure this conversion is valid
 			emt.EmitValidConversionCheck(blk, builtins.String, value)
@@ -2465,8 +2873,12 @@ func (emt *Emitter) EmitConversionExpression(blk **ir.Block, expr boundnodes.Bou
 				// change the pointer type
 				return (*blk).NewBitCast(value, emt.IRTypes(expr.ToType))
 			} else {
+				// primitive arrays are created as parrays, so thats what we need to check against
+				typ := expr.ToType
+				typ.Name = builtins.PArray.Name
+
 				// make sure this conversion is valid
-				emt.EmitValidConversionCheck(blk, expr.ToType, value)
+				emt.EmitValidConversionCheck(blk, typ, value)
 
 				// change the pointer type
 				return (*blk).NewBitCast(value, emt.IRTypes(expr.ToType))
@@ -2505,6 +2917,26 @@ func (emt *Emitter) EmitConversionExpression(blk **ir.Block, expr boundnodes.Bou
 		}
 	}
 
+	// objects being put into an interface
+	if iface, ok := emt.Interfaces[emt.Id(expr.ToType)]; ok && expr.Expression.Type().Fingerprint() == builtins.Any.Fingerprint() {
+		// make sure the object actually implements this interface
+		emt.EmitInterfaceConversionCheck(blk, iface, value)
+
+		return (*blk).NewBitCast(value, emt.IRTypes(expr.ToType))
+	}
+
+	// classes inheriting from each other (or implementing interfaces)
+	if expr.ToType.IsObject && expr.ToType.IsUserDefined &&
+		expr.Expression.Type().IsObject && expr.Expression.Type().IsUserDefined {
+
+		// going down the chain has to be checked, going up is always fine
+		if binder.ClassifyConversion(expr.Expression.Type(), expr.ToType).IsExplicit {
+			emt.EmitValidConversionCheck(blk, expr.ToType, value)
+		}
+
+		return (*blk).NewBitCast(value, emt.IRTypes(expr.ToType))
+	}
+
 	// classes
 	if expr.ToType.IsObject && expr.Expression.Type().Fingerprint() == builtins.Any.Fingerprint() {
 		// make sure this conversion is valid
@@ -2534,11 +2966,24 @@ func (emt *Emitter) EmitDereferenceExpression(blk **ir.Block, expr boundnodes.Bo
 
 func (emt *Emitter) EmitLambdaExpression(blk **ir.Block, expr boundnodes.BoundLambdaExpressionNode) value.Value {
 	// is this lambda already defined?
-	lbd, ok := emt.Lambdas[expr.Function.Fingerprint()]
-	if ok {
-		return lbd
+	function, ok := emt.Lambdas[expr.Function.Fingerprint()]
+	if !ok {
+		function = emt.EmitLambdaFunction(expr)
+	}
+
+	// nothing captured -> every instance is the same, so they can all share one action
+	if len(expr.Captures) == 0 && !expr.CapturesThis {
+		return emt.GetActionConstant(function, expr.Type())
 	}
 
+	// otherwise this instance gets its own environment
+	env := emt.EmitLambdaEnvironment(blk, expr)
+
+	// don
+	return emt.CreateAction(blk, function, env, expr.Type())
+}
+
+func (emt *Emitter) EmitLambdaFunction(expr boundnodes.BoundLambdaExpressionNode) *ir.Func {
 	// take a snapshot of the function we're in
 	// TODO(RedCube): Replace this with a stack
 	fnc := emt.Function
@@ -2546,9 +2991,41 @@ func (emt *Emitter) EmitLambdaExpression(blk **ir.Block, expr boundnodes.BoundLa
 	fnclcs := emt.Locals
 	fnctmp := emt.Temps
 	fnclbs := emt.Labels
+	fncinc := emt.IsInClass
+	fncths := emt.This
+	fnceh := emt.HasExceptionHandlers
+
+	// lambdas are a lie (they're just functions that get their environment handed in last)
+	function := emt.EmitFunction(expr.Function, expr.Body, ir.NewParam("env", types.I8Ptr))
+
+	// load our captured variables out of the environment
+	var this value.Value
+	if len(expr.Captures) > 0 || expr.CapturesThis {
+		root := function.Blocks[0]
+		locals := emt.FunctionLocals[emt.Id(expr.Function)]
+
+		envType := emt.LambdaEnvironmentType(expr)
+		env := root.NewBitCast(function.Params[len(function.Params)-1], types.NewPointer(envType))
 
-	// lambdas are a lie
-	function := emt.EmitFunction(expr.Function, expr.Body)
+		for i, captured := range expr.Captures {
+			// each field points to the variable's heap cell, which we just use as our "local"
+			fieldPtr := root.NewGetElementPtr(envType, env, CI32(0), CI32(int32(i)))
+			cell := root.NewLoad(types.NewPointer(emt.IRTypes(captured.VarType())), fieldPtr)
+			cell.SetName("C" + emt.Id(captured))
+
+			locals[emt.Id(captured)] = Local{IRLocal: cell, IRBlock: root, Type: captured.VarType(), IsSet: true}
+		}
+
+		// the object we were created in comes last
+		if expr.CapturesThis {
+			fieldPtr := root.NewGetElementPtr(envType, env, CI32(0), CI32(int32(len(expr.Captures))))
+			this = root.NewLoad(emt.This.Type(), fieldPtr)
+		}
+	}
+
+	// lambdas are never class members, but they can use the object they were created in
+	emt.IsInClass = false
+	emt.This = this
 
 	// emit the body
 	emt.FunctionSym = expr.Function
@@ -2560,25 +3037,153 @@ func (emt *Emitter) EmitLambdaExpression(blk **ir.Block, expr boundnodes.BoundLa
 	emt.Locals = fnclcs
 	emt.Temps = fnctmp
 	emt.Labels = fnclbs
+	emt.IsInClass = fncinc
+	emt.This = fncths
+	emt.HasExceptionHandlers = fnceh
 
 	// store this lambda for later
 	emt.Lambdas[expr.Function.Fingerprint()] = function
 
-	// don
 	return function
 }
 
+// the environment of a lambda is a struct of pointers to all the variables it captured
+// those point at the variables' heap cells, so the lambda and its creator share the same values
+func (emt *Emitter) LambdaEnvironmentType(expr boundnodes.BoundLambdaExpressionNode) types.Type {
+	fields := make([]types.Type, 0)
+	for _, captured := range expr.Captures {
+		fields = append(fields, types.NewPointer(emt.IRTypes(captured.VarType())))
+	}
+
+	if expr.CapturesThis {
+		fields = append(fields, emt.This.Type())
+	}
+
+	return types.NewStruct(fields...)
+}
+
+// every instance of a lambda gets its own environment, which is handed to it every time it's called
+func (emt *Emitter) EmitLambdaEnvironment(blk **ir.Block, expr boundnodes.BoundLambdaExpressionNode) value.Value {
+	envType := emt.LambdaEnvironmentType(expr)
+
+	// sizeof the struct
+	size := (*blk).NewGetElementPtr(envType, constant.NewNull(types.NewPointer(envType)), CI32(1))
+	sizeInt := (*blk).NewPtrToInt(size, types.I32)
+
+	// create space for the environment
+	env := (*blk).NewBitCast((*blk).NewCall(emt.CFuncs["gc_malloc"], sizeInt), types.NewPointer(envType))
+
+	// store references to all the captured variables
+	for i, captured := range expr.Captures {
+		fieldPtr := (*blk).NewGetElementPtr(envType, env, CI32(0), CI32(int32(i)))
+		(*blk).NewStore(emt.LocalPtr(blk, emt.Id(captured)), fieldPtr)
+	}
+
+	// and the object we're in
+	if expr.CapturesThis {
+		fieldPtr := (*blk).NewGetElementPtr(envType, env, CI32(0), CI32(int32(len(expr.Captures))))
+		(*blk).NewStore(emt.This, fieldPtr)
+	}
+
+	return (*blk).NewBitCast(env, types.I8Ptr)
+}
+
+// puts a function and an environment together into an action
+func (emt *Emitter) CreateAction(blk **ir.Block, function value.Value, env value.Value, typ symbols.TypeSymbol) value.Value {
+	actionType := emt.ResolveActionType(typ.SubTypes)
+
+	// sizeof the struct
+	size := (*blk).NewGetElementPtr(actionType, constant.NewNull(types.NewPointer(actionType)), CI32(1))
+	sizeInt := (*blk).NewPtrToInt(size, types.I32)
+
+	// create space for the action
+	action := (*blk).NewBitCast((*blk).NewCall(emt.CFuncs["gc_malloc"], sizeInt), types.NewPointer(actionType))
+
+	(*blk).NewStore(function, (*blk).NewGetElementPtr(actionType, action, CI32(0), CI32(0)))
+	(*blk).NewStore(env, (*blk).NewGetElementPtr(actionType, action, CI32(0), CI32(1)))
+
+	return action
+}
+
+// actions without an environment never change, so each function only needs one of them
+func (emt *Emitter) GetActionConstant(function *ir.Func, typ symbols.TypeSymbol) value.Value {
+	global, ok := emt.ActionConstants[function.Name()]
+	if ok {
+		return global
+	}
+
+	actionType := emt.ResolveActionType(typ.SubTypes).(*types.StructType)
+
+	global = emt.Module.NewGlobalDef(function.Name()+"_Action", constant.NewStruct(actionType, function, constant.NewNull(types.I8Ptr)))
+	global.Immutable = true
+	emt.ActionConstants[function.Name()] = global
+
+	return global
+}
+
+// calls whatever function is in an action, with whatever environment is in it
+func (emt *Emitter) EmitActionCall(blk **ir.Block, action value.Value, typ symbols.TypeSymbol, args []value.Value) value.Value {
+	actionType := emt.ResolveActionType(typ.SubTypes)
+
+	// run a null check on the action
+	(*blk).NewCall(emt.ExcFuncs["ThrowIfNull"], (*blk).NewBitCast(action, types.I8Ptr))
+
+	function := (*blk).NewLoad(types.NewPointer(emt.ResolveFunctionPointer(typ.SubTypes)), (*blk).NewGetElementPtr(actionType, action, CI32(0), CI32(0)))
+	env := (*blk).NewLoad(types.I8Ptr, (*blk).NewGetElementPtr(actionType, action, CI32(0), CI32(1)))
+
+	return (*blk).NewCall(function, append(args, env)...)
+}
+
+// normal functions dont take an environment, so actions call them through one of these
+func (emt *Emitter) GetActionAdapter(function *ir.Func) *ir.Func {
+	name := function.Name() + "_ActionAdapter"
+
+	adapter, ok := emt.FunctionWrappers[name]
+	if ok {
+		return adapter
+	}
+
+	// same parameters, plus the environment we dont need
+	params := make([]*ir.Param, 0)
+	for _, param := range function.Params {
+		params = append(params, ir.NewParam("", param.Typ))
+	}
+	params = append(params, ir.NewParam("env", types.I8Ptr))
+
+	adapter = emt.Module.NewFunc(name, function.Sig.RetType, params...)
+	root := adapter.NewBlock("")
+
+	args := make([]value.Value, 0)
+	for _, param := range adapter.Params[:len(adapter.Params)-1] {
+		args = append(args, param)
+	}
+
+	result := root.NewCall(function, args...)
+	if function.Sig.RetType.Equal(types.Void) {
+		root.NewRet(nil)
+	} else {
+		root.NewRet(result)
+	}
+
+	emt.FunctionWrappers[name] = adapter
+	return adapter
+}
+
 func (emt *Emitter) EmitFunctionExpression(blk **ir.Block, expr boundnodes.BoundFunctionExpressionNode) value.Value {
+	var function *ir.Func
 
 	if expr.InClass.Exists && !expr.Function.BuiltIn {
-		return emt.Classes[emt.Id(emt.ClassSym.Type)].Functions[emt.Id(expr.Function)] // return the lame IR function
+		function = emt.Classes[emt.Id(emt.ClassSym.Type)].Functions[emt.Id(expr.Function)] // the lame IR function
 	} else {
-		return emt.Functions[emt.Id(expr.Function)].IRFunction // return the IR function
+		function = emt.Functions[emt.Id(expr.Function)].IRFunction // the IR function
 	}
+
+	// wrap it up into an action
+	return emt.GetActionConstant(emt.GetActionAdapter(function), expr.Type())
 }
 
 func (emt *Emitter) EmitThisExpression(blk **ir.Block, expr boundnodes.BoundThisExpressionNode) value.Value {
-	return emt.Function.Params[0] // return the reserved parameter
+	return emt.This // the reserved parameter (or whatever our lambda captured)
 }
 
 func (emt *Emitter) EmitEnumExpression(blk **ir.Block, expr boundnodes.BoundEnumExpressionNode) value.Value {
@@ -2588,12 +3193,55 @@ func (emt *Emitter) EmitEnumExpression(blk **ir.Block, expr boundnodes.BoundEnum
 // </EXPRESSIONS>--------------------------------------------------------------
 // <UTILS>---------------------------------------------------------------------
 
+func (emt *Emitter) EmitCaughtExceptionExpression(blk **ir.Block, expr boundnodes.BoundCaughtExceptionExpressionNode) value.Value {
+	// wrap whatever was thrown last into a string object
+	message := (*blk).NewCall(emt.ExcFuncs["GetMessage"])
+	exception := emt.CreateObject(blk, builtins.String)
+	(*blk).NewCall(emt.Classes[emt.Id(builtins.String)].Functions["Load"], exception, message)
+	return exception
+}
+
 func (emt *Emitter) EmitValidConversionCheck(blk **ir.Block, typ symbols.TypeSymbol, val value.Value) {
 	bas := typ
 	bas.SubTypes = make([]symbols.TypeSymbol, 0) // remove subtypes
 	(*blk).NewCall(emt.ExcFuncs["ThrowIfInvalidCast"], (*blk).NewBitCast(val, emt.IRTypes(builtins.Any)), (*blk).NewBitCast(emt.Classes[emt.Id(bas)].vConstant, types.NewPointer(emt.Classes[emt.Id(builtins.Any)].vTable)), emt.GetConstantStringConstant(typ.Fingerprint()))
 }
 
+func (emt *Emitter) EmitInterfaceConversionCheck(blk **ir.Block, iface *Interface, val value.Value) {
+	// the only objects that can implement an interface are instances of local classes
+	// -> the object's vTable parent has to be one of their class vTables
+	clsvTablePtr := (*blk).NewGetElementPtr(emt.Classes[emt.Id(builtins.Any)].Type, val, CI32(0), CI32(0), CI32(0))
+
+	isNull := (*blk).NewICmp(enum.IPredEQ, val, constant.NewNull(val.Type().(*types.PointerType)))
+
+	CheckBlock := (*blk).Parent.NewBlock("")
+	FailBlock := (*blk).Parent.NewBlock("")
+	EndBlock := (*blk).Parent.NewBlock("")
+
+	// null is allowed to be anything, dont even look at its vTable
+	(*blk).NewCondBr(isNull, EndBlock, CheckBlock)
+
+	clsvTable := CheckBlock.NewLoad(types.I8Ptr, clsvTablePtr)
+	var matches value.Value = constant.NewBool(false)
+
+	for _, bcls := range emt.Program.Classes {
+		if !bcls.Symbol.Implements(iface.Symbol) {
+			continue
+		}
+
+		cls := emt.Classes[emt.Id(bcls.Symbol.Type)]
+		isClass := CheckBlock.NewICmp(enum.IPredEQ, clsvTable, constant.NewBitCast(cls.vConstant, types.I8Ptr))
+		matches = CheckBlock.NewOr(matches, isClass)
+	}
+
+	CheckBlock.NewCondBr(matches, EndBlock, FailBlock)
+
+	FailBlock.NewCall(emt.ExcFuncs["Throw"], emt.GetConstantStringConstant("Object could not be casted to interface "+iface.Name+" as it does not implement it!"))
+	FailBlock.NewUnreachable()
+
+	(*blk) = EndBlock
+}
+
 func (emt *Emitter) DefaultConstant(blk **ir.Block, typ symbols.TypeSymbol) constant.Constant {
 	switch typ.Fingerprint() {
 	case builtins.Bool.Fingerprint():
@@ -2610,9 +3258,15 @@ func (emt *Emitter) DefaultConstant(blk **ir.Block, typ symbols.TypeSymbol) cons
 		return constant.NewNull(emt.IRTypes(builtins.String).(*types.PointerType))
 	case builtins.Any.Fingerprint():
 		return constant.NewNull(emt.IRTypes(builtins.Any).(*types.PointerType))
+	case builtins.Exception.Fingerprint():
+		return constant.NewNull(emt.IRTypes(builtins.Exception).(*types.PointerType))
 	}
 
-	if typ.Name == builtins.Array.Name {
+	if typ.IsEnum {
+		return constant.NewInt(types.I32, 0)
+	}
+
+	if typ.Name == builtins.Array.Name || typ.Name == builtins.Map.Name {
 		return constant.NewNull(emt.IRTypes(typ).(*types.PointerType))
 	}
 
@@ -2740,7 +3394,7 @@ func (emt *Emitter) GetThreadWrapper(source symbols.TypeSymbol) *ir.Func {
 		))
 
 	// call!
-	root.NewCall(fnc, arguments...)
+	emt.EmitActionCall(&root, fnc, source, arguments)
 	root.NewRet(constant.NewNull(types.I8Ptr))
 
 	// register the wrapper if we need to use it again later
@@ -2792,9 +3446,17 @@ func (emt *Emitter) CreateObject(blk **ir.Block, src symbols.TypeSymbol, args ..
 func (emt *Emitter) GetVtableConstant(src symbols.TypeSymbol, typ string) value.Value {
 	fields := make([]constant.Constant, 0)
 	template := emt.Classes[typ].vConstant.Init.(*constant.Struct)
+	vTableType := template.Typ
 
 	// parent vTable
-	fields = append(fields, template.Fields[0])
+	if emt.Classes[typ].MethodTable != nil {
+		// local classes use their class' vTable instead, it holds their
+		// methods and links up to the vTables of their base classes
+		fields = append(fields, constant.NewBitCast(emt.Classes[typ].vConstant, types.I8Ptr))
+		vTableType = emt.Classes[emt.Id(builtins.Any)].vTable.(*types.StructType)
+	} else {
+		fields = append(fields, template.Fields[0])
+	}
 
 	// class name
 	fields = append(fields, emt.GetConstantStringConstant(strings.ToUpper(src.Name[:1])+src.Name[1:]))
@@ -2806,7 +3468,7 @@ func (emt *Emitter) GetVtableConstant(src symbols.TypeSymbol, typ string) value.
 	fields = append(fields, emt.GetConstantStringConstant(src.Fingerprint()))
 
 	return constant.NewStruct(
-		template.Typ,
+		vTableType,
 		fields...,
 	)
 }
@@ -2821,6 +3483,83 @@ func (emt *Emitter) SizeOf(blk **ir.Block, typ symbols.TypeSymbol) value.Value {
 	return sizeInt
 }
 
+// converts any value to an object (boxing it if needed)
+func (emt *Emitter) EmitToAny(blk **ir.Block, val value.Value, typ symbols.TypeSymbol) value.Value {
+	// enums are just ints in a trenchcoat
+	if typ.IsEnum {
+		return (*blk).NewBitCast(emt.Box(blk, val, builtins.Int), emt.IRTypes(builtins.Any))
+	}
+
+	return emt.EmitConversionExpression(blk, boundnodes.CreateBoundConversionExpressionNode(
+		builtins.Any,
+		boundnodes.CreateBoundInternalValueExpressionNode(val, typ),
+		nodes.CallExpressionNode{},
+	))
+}
+
+// converts an object back into the type it's supposed to be (unboxing it if needed)
+func (emt *Emitter) EmitFromAny(blk **ir.Block, val value.Value, typ symbols.TypeSymbol) value.Value {
+	if typ.IsEnum {
+		typ = builtins.Int
+	}
+
+	return emt.EmitConversionExpression(blk, boundnodes.CreateBoundConversionExpressionNode(
+		typ,
+		boundnodes.CreateBoundInternalValueExpressionNode(val, builtins.Any),
+		nodes.CallExpressionNode{},
+	))
+}
+
+// collects all keys of a map into a new array
+func (emt *Emitter) EmitMapKeys(blk **ir.Block, base value.Value, mapType symbols.TypeSymbol) value.Value {
+	keyType := mapType.SubTypes[0]
+	arrType := symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{keyType}, true, false, false, symbols.PackageSymbol{}, nil)
+
+	length := (*blk).NewCall(emt.Classes[emt.Id(builtins.Map)].Functions["GetLength"], base)
+
+	// create an array big enough for all keys
+	var arr value.Value
+	if keyType.IsObject {
+		arr = emt.CreateObject(blk, arrType, length)
+	} else {
+		typ := arrType
+		typ.Name = "parray"
+		arr = emt.CreateObject(blk, typ, length, emt.SizeOf(blk, keyType))
+	}
+
+	// loop over every entry
+	// ---------------------
+	LoopBlock := (*blk).Parent.NewBlock("")
+	BodyBlock := (*blk).Parent.NewBlock("")
+	EndBlock := (*blk).Parent.NewBlock("")
+
+	index := ir.NewPhi(ir.NewIncoming(CI32(0), *blk))
+	LoopBlock.Insts = append(LoopBlock.Insts, index)
+	(*blk).NewBr(LoopBlock)
+
+	LoopBlock.NewCondBr(LoopBlock.NewICmp(enum.IPredSLT, index, length), BodyBlock, EndBlock)
+
+	// copy the key over into the array
+	key := BodyBlock.NewCall(emt.Classes[emt.Id(builtins.Map)].Functions["GetKey"], base, index)
+
+	if keyType.IsObject {
+		BodyBlock.NewCall(emt.Classes[emt.Id(builtins.Array)].Functions["SetElement"], arr, index, key)
+	} else {
+		element := emt.EmitFromAny(&BodyBlock, key, keyType)
+		elementPtr := BodyBlock.NewCall(emt.Classes[emt.Id(builtins.PArray)].Functions["GetElementPtr"], arr, index)
+		BodyBlock.NewStore(element, BodyBlock.NewBitCast(elementPtr, types.NewPointer(emt.IRTypes(keyType))))
+	}
+
+	// on to the next one
+	index.Incs = append(index.Incs, ir.NewIncoming(BodyBlock.NewAdd(index, CI32(1)), BodyBlock))
+	BodyBlock.NewBr(LoopBlock)
+
+	(*blk) = EndBlock
+
+	// bitcast to typed array type
+	return (*blk).NewBitCast(arr, emt.IRTypes(arrType))
+}
+
 func (emt *Emitter) Box(blk **ir.Block, val value.Value, typ symbols.TypeSymbol) value.Value {
 	// boxing is the act of "objectifying" primitive types
 	// (like an int or bool)
@@ -2837,6 +3576,75 @@ func (emt *Emitter) Box(blk **ir.Block, val value.Value, typ symbols.TypeSymbol)
 	return obj
 }
 
+// creates the storage for a local variable in the root block of a function
+// captured variables live in a cell on the heap instead, that way lambdas can keep using them after we've returned
+func (emt *Emitter) EmitLocalStorage(root *ir.Block, variable symbols.VariableSymbol, name string, packStructs bool) Local {
+	typ := emt.IRTypes(variable.VarType())
+	local := Local{IRBlock: root, Type: variable.VarType()}
+
+	if emt.Program.CapturedVariables[variable.Fingerprint()] {
+		// parameters only get declared once per call, so they can have their cell right away
+		if variable.SymbolType() == symbols.Parameter {
+			cell := emt.EmitCell(root, typ)
+			cell.SetName(name)
+
+			local.IRLocal = cell
+			return local
+		}
+
+		// anything else just gets a place to keep its current cell in
+		// (the cell itself is created whenever the declaration runs)
+		holder := root.NewAlloca(types.NewPointer(typ))
+		holder.SetName(name)
+		root.NewStore(constant.NewNull(types.NewPointer(typ)), holder)
+
+		local.IRLocal = holder
+		local.InCell = true
+		return local
+	}
+
+	alloca := root.NewAlloca(typ)
+	alloca.SetName(name)
+
+	if packStructs && variable.VarType().IsUserDefined && !variable.VarType().IsObject {
+		alloca.Align = 1
+	}
+
+	local.IRLocal = alloca
+	return local
+}
+
+// allocates a heap cell big enough for one value of the given type
+func (emt *Emitter) EmitCell(blk *ir.Block, typ types.Type) *ir.InstBitCast {
+	size := blk.NewGetElementPtr(typ, constant.NewNull(types.NewPointer(typ)), CI32(1))
+	sizeInt := blk.NewPtrToInt(size, types.I32)
+
+	return blk.NewBitCast(blk.NewCall(emt.CFuncs["gc_malloc"], sizeInt), types.NewPointer(typ))
+}
+
+// gives a captured local a new cell, lambdas created before this keep the old one
+func (emt *Emitter) EmitNewCell(blk **ir.Block, local Local) {
+	if !local.InCell {
+		return
+	}
+
+	store := (*blk).NewStore(emt.EmitCell(*blk, emt.IRTypes(local.Type)), local.IRLocal)
+	store.Volatile = emt.HasExceptionHandlers
+}
+
+// the place a local's value is stored at (for captured locals thats whatever cell they have right now)
+func (emt *Emitter) LocalPtr(blk **ir.Block, varName string) value.Value {
+	local := emt.Locals[varName]
+
+	if !local.InCell {
+		return local.IRLocal
+	}
+
+	load := (*blk).NewLoad(types.NewPointer(emt.IRTypes(local.Type)), local.IRLocal)
+	load.Volatile = emt.HasExceptionHandlers
+	return load
+}
+
 func (emt *Emitter) EmitVariableDeclaration(blk **ir.Block, varibale symbols.LocalVariableSymbol, isTmp bool) {
 	varName := emt.Id(varibale)
 	expression := emt.DefaultConstant(blk, varibale.VarType())
@@ -2845,8 +3653,11 @@ func (emt *Emitter) EmitVariableDeclaration(blk **ir.Block, varibale symbols.Loc
 	local.IsSet = true
 	emt.Locals[varName] = local
 
+	// captured locals get a new cell every time they're declared
+	emt.EmitNewCell(blk, local)
+
 	// emit its assignemnt
-	(*blk).NewStore(expression, local.IRLocal)
+	(*blk).NewStore(expression, emt.LocalPtr(blk, varName))
 
 	// if this is a temp, add it to the list for cleanup
 	if isTmp {
@@ -2868,7 +3679,7 @@ func (emt *Emitter) EmitAssignment(blk **ir.Block, variable symbols.LocalVariabl
 		(*blk).NewStore(expression, emt.Globals[varName].IRGlobal)
 	} else {
 		// assign the value to the local variable
-		(*blk).NewStore(expression, emt.Locals[varName].IRLocal)
+		(*blk).NewStore(expression, emt.LocalPtr(blk, varName))
 	}
 }
 
@@ -40,44 +40,67 @@ func (emt *Emitter) LoadAndReferenceClasses(module *ir.Module) {
 	// reference all classes
 
 	// first load all class names
+	classTypes := make(map[string]types.Type)
+	classNames := make([]string, 0)
+
 	for _, typ := range module.TypeDefs {
 		// if a type name starts with 'struct.class' it's a class
 		if strings.HasPrefix(typ.Name(), "struct.class") {
-			// get the class name
 			className := strings.Split(typ.Name(), "_")[1]
-			typeSymbol := builtins.Error
-
-			// find out what type symbol this is for
-			for _, sym := range builtins.Types {
-				if strings.ToLower(sym.SymbolName()) == strings.ToLower(className) {
-					typeSymbol = sym
-					break
-				}
-			}
+			classTypes[className] = typ
+			classNames = append(classNames, className)
+		}
+	}
 
-			// find and link the vtable
-			// ------------------------
+	// classes that look exactly like another one (like Bool and Byte) get merged into it by clang
+	// so all thats left of them are their functions, which still tell us what type they use
+	for _, fnc := range module.Funcs {
+		if !strings.HasSuffix(fnc.Name(), "_public_Constructor") || len(fnc.Params) == 0 {
+			continue
+		}
 
-			// 1. format the name (removing % prefix and * suffix)
-			vTableType := typ.(*types.StructType).Fields[0].String()
-			vTableType = vTableType[1:]
+		className := strings.Split(fnc.Name(), "_")[0]
+		if _, ok := classTypes[className]; !ok {
+			classTypes[className] = fnc.Params[0].Typ.(*types.PointerType).ElemType
+			classNames = append(classNames, className)
+		}
+	}
 
-			// 2. finding and importing the type
-			vTable := FindType(module, vTableType)
-			emt.ImportType(vTable)
+	for _, className := range classNames {
+		typ := classTypes[className]
 
-			// 3. finding and importing the types vtable constant
-			vConstantName := className + "_vTable_Const"
-			vTableConstant := irtools.FindGlobal(module, vConstantName)
+		typeSymbol := builtins.Error
 
-			if !GlobalExists(emt.Module, vTableConstant.GlobalName) {
-				emt.Module.NewGlobal(vTableConstant.GlobalName, vTable).Linkage = enum.LinkageExternal
+		// find out what type symbol this is for
+		for _, sym := range builtins.Types {
+			if strings.ToLower(sym.SymbolName()) == strings.ToLower(className) {
+				typeSymbol = sym
+				break
 			}
+		}
+
+		// find and link the vtable
+		// ------------------------
+
+		// 1. format the name (removing % prefix and * suffix)
+		vTableType := typ.(*types.StructType).Fields[0].String()
+		vTableType = vTableType[1:]
+
+		// 2. finding and importing the type
+		vTable := FindType(module, vTableType)
+		emt.ImportType(vTable)
+
+		// 3. finding and importing the types vtable constant
+		vConstantName := className + "_vTable_Const"
+		vTableConstant := irtools.FindGlobal(module, vConstantName)
 
-			// create a class object
-			emt.Classes[emt.Id(typeSymbol)] = &Class{Type: typ, vTable: vTable, vConstant: vTableConstant, Constructor: nil, Functions: make(map[string]*ir.Func), Name: className}
-			emt.ImportType(typ)
+		if !GlobalExists(emt.Module, vTableConstant.GlobalName) {
+			emt.Module.NewGlobal(vTableConstant.GlobalName, vTable).Linkage = enum.LinkageExternal
 		}
+
+		// create a class object
+		emt.Classes[emt.Id(typeSymbol)] = &Class{Type: typ, vTable: vTable, vConstant: vTableConstant, Constructor: nil, Functions: make(map[string]*ir.Func), Name: className}
+		emt.ImportType(typ)
 	}
 
 	// then load all class functions
@@ -109,6 +132,9 @@ func (emt *Emitter) LoadAndReferenceClasses(module *ir.Module) {
 	// pointers are a lie.
 	emt.Classes[emt.Id(builtins.Pointer)] = emt.Classes[emt.Id(builtins.Long)]
 	emt.Classes[emt.Id(builtins.Action)] = emt.Classes[emt.Id(builtins.Long)]
+
+	// so are exceptions, they're just strings
+	emt.Classes[emt.Id(builtins.Exception)] = emt.Classes[emt.Id(builtins.String)]
 }
 
 func (emt *Emitter) LoadAndReferenceClassesFromPackage(module *ir.Module, pack symbols.PackageSymbol) {
@@ -58,12 +58,17 @@ func (emt *Emitter) IRTypes(typ symbols.TypeSymbol) types.Type {
 		}
 	}
 
+	// maps all share the same class, no matter what they hold
+	if typ.Name == builtins.Map.Name {
+		return types.NewPointer(emt.Classes[emt.Id(builtins.Map)].Type)
+	}
+
 	if typ.Name == builtins.Pointer.Name {
 		return types.NewPointer(emt.IRTypes(typ.SubTypes[0]))
 	}
 
 	if typ.Name == builtins.Action.Name {
-		return types.NewPointer(emt.ResolveFunctionPointer(typ.SubTypes))
+		return types.NewPointer(emt.ResolveActionType(typ.SubTypes))
 	}
 
 	// try looking up a class
@@ -72,6 +77,12 @@ func (emt *Emitter) IRTypes(typ symbols.TypeSymbol) types.Type {
 		return types.NewPointer(cls.Type)
 	}
 
+	// interfaces can hold any kind of class, so they're just plain objects
+	_, ok = emt.Interfaces[emt.Id(typ)]
+	if ok {
+		return types.NewPointer(emt.Classes[emt.Id(builtins.Any)].Type)
+	}
+
 	// try looking up a struct
 	stc, ok := emt.Structs[emt.Id(typ)]
 	if ok {
@@ -104,13 +115,15 @@ func (emt *Emitter) ResolveArray(typ symbols.TypeSymbol, cache *map[string]types
 	suffix := strings.ToUpper(string(name[0])) + name[1:]
 	if len(typ.SubTypes[0].SubTypes) > 0 {
 		suffix = typ.SubTypes[0].Fingerprint()
-
-		// escape symbols which arent allowed in c
-		suffix = strings.Replace(suffix, "[", "$b$", -1)
-		suffix = strings.Replace(suffix, "]", "$e$", -1)
-		suffix = strings.Replace(suffix, ";", "$s$", -1)
 	}
 
+	// escape symbols which arent allowed in c
+	// (generic class instances have these in their names too, Stack[int])
+	suffix = strings.Replace(suffix, "[", "$b$", -1)
+	suffix = strings.Replace(suffix, "]", "$e$", -1)
+	suffix = strings.Replace(suffix, ";", "$s$", -1)
+	suffix = strings.Replace(suffix, ",", "$c$", -1)
+
 	// figure out the prefix
 	prefix := "class_Array_"
 	if generic.Fingerprint() == builtins.PArray.Fingerprint() {
@@ -140,25 +153,27 @@ func (emt *Emitter) ResolveArray(typ symbols.TypeSymbol, cache *map[string]types
 }
 
 func (emt *Emitter) ResolveFunctionPointer(subTypes []symbols.TypeSymbol) types.Type {
-	fnc := types.NewFunc(emt.IRTypes(subTypes[0])) // ALWAYS needs to be defined
-
-	// no params? ok
-	if len(subTypes) == 1 {
-		return fnc
-	}
+	// the last entry is the return value
+	fnc := types.NewFunc(emt.IRTypes(subTypes[len(subTypes)-1]))
 
 	// add some quirky params
 	for _, symbol := range subTypes[:len(subTypes)-1] {
 		fnc.Params = append(fnc.Params, emt.IRTypes(symbol))
 	}
 
-	// set the return value to the last entry
-	fnc.RetType = emt.IRTypes(subTypes[len(subTypes)-1])
+	// every action gets its environment handed in last
+	fnc.Params = append(fnc.Params, types.I8Ptr)
 
 	// cool beans
 	return fnc
 }
 
+// an action is a pointer to one of these: the function to call and the environment it gets called with
+// (functions that dont capture anything just get a null environment)
+func (emt *Emitter) ResolveActionType(subTypes []symbols.TypeSymbol) types.Type {
+	return types.NewStruct(types.NewPointer(emt.ResolveFunctionPointer(subTypes)), types.I8Ptr)
+}
+
 type Global struct {
 	IRGlobal *ir.Global
 	Type     symbols.TypeSymbol
@@ -169,6 +184,7 @@ type Local struct {
 	IRBlock *ir.Block
 	Type    symbols.TypeSymbol
 	IsSet   bool
+	InCell  bool // IRLocal only holds a pointer to the variable's heap cell (captured locals)
 }
 
 type Function struct {
@@ -184,6 +200,20 @@ type Class struct {
 	Functions   map[string]*ir.Func
 	Fields      map[string]int
 	Name        string
+
+	// local classes only
+	// ------------------
+	Parent      *Class
+	MethodTable *types.StructType // the type of vConstant, holds all virtual methods
+	Methods     []string          // function ids in the order of their vTable slots
+	Virtual     bool              // true if another class inherits from this one
+}
+
+type Interface struct {
+	Name    string
+	Methods []string // function ids in the order of their iTable slots
+	Index   int      // slot of this interface in every class' iTable list
+	Symbol  symbols.InterfaceSymbol
 }
 
 type Struct struct {
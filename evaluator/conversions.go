@@ -0,0 +1,258 @@
+package evaluator
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+func (evl *Evaluator) EvaluateConversionExpression(expr boundnodes.BoundConversionExpressionNode) interface{} {
+	value := evl.EvaluateExpression(expr.Expression)
+	return evl.Convert(value, expr.Expression.Type(), expr.ToType)
+}
+
+// converts a value the same way a compiled program would
+func (evl *Evaluator) Convert(value interface{}, from symbols.TypeSymbol, to symbols.TypeSymbol) interface{} {
+	fromAny := from.Fingerprint() == builtins.Any.Fingerprint()
+
+	// boxing doesnt do anything for us, values already know what they are
+	if to.Fingerprint() == builtins.Any.Fingerprint() {
+		return value
+	}
+
+	switch to.Fingerprint() {
+	case builtins.String.Fingerprint():
+		if fromAny {
+			evl.CheckCast(value, to)
+			return value
+		}
+
+		return evl.FormatValue(value)
+
+	case builtins.Bool.Fingerprint():
+		if from.Fingerprint() == builtins.String.Fingerprint() {
+			return evl.StringValue(value) == "true"
+		}
+
+		if fromAny {
+			evl.CheckCast(value, to)
+		}
+
+		return value
+
+	case builtins.Byte.Fingerprint(), builtins.Int.Fingerprint(), builtins.UInt.Fingerprint(),
+		builtins.Long.Fingerprint(), builtins.ULong.Fingerprint(),
+		builtins.Float.Fingerprint(), builtins.Double.Fingerprint():
+
+		if from.Fingerprint() == builtins.String.Fingerprint() {
+			return evl.ParseNumber(evl.StringValue(value), to)
+		}
+
+		if fromAny {
+			evl.CheckCast(value, to)
+			return value
+		}
+
+		// pointers are just numbers
+		if pointer, ok := value.(Pointer); ok {
+			return ConvertNumber(int64(pointer.Offset), to)
+		}
+
+		return ConvertNumber(value, to)
+	}
+
+	if to.Name == builtins.Array.Name && fromAny {
+		evl.CheckCast(value, to)
+		return value
+	}
+
+	if to.Name == builtins.Pointer.Name {
+		return AsPointer(value)
+	}
+
+	// actions get boxed as longs
+	if to.Name == builtins.Action.Name && fromAny {
+		evl.CheckCast(value, builtins.Long)
+		return value
+	}
+
+	// objects being put into an interface
+	if iface, ok := evl.Interfaces[to.Name]; ok && to.IsUserDefined && fromAny {
+		evl.CheckInterfaceCast(value, iface)
+		return value
+	}
+
+	// classes inheriting from each other (or implementing interfaces)
+	// going down the chain has to be checked, going up is always fine
+	if to.IsObject && to.IsUserDefined && from.IsObject && from.IsUserDefined {
+		if binder.ClassifyConversion(from, to).IsExplicit {
+			evl.CheckCast(value, to)
+		}
+
+		return value
+	}
+
+	// any -> classes, maps, threads
+	if to.IsObject && fromAny {
+		evl.CheckCast(value, to)
+	}
+
+	return value
+}
+
+// <TO STRING> ----------------------------------------------------------------
+
+// turns a value into a string (just like snprintf would)
+func (evl *Evaluator) FormatValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		return value
+	case bool:
+		if value {
+			return "true"
+		}
+		return "false"
+	case byte:
+		return strconv.FormatInt(int64(int8(value)), 10)
+	case int32:
+		return strconv.FormatInt(int64(value), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(value), 10)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case uint64:
+		return strconv.FormatUint(value, 10)
+	case float32:
+		return FormatFloat(float64(value))
+	case float64:
+		return FormatFloat(value)
+	case nil:
+		// null exceptions stay null
+		return nil
+	}
+
+	evl.Die("No Conversion! (cringe) [%T -> string]", value)
+	return nil
+}
+
+// C's %g, go's own version of it looks a little different for special values
+func FormatFloat(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		if math.Signbit(value) {
+			return "-nan"
+		}
+		return "nan"
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	}
+
+	return strconv.FormatFloat(value, 'g', 6, 64)
+}
+
+// </TO STRING> ---------------------------------------------------------------
+// <NUMBERS> ------------------------------------------------------------------
+
+// converts between numeric types, following the same extension and truncation rules as the emitter
+func ConvertNumber(value interface{}, to symbols.TypeSymbol) interface{} {
+	var integer int64
+	var float float64
+	isFloat := false
+
+	switch number := value.(type) {
+	case float32:
+		float, isFloat = float64(number), true
+	case float64:
+		float, isFloat = number, true
+	case int32:
+		integer = int64(number)
+
+		// ints get zero extended into ulongs
+		if to.Fingerprint() == builtins.ULong.Fingerprint() {
+			integer = int64(uint32(number))
+		}
+	default:
+		integer = IntegerValue(value)
+	}
+
+	switch to.Fingerprint() {
+	case builtins.Float.Fingerprint():
+		if isFloat {
+			return float32(float)
+		}
+		return float32(integer)
+
+	case builtins.Double.Fingerprint():
+		if isFloat {
+			return float
+		}
+		return float64(integer)
+	}
+
+	if isFloat {
+		integer = int64(float)
+	}
+
+	switch to.Fingerprint() {
+	case builtins.Byte.Fingerprint():
+		return byte(integer)
+	case builtins.Int.Fingerprint():
+		return int32(integer)
+	case builtins.UInt.Fingerprint():
+		return uint32(integer)
+	case builtins.Long.Fingerprint():
+		return integer
+	case builtins.ULong.Fingerprint():
+		return uint64(integer)
+	}
+
+	return value
+}
+
+// strings get parsed with atoi(), atol() and atof(), which just stop at the first thing they dont understand
+var integerPrefix = regexp.MustCompile(`^[+-]?[0-9]+`)
+var floatPrefix = regexp.MustCompile(`^(?i)[+-]?(([0-9]+\.?[0-9]*|\.[0-9]+)(e[+-]?[0-9]+)?|inf(inity)?|nan)`)
+
+func (evl *Evaluator) ParseNumber(str string, to symbols.TypeSymbol) interface{} {
+	str = strings.TrimLeft(str, " \t\n\v\f\r")
+
+	if to.Fingerprint() == builtins.Float.Fingerprint() || to.Fingerprint() == builtins.Double.Fingerprint() {
+		number, _ := strconv.ParseFloat(floatPrefix.FindString(str), 64)
+		return ConvertNumber(number, to)
+	}
+
+	// numbers that are too big just wrap around
+	var number int64
+	digits := integerPrefix.FindString(str)
+	negative := strings.HasPrefix(digits, "-")
+
+	for _, digit := range strings.TrimLeft(digits, "+-") {
+		number = number*10 + int64(digit-'0')
+	}
+
+	if negative {
+		number = -number
+	}
+
+	return ConvertNumber(number, to)
+}
+
+// </NUMBERS> -----------------------------------------------------------------
+
+// reads a string value, null strings cant be used for anything
+func (evl *Evaluator) StringValue(value interface{}) string {
+	str, ok := value.(string)
+	if !ok {
+		evl.Throw(NullPointerMessage)
+	}
+
+	return str
+}
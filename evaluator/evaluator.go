@@ -3,101 +3,456 @@ package evaluator
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
-	"math"
-	"math/rand"
-	"os"
-	"os/exec"
-	"strconv"
-	"time"
 )
 
 type Evaluator struct {
-	Program   binder.BoundProgram
-	Globals   map[string]interface{}
-	Functions map[string]binder.BoundFunction
-	Locals    []map[string]interface{}
-}
+	Program    binder.BoundProgram
+	Globals    map[string]interface{}
+	Functions  map[string]binder.BoundFunction
+	Classes    map[string]Class
+	Structs    map[string]symbols.StructSymbol
+	Interfaces map[string]symbols.InterfaceSymbol
+
+	// the call stack
+	Frames []Frame
+
+	// label positions of every body we've run so far
+	Labels map[*boundnodes.BoundStatementNode]map[boundnodes.BoundLabel]int
 
-// locals stack helpers
-func (evl *Evaluator) PushLocals() {
-	evl.Locals = append(evl.Locals, make(map[string]interface{}))
+	// whatever was caught last
+	CaughtException string
+
+	// whatever the last expression statement came out as (the repl prints this)
+	LastValue interface{}
+
+	// the thread this evaluator is running on (nil for the main thread)
+	CurrentThread *ThreadRun
+	steps         int
+
+	// where sys reads from and writes to
+	Stdin  *bufio.Reader
+	Stdout io.Writer
+
+	// bindings only this evaluator knows about (these win over the global ones)
+	Natives map[string]map[string]NativeBinding
+
+	// gets told when a thread dies (if its nil the whole program dies with it)
+	OnThreadError func(err *RuntimeError)
+
+	// whose turn it is (shared with all threads this evaluator started)
+	scheduler *scheduler
 }
 
-func (evl *Evaluator) PushTheseLocals(locals map[string]interface{}) {
-	evl.Locals = append(evl.Locals, locals)
+// a class and all of its function bodies
+type Class struct {
+	Symbol      symbols.ClassSymbol
+	Functions   map[string]binder.BoundFunction
+	Constructor binder.BoundFunction
 }
 
-func (evl *Evaluator) PopLocals() {
-	evl.Locals = evl.Locals[:len(evl.Locals)-1]
+// a single function call on the stack
+type Frame struct {
+	Name        string
+	Locals      map[string]interface{}
+	Environment Environment
+	This        *Object
 }
 
-func (evl *Evaluator) GetLocals() map[string]interface{} {
-	return evl.Locals[len(evl.Locals)-1]
+// a thrown exception, travels up the go stack as a panic
+type Exception struct {
+	Message string
 }
 
-func (evl *Evaluator) GetLocal(name string) interface{} {
-	return evl.Locals[len(evl.Locals)-1][name]
+// panicked by Die, unlike exceptions nobody can catch these
+type fatalError struct {
+	Message string
 }
 
-func (evl *Evaluator) SetLocal(fingerprint string, value interface{}) {
-	evl.Locals[len(evl.Locals)-1][fingerprint] = value
+// whatever ended the program early, either an uncaught exception or something fatal
+type RuntimeError struct {
+	Message    string
+	Exception  bool     // thrown by the program itself
+	Stacktrace []string // innermost call first
 }
 
-// variable helpers
-func (evl *Evaluator) Assign(sym symbols.VariableSymbol, value interface{}) {
-	if sym.IsGlobal() {
-		evl.Globals[sym.Fingerprint()] = value
-	} else {
-		evl.SetLocal(sym.Fingerprint(), value)
+func (err *RuntimeError) Error() string {
+	if err.Exception {
+		return "uncaught exception: " + err.Message
 	}
+
+	return err.Message
 }
 
-func (evl *Evaluator) Read(sym symbols.VariableSymbol) interface{} {
-	if sym.IsGlobal() {
-		return evl.Globals[sym.Fingerprint()]
-	} else {
-		return evl.GetLocal(sym.Fingerprint())
-	}
+// an active try block inside of a function body
+type Handler struct {
+	CatchLabel boundnodes.BoundLabel
+	Depth      int // how many frames were on the stack when we entered
 }
 
-var reader *bufio.Reader
+// an environment maps every captured variable to the locals it actually lives in
+type Environment map[string]map[string]interface{}
+
+const NullPointerMessage = "Null-Pointer exception! The given reference was null."
+
 var cursorVisible bool = true
 
+func CreateEvaluator(program binder.BoundProgram) *Evaluator {
+	evaluator := &Evaluator{
+		Globals: make(map[string]interface{}),
+		Frames:  []Frame{},
+		Labels:  make(map[*boundnodes.BoundStatementNode]map[boundnodes.BoundLabel]int),
+		Stdin:   bufio.NewReader(os.Stdin),
+		Stdout:  os.Stdout,
+		Natives: make(map[string]map[string]NativeBinding),
+
+		scheduler: &scheduler{},
+	}
+
+	evaluator.LoadProgram(program)
+	return evaluator
+}
+
+// switches over to the given program, globals that already exist keep their values
+// (the repl does this after every line)
+func (evl *Evaluator) LoadProgram(program binder.BoundProgram) {
+	evl.Program = program
+	evl.Functions = make(map[string]binder.BoundFunction)
+	evl.Classes = make(map[string]Class)
+	evl.Structs = make(map[string]symbols.StructSymbol)
+	evl.Interfaces = make(map[string]symbols.InterfaceSymbol)
+
+	for _, fnc := range program.Functions {
+		evl.Functions[fnc.Symbol.Fingerprint()] = fnc
+	}
+
+	for _, cls := range program.Classes {
+		class := Class{Symbol: cls.Symbol, Functions: make(map[string]binder.BoundFunction)}
+
+		for _, fnc := range cls.Functions {
+			class.Functions[fnc.Symbol.Fingerprint()] = fnc
+
+			if fnc.Symbol.Name == "Constructor" {
+				class.Constructor = fnc
+			}
+		}
+
+		evl.Classes[cls.Symbol.Name] = class
+	}
+
+	for _, stc := range program.Structs {
+		evl.Structs[stc.Name] = stc
+	}
+
+	for _, iface := range program.Interfaces {
+		evl.Interfaces[iface.Name] = iface
+	}
+
+	// globals start out zeroed, just like in a compiled program
+	if program.GlobalScope != nil {
+		for _, global := range program.GlobalScope.Variables {
+			if _, ok := evl.Globals[global.Fingerprint()]; !ok {
+				evl.Globals[global.Fingerprint()] = evl.DefaultValue(global.VarType())
+			}
+		}
+	}
+}
+
 // evaluate!
 func Evaluate(program binder.BoundProgram) {
-	evaluator := Evaluator{
-		Program:   program,
-		Globals:   make(map[string]interface{}),
-		Functions: make(map[string]binder.BoundFunction),
-		Locals:    []map[string]interface{}{},
-	}
+	evaluator := CreateEvaluator(program)
+	RunProgram(evaluator, evaluator.RunMain)
+}
 
+// sets everything up, runs main and takes the whole process down if anything goes wrong
+// (the vm runs its programs through here too, it just has its own main)
+func RunProgram(evl *Evaluator, main func()) {
 	// setup things
-	reader = bufio.NewReader(os.Stdin)
 	exec.Command("stty", "-F", "/dev/tty", "cbreak", "min", "1").Run() // disable input buffering
 	rand.Seed(time.Now().UnixNano())
 
-	evaluator.PushLocals()
+	// the main thread always holds the lock unless its waiting for something
+	evl.TakeTurn()
+	defer evl.EndTurn()
 
-	for _, fnc := range program.Functions {
-		symbol := fnc.Symbol
-		evaluator.Functions[symbol.Fingerprint()] = fnc
+	err := evl.Protected(func() {
+		evl.CheckNativeBindings()
+		main()
+	})
+
+	// nobody caught this one
+	if err != nil {
+		evl.Report(err)
+		os.Exit(-1)
+	}
+}
+
+func (evl *Evaluator) RunMain() {
+	main := evl.Functions[evl.Program.MainFunction.Fingerprint()]
+	evl.CallFunction(main, nil, []interface{}{})
+}
+
+// runs the main body inside of the given frame instead of a fresh one
+// (the repl keeps its main frame around, so variables from one line are still there on the next)
+func (evl *Evaluator) RunMainIn(frame Frame) {
+	main := evl.Functions[evl.Program.MainFunction.Fingerprint()]
+
+	evl.Frames = append(evl.Frames, frame)
+	evl.EvaluateStatement(main.Body)
+	evl.Frames = evl.Frames[:len(evl.Frames)-1]
+}
+
+// <HELPERS> ------------------------------------------------------------------
+
+// throws an exception inside of the program (these can be caught)
+func (evl *Evaluator) Throw(message string) {
+	panic(Exception{Message: message})
+}
+
+// something went so wrong the program cant keep going (these cant be caught)
+func (evl *Evaluator) Die(message string, args ...interface{}) {
+	panic(fatalError{Message: fmt.Sprintf(message, args...)})
+}
+
+// runs something and hands back whatever killed it instead of letting it take everything down
+// (the stack is put back the way it was, so the evaluator can keep being used afterwards)
+func (evl *Evaluator) Protected(action func()) (err *RuntimeError) {
+	depth := len(evl.Frames)
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch thrown := r.(type) {
+			case Exception:
+				err = &RuntimeError{Message: thrown.Message, Exception: true}
+			case fatalError:
+				err = &RuntimeError{Message: thrown.Message}
+			default:
+				panic(r)
+			}
+
+			for i := len(evl.Frames) - 1; i >= 0; i-- {
+				err.Stacktrace = append(err.Stacktrace, evl.Frames[i].Name)
+			}
+
+			evl.Frames = evl.Frames[:depth]
+		}
+	}()
+
+	action()
+	return nil
+}
+
+// prints an error the same way the runtime does
+func (evl *Evaluator) Report(err *RuntimeError) {
+	if !err.Exception {
+		print.PrintCF(print.Red, "%s", err.Message)
+		return
+	}
+
+	fmt.Fprintf(evl.Stdout, "\033[1;31m[RUNTIME] \033[0;31mEncountered Exception! \033[1;31m'%s'\n", err.Message)
+	fmt.Fprintf(evl.Stdout, "\033[1;33m[STACKTRACE] \033[0;33m\n")
+
+	for _, frame := range err.Stacktrace {
+		fmt.Fprintf(evl.Stdout, "  at %s\n", frame)
+	}
+
+	fmt.Fprint(evl.Stdout, print.EReset)
+}
+
+func (evl *Evaluator) Frame() *Frame {
+	return &evl.Frames[len(evl.Frames)-1]
+}
+
+// how deep calls can go before we give up
+// (every call takes a good chunk of go's stack, and running out of that kills the whole process)
+const MaxCallDepth = 10000
+
+func (evl *Evaluator) PushFrame(frame Frame) {
+	if len(evl.Frames) >= MaxCallDepth {
+		evl.Die("Stack overflow! Function calls can't go more than %d deep.", MaxCallDepth)
+	}
+
+	evl.Frames = append(evl.Frames, frame)
+}
+
+// </HELPERS> -----------------------------------------------------------------
+// <VARIABLES> ----------------------------------------------------------------
+
+// figures out where a variable actually lives
+func (evl *Evaluator) Storage(sym symbols.VariableSymbol, inMain bool) map[string]interface{} {
+	frame := evl.Frame()
+
+	if sym.IsGlobal() {
+		// globals inside of a class are the fields of the object we're in
+		if frame.This != nil && !inMain {
+			return frame.This.Fields
+		}
+
+		return evl.Globals
+	}
+
+	// captured variables live in someone else's locals
+	if locals, ok := frame.Environment[sym.Fingerprint()]; ok {
+		return locals
+	}
+
+	return frame.Locals
+}
+
+func (evl *Evaluator) Assign(sym symbols.VariableSymbol, inMain bool, value interface{}) {
+	evl.Storage(sym, inMain)[sym.Fingerprint()] = CopyValue(value)
+}
+
+func (evl *Evaluator) Read(sym symbols.VariableSymbol, inMain bool) interface{} {
+	return evl.Storage(sym, inMain)[sym.Fingerprint()]
+}
+
+// </VARIABLES> ---------------------------------------------------------------
+// <CALLS> --------------------------------------------------------------------
+
+func (evl *Evaluator) CallFunction(fnc binder.BoundFunction, this *Object, arguments []interface{}) interface{} {
+	name := fnc.Symbol.Name
+	if this != nil {
+		name = this.Class.Name + "->" + name
+	}
+
+	locals := make(map[string]interface{})
+	for i, param := range fnc.Symbol.Parameters {
+		locals[param.Fingerprint()] = CopyValue(arguments[i])
+	}
+
+	evl.PushFrame(Frame{Name: name, Locals: locals, Environment: make(Environment), This: this})
+	result := evl.EvaluateStatement(fnc.Body)
+	evl.Frames = evl.Frames[:len(evl.Frames)-1]
+
+	return result
+}
+
+func (evl *Evaluator) CallClosure(closure *Closure, arguments []interface{}) interface{} {
+	locals := make(map[string]interface{})
+	for i, param := range closure.Lambda.Function.Parameters {
+		locals[param.Fingerprint()] = CopyValue(arguments[i])
+	}
+
+	// copy the environment, anything the lambda declares itself shouldn't end up in there
+	env := make(Environment, len(closure.Environment))
+	for fingerprint, storage := range closure.Environment {
+		env[fingerprint] = storage
 	}
 
-	mainFunction := program.MainFunction
-	body := evaluator.Functions[mainFunction.Fingerprint()].Body
-	evaluator.EvaluateStatement(body)
+	// lambdas are never class members, but they can use the object they were created in
+	evl.PushFrame(Frame{Name: "lambda", Locals: locals, Environment: env, This: closure.This})
+	result := evl.EvaluateStatement(closure.Lambda.Body)
+	evl.Frames = evl.Frames[:len(evl.Frames)-1]
+
+	return result
 }
 
+// calls whatever is stored in an action
+func (evl *Evaluator) RunAction(action interface{}, arguments []interface{}) interface{} {
+	switch action := action.(type) {
+	case *Closure:
+		return evl.CallClosure(action, arguments)
+
+	case *FunctionReference:
+		// class functions get the object they're called on as their first argument
+		if action.Class != nil {
+			this, ok := arguments[0].(*Object)
+			if !ok {
+				evl.Throw(NullPointerMessage)
+			}
+
+			method, ok := evl.LookupMethod(*action.Class, action.Function.Fingerprint())
+			if !ok {
+				evl.Die("Unknown class function! [%s]", action.Function.Fingerprint())
+			}
+
+			return evl.CallFunction(method, this, arguments[1:])
+		}
+
+		return evl.CallFunction(evl.LookupFunction(action.Function), nil, arguments)
+	}
+
+	evl.Throw(NullPointerMessage)
+	return nil
+}
+
+func (evl *Evaluator) LookupFunction(sym symbols.FunctionSymbol) binder.BoundFunction {
+	if sym.External {
+		evl.Die("External function '%s' is not available in interpreter mode!", sym.Name)
+	}
+
+	fnc, ok := evl.Functions[sym.Fingerprint()]
+	if !ok {
+		evl.Die("Unknown function! [%s]", sym.Fingerprint())
+	}
+
+	return fnc
+}
+
+func (evl *Evaluator) EvaluateArguments(arguments []boundnodes.BoundExpressionNode) []interface{} {
+	values := make([]interface{}, 0, len(arguments))
+	for _, arg := range arguments {
+		values = append(values, evl.EvaluateExpression(arg))
+	}
+
+	return values
+}
+
+// </CALLS> -------------------------------------------------------------------
+// <STATEMENTS> ---------------------------------------------------------------
+
 func (evl *Evaluator) EvaluateStatement(body boundnodes.BoundBlockStatementNode) interface{} {
-	labelIndexes := make(map[boundnodes.BoundLabel]int)
+	labelIndexes := evl.LabelIndexes(body)
+
+	index := 0
+	handlers := make([]Handler, 0)
+
+	for {
+		result, exception := evl.EvaluateStatements(body, labelIndexes, &index, &handlers)
+		if exception == nil {
+			return result
+		}
 
-	// look through the entire body and look for any labels
+		// no try block in here -> someone further up has to deal with this
+		if len(handlers) == 0 {
+			panic(*exception)
+		}
+
+		handler := handlers[len(handlers)-1]
+		handlers = handlers[:len(handlers)-1]
+
+		// throw away the frames of any calls that never got to finish
+		evl.Frames = evl.Frames[:handler.Depth]
+
+		evl.CaughtException = exception.Message
+		index = labelIndexes[handler.CatchLabel]
+	}
+}
+
+// looks through the entire body for labels (only once per body)
+func (evl *Evaluator) LabelIndexes(body boundnodes.BoundBlockStatementNode) map[boundnodes.BoundLabel]int {
+	if len(body.Statements) == 0 {
+		return nil
+	}
+
+	key := &body.Statements[0]
+	if labelIndexes, ok := evl.Labels[key]; ok {
+		return labelIndexes
+	}
+
+	labelIndexes := make(map[boundnodes.BoundLabel]int)
 	for i, stmt := range body.Statements {
 		// if its a label statement...
 		if stmt.NodeType() == boundnodes.BoundLabelStatement {
@@ -106,60 +461,110 @@ func (evl *Evaluator) EvaluateStatement(body boundnodes.BoundBlockStatementNode)
 		}
 	}
 
-	index := 0
+	evl.Labels[key] = labelIndexes
+	return labelIndexes
+}
 
-	for index < len(body.Statements) {
-		stmt := body.Statements[index]
+func (evl *Evaluator) EvaluateStatements(body boundnodes.BoundBlockStatementNode, labelIndexes map[boundnodes.BoundLabel]int, index *int, handlers *[]Handler) (result interface{}, exception *Exception) {
+	// if anything gets thrown, hand it back to EvaluateStatement
+	defer func() {
+		if r := recover(); r != nil {
+			thrown, ok := r.(Exception)
+			if !ok {
+				panic(r)
+			}
+
+			exception = &thrown
+		}
+	}()
+
+	for *index < len(body.Statements) {
+		stmt := body.Statements[*index]
+		evl.Step()
 
 		switch stmt.NodeType() {
 		case boundnodes.BoundVariableDeclaration:
 			evl.EvaluateVariableDeclaration(stmt.(boundnodes.BoundVariableDeclarationStatementNode))
-			index++
+			*index++
 
 		case boundnodes.BoundExpressionStatement:
 			evl.EvaluateExpressionStatement(stmt.(boundnodes.BoundExpressionStatementNode))
-			index++
+			*index++
 
 		case boundnodes.BoundGotoStatement:
 			gotoStatement := stmt.(boundnodes.BoundGotoStatementNode)
-			index = labelIndexes[gotoStatement.Label]
+			*index = labelIndexes[gotoStatement.Label]
 
 		case boundnodes.BoundConditionalGotoStatement:
 			gotoStatement := stmt.(boundnodes.BoundConditionalGotoStatementNode)
 			condition := evl.EvaluateExpression(gotoStatement.Condition)
 
 			if condition.(bool) {
-				index = labelIndexes[gotoStatement.IfLabel]
+				*index = labelIndexes[gotoStatement.IfLabel]
 			} else {
-				index = labelIndexes[gotoStatement.ElseLabel]
+				*index = labelIndexes[gotoStatement.ElseLabel]
 			}
 
 		case boundnodes.BoundLabelStatement:
-			index++
+			*index++
 
 		case boundnodes.BoundReturnStatement:
 			returnStatement := stmt.(boundnodes.BoundReturnStatementNode)
 
 			if returnStatement.Expression != nil {
-				return evl.EvaluateExpression(returnStatement.Expression)
+				return CopyValue(evl.EvaluateExpression(returnStatement.Expression)), nil
 			}
 
-			return nil
+			return nil, nil
+
+		case boundnodes.BoundTryStartStatement:
+			tryStatement := stmt.(boundnodes.BoundTryStartStatementNode)
+			*handlers = append(*handlers, Handler{CatchLabel: tryStatement.CatchLabel, Depth: len(evl.Frames)})
+			*index = labelIndexes[tryStatement.BodyLabel]
+
+		case boundnodes.BoundTryEndStatement:
+			if len(*handlers) > 0 {
+				*handlers = (*handlers)[:len(*handlers)-1]
+			}
+			*index++
+
+		case boundnodes.BoundThrowStatement:
+			throwStatement := stmt.(boundnodes.BoundThrowStatementNode)
+			evl.Throw(evl.StringValue(evl.EvaluateExpression(throwStatement.Expression)))
+
+		default:
+			evl.Die("Unknown statement! [%s]", stmt.NodeType())
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 func (evl *Evaluator) EvaluateVariableDeclaration(stmt boundnodes.BoundVariableDeclarationStatementNode) {
-	value := evl.EvaluateExpression(stmt.Initializer)
-	evl.Assign(stmt.Variable, value)
+	var value interface{}
+
+	if stmt.Initializer != nil {
+		value = evl.EvaluateExpression(stmt.Initializer)
+	} else {
+		value = evl.DefaultValue(stmt.Variable.VarType())
+	}
+
+	// captured locals get new storage every time they're declared
+	// (otherwise every loop iteration would share the same one)
+	if !stmt.Variable.IsGlobal() && evl.Program.CapturedVariables[stmt.Variable.Fingerprint()] {
+		evl.Frame().Environment[stmt.Variable.Fingerprint()] = make(map[string]interface{})
+	}
+
+	evl.Assign(stmt.Variable, true, value)
 }
 
 func (evl *Evaluator) EvaluateExpressionStatement(stmt boundnodes.BoundExpressionStatementNode) {
-	evl.EvaluateExpression(stmt.Expression)
+	evl.LastValue = evl.EvaluateExpression(stmt.Expression)
 }
 
+// </STATEMENTS> --------------------------------------------------------------
+// <EXPRESSIONS> --------------------------------------------------------------
+
 // all them expressionz
 func (evl *Evaluator) EvaluateExpression(expr boundnodes.BoundExpressionNode) interface{} {
 	switch expr.NodeType() {
@@ -181,315 +586,528 @@ func (evl *Evaluator) EvaluateExpression(expr boundnodes.BoundExpressionNode) in
 	case boundnodes.BoundCallExpression:
 		return evl.EvaluateCallExpression(expr.(boundnodes.BoundCallExpressionNode))
 
+	case boundnodes.BoundPackageCallExpression:
+		return evl.EvaluatePackageCallExpression(expr.(boundnodes.BoundPackageCallExpressionNode))
+
 	case boundnodes.BoundTypeCallExpression:
 		return evl.EvaluateTypeCallExpression(expr.(boundnodes.BoundTypeCallExpressionNode))
 
+	case boundnodes.BoundClassCallExpression:
+		return evl.EvaluateClassCallExpression(expr.(boundnodes.BoundClassCallExpressionNode))
+
+	case boundnodes.BoundClassFieldAccessExpression:
+		return evl.EvaluateClassFieldAccessExpression(expr.(boundnodes.BoundClassFieldAccessExpressionNode))
+
+	case boundnodes.BoundClassFieldAssignmentExpression:
+		return evl.EvaluateClassFieldAssignmentExpression(expr.(boundnodes.BoundClassFieldAssignmentExpressionNode))
+
 	case boundnodes.BoundConversionExpression:
 		return evl.EvaluateConversionExpression(expr.(boundnodes.BoundConversionExpressionNode))
+
+	case boundnodes.BoundLambdaExpression:
+		return evl.EvaluateLambdaExpression(expr.(boundnodes.BoundLambdaExpressionNode))
+
+	case boundnodes.BoundFunctionExpression:
+		return evl.EvaluateFunctionExpression(expr.(boundnodes.BoundFunctionExpressionNode))
+
+	case boundnodes.BoundCaughtExceptionExpression:
+		return evl.CaughtException
+
+	case boundnodes.BoundMakeExpression:
+		return evl.EvaluateMakeExpression(expr.(boundnodes.BoundMakeExpressionNode))
+
+	case boundnodes.BoundMakeArrayExpression:
+		return evl.EvaluateMakeArrayExpression(expr.(boundnodes.BoundMakeArrayExpressionNode))
+
+	case boundnodes.BoundMakeMapExpression:
+		return CreateMap(expr.(boundnodes.BoundMakeMapExpressionNode).MapType)
+
+	case boundnodes.BoundMakeStructExpression:
+		return evl.EvaluateMakeStructExpression(expr.(boundnodes.BoundMakeStructExpressionNode))
+
+	case boundnodes.BoundArrayAccessExpression:
+		return evl.EvaluateArrayAccessExpression(expr.(boundnodes.BoundArrayAccessExpressionNode))
+
+	case boundnodes.BoundArrayAssignmentExpression:
+		return evl.EvaluateArrayAssignmentExpression(expr.(boundnodes.BoundArrayAssignmentExpressionNode))
+
+	case boundnodes.BoundTernaryExpression:
+		return evl.EvaluateTernaryExpression(expr.(boundnodes.BoundTernaryExpressionNode))
+
+	case boundnodes.BoundMatchExpression:
+		return evl.EvaluateMatchExpression(expr.(boundnodes.BoundMatchExpressionNode))
+
+	case boundnodes.BoundInterpolatedStringExpression:
+		return evl.EvaluateInterpolatedStringExpression(expr.(boundnodes.BoundInterpolatedStringExpressionNode))
+
+	case boundnodes.BoundReferenceExpression:
+		return evl.EvaluateReferenceExpression(expr.(boundnodes.BoundReferenceExpressionNode))
+
+	case boundnodes.BoundDereferenceExpression:
+		pointer := AsPointer(evl.EvaluateExpression(expr.(boundnodes.BoundDereferenceExpressionNode).Expression))
+		return evl.Load(pointer)
+
+	case boundnodes.BoundThisExpression:
+		// a nil *Object is not the same as nil
+		if this := evl.Frame().This; this != nil {
+			return this
+		}
+		return nil
+
+	case boundnodes.BoundEnumExpression:
+		return int32(expr.(boundnodes.BoundEnumExpressionNode).Value)
 	}
 
+	evl.Die("Unknown expression! [%s]", expr.NodeType())
 	return nil
 }
 
 func (evl *Evaluator) EvaluateLiteralExpression(expr boundnodes.BoundLiteralExpressionNode) interface{} {
+	switch value := expr.Value.(type) {
+	case int:
+		return int32(value)
+	case string:
+		// native strings (aka byte pointers)
+		if expr.Type().Name == builtins.Pointer.Name {
+			return NativeString(value)
+		}
+	}
+
 	return expr.Value
 }
 
 func (evl *Evaluator) EvaluateVariableExpression(expr boundnodes.BoundVariableExpressionNode) interface{} {
-	return evl.Read(expr.Variable)
+	return evl.Read(expr.Variable, expr.InMain)
 }
 
 func (evl *Evaluator) EvaluateAssignmentExpression(expr boundnodes.BoundAssignmentExpressionNode) interface{} {
 	value := evl.EvaluateExpression(expr.Expression)
-	evl.Assign(expr.Variable, value)
+	evl.Assign(expr.Variable, expr.InMain, value)
 	return value
 }
 
-func (evl *Evaluator) EvaluateUnaryExpression(expr boundnodes.BoundUnaryExpressionNode) interface{} {
-	value := evl.EvaluateExpression(expr.Expression)
-	switch expr.Op.OperatorKind {
+func (evl *Evaluator) EvaluateCallExpression(expr boundnodes.BoundCallExpressionNode) interface{} {
+	arguments := evl.EvaluateArguments(expr.Arguments)
 
-	case boundnodes.Identity:
-		if expr.Expression.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return value.(int)
-		} else if expr.Expression.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return value.(float32)
+	// inside of a class, calls go to the object we're in
+	if this := evl.Frame().This; this != nil && !expr.InMain && !expr.Function.BuiltIn {
+		method, ok := evl.LookupMethod(this.Class, expr.Function.Fingerprint())
+		if !ok {
+			evl.Die("Unknown class function! [%s]", expr.Function.Fingerprint())
 		}
 
-	case boundnodes.Negation:
-		if expr.Expression.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return -(value.(int))
-		} else if expr.Expression.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return -(value.(float32))
-		}
+		return evl.CallFunction(method, this, arguments)
+	}
 
-	case boundnodes.LogicalNegation:
-		return !(value.(bool))
+	return evl.CallFunction(evl.LookupFunction(expr.Function), nil, arguments)
+}
 
-	default:
-		print.PrintC(print.Red, "Unknown unary operation!")
-		os.Exit(-1)
+func (evl *Evaluator) EvaluateClassCallExpression(expr boundnodes.BoundClassCallExpressionNode) interface{} {
+	base := evl.EvaluateExpression(expr.Base)
+
+	// run a null check on the base
+	object, ok := base.(*Object)
+	if !ok {
+		evl.Throw(NullPointerMessage)
 	}
 
+	arguments := evl.EvaluateArguments(expr.Arguments)
+
+	// calls to a base class' constructor
+	if expr.Function.Name == "Constructor" {
+		return evl.CallFunction(evl.Classes[expr.Base.Type().Name].Constructor, object, arguments)
+	}
+
+	// the function might have been overridden (or we're calling through an interface)
+	// -> look it up in the object's actual class
+	method, ok := evl.LookupMethod(object.Class, expr.Function.Fingerprint())
+	if !ok {
+		evl.Die("Unknown class function! [%s]", expr.Function.Fingerprint())
+	}
+
+	return evl.CallFunction(method, object, arguments)
+}
+
+func (evl *Evaluator) EvaluateClassFieldAccessExpression(expr boundnodes.BoundClassFieldAccessExpressionNode) interface{} {
+	return evl.FieldsOf(expr.Base)[expr.Field.Fingerprint()]
+}
+
+func (evl *Evaluator) EvaluateClassFieldAssignmentExpression(expr boundnodes.BoundClassFieldAssignmentExpressionNode) interface{} {
+	value := evl.EvaluateExpression(expr.Value)
+	evl.FieldsOf(expr.Base)[expr.Field.Fingerprint()] = CopyValue(value)
+	return value
+}
+
+// the fields of an object or a struct
+func (evl *Evaluator) FieldsOf(base boundnodes.BoundExpressionNode) map[string]interface{} {
+	switch value := evl.EvaluateExpression(base).(type) {
+	case *Object:
+		return value.Fields
+	case *Struct:
+		return value.Fields
+	}
+
+	evl.Throw(NullPointerMessage)
 	return nil
 }
 
-func (evl *Evaluator) EvaluateBinaryExpression(expr boundnodes.BoundBinaryExpressionNode) interface{} {
-	left := evl.EvaluateExpression(expr.Left)
-	right := evl.EvaluateExpression(expr.Right)
+func (evl *Evaluator) EvaluateMakeExpression(expr boundnodes.BoundMakeExpressionNode) interface{} {
+	arguments := evl.EvaluateArguments(expr.Arguments)
 
-	switch expr.Op.OperatorKind {
-	case boundnodes.Addition:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) + right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) + right.(float32)
-		} else if expr.Left.Type().Fingerprint() == builtins.String.Fingerprint() {
-			return left.(string) + right.(string)
+	cls, ok := evl.Classes[expr.BaseType.Name]
+	if !ok {
+		// package classes live in LLVM modules, there's nothing for us to run
+		if expr.BaseType.Package.Exists {
+			evl.Die("Package class '%s::%s' has no interpreter binding! (it only works in compiled programs)", PackageName(expr.BaseType.Package), expr.BaseType.Name)
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
 
-	case boundnodes.Subtraction:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) - right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) - right.(float32)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+		evl.Die("Unknown class! [%s]", expr.BaseType.Name)
+	}
 
-	case boundnodes.Multiplication:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) * right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) * right.(float32)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	object := evl.CreateObject(cls.Symbol)
+	evl.CallFunction(cls.Constructor, object, arguments)
 
-	case boundnodes.Division:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			if right.(int) == 0 {
-				print.PrintC(print.Red, "Division by 0 is illegal!")
-				os.Exit(-1)
-			}
-			return left.(int) / right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			if right.(float32) == 0 {
-				print.PrintC(print.Red, "Division by 0 is illegal!")
-				os.Exit(-1)
-			}
-			return left.(float32) / right.(float32)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	return object
+}
 
-	case boundnodes.Modulus:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			if right.(int) == 0 {
-				print.PrintC(print.Red, "Division by 0 is illegal!")
-				os.Exit(-1)
-			}
-			return left.(int) % right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			if right.(float32) == 0 {
-				print.PrintC(print.Red, "Division by 0 is illegal!")
-				os.Exit(-1)
-			}
-			return math.Mod(left.(float64), right.(float64))
+func (evl *Evaluator) EvaluateMakeArrayExpression(expr boundnodes.BoundMakeArrayExpressionNode) interface{} {
+	if expr.IsLiteral {
+		elements := make([]interface{}, 0, len(expr.Literals))
+		for _, literal := range expr.Literals {
+			elements = append(elements, CopyValue(evl.EvaluateExpression(literal)))
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
 
-	case boundnodes.BitwiseAnd:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) & right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Bool.Fingerprint() {
-			return left.(bool) && right.(bool)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+		return CreateArray(expr.Type(), elements)
+	}
 
-	case boundnodes.BitwiseOr:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) | right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Bool.Fingerprint() {
-			return left.(bool) || right.(bool)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	length := IntegerValue(evl.EvaluateExpression(expr.Length))
+	if length < 0 {
+		evl.Die("Array length cannot be negative!")
+	}
 
-	case boundnodes.BitwiseXor:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) ^ right.(int)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	elements := make([]interface{}, length)
+	for i := range elements {
+		elements[i] = evl.DefaultValue(expr.BaseType)
+	}
 
-	case boundnodes.Equals:
-		return left == right
+	return CreateArray(expr.Type(), elements)
+}
 
-	case boundnodes.NotEquals:
-		return left != right
+func (evl *Evaluator) EvaluateMakeStructExpression(expr boundnodes.BoundMakeStructExpressionNode) interface{} {
+	stc := evl.CreateStruct(evl.Structs[expr.StructType.Name])
 
-	case boundnodes.Greater:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) > right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) > right.(float32)
+	// fields get filled in order, anything we dont have a value for keeps its default
+	for i, field := range stc.Symbol.Fields {
+		if i < len(expr.Literals) {
+			stc.Fields[field.Fingerprint()] = CopyValue(evl.EvaluateExpression(expr.Literals[i]))
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	}
 
-	case boundnodes.GreaterOrEquals:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) >= right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) >= right.(float32)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	return stc
+}
 
-	case boundnodes.Less:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) < right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) < right.(float32)
-		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+func (evl *Evaluator) EvaluateArrayAccessExpression(expr boundnodes.BoundArrayAccessExpressionNode) interface{} {
+	base := evl.EvaluateExpression(expr.Base)
+	index := evl.EvaluateExpression(expr.Index)
+
+	// is this actually a sneaky pointer access?
+	if expr.IsPointer {
+		return evl.Load(Offset(AsPointer(base), index))
+	}
 
-	case boundnodes.LessOrEquals:
-		if expr.Left.Type().Fingerprint() == builtins.Int.Fingerprint() {
-			return left.(int) <= right.(int)
-		} else if expr.Left.Type().Fingerprint() == builtins.Float.Fingerprint() {
-			return left.(float32) <= right.(float32)
+	switch base := base.(type) {
+	case *Map:
+		value, ok := base.Values[index]
+		if !ok {
+			evl.Throw("Key not found in map!")
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
 
-	case boundnodes.LogicalAnd:
-		if expr.Left.Type().Fingerprint() == builtins.Bool.Fingerprint() {
-			return left.(bool) && right.(bool)
+		return value
+
+	case *Array:
+		return base.Elements[evl.ArrayIndex(base, index)]
+	}
+
+	evl.Throw(NullPointerMessage)
+	return nil
+}
+
+func (evl *Evaluator) EvaluateArrayAssignmentExpression(expr boundnodes.BoundArrayAssignmentExpressionNode) interface{} {
+	base := evl.EvaluateExpression(expr.Base)
+	index := evl.EvaluateExpression(expr.Index)
+	value := evl.EvaluateExpression(expr.Value)
+
+	// is this actually a sneaky pointer access?
+	if expr.IsPointer {
+		evl.Store(Offset(AsPointer(base), index), value)
+		return value
+	}
+
+	switch base := base.(type) {
+	case *Map:
+		// new keys go onto the end
+		if _, ok := base.Values[index]; !ok {
+			base.Keys = append(base.Keys, index)
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
 
-	case boundnodes.LogicalOr:
-		if expr.Left.Type().Fingerprint() == builtins.Bool.Fingerprint() {
-			return left.(bool) || right.(bool)
+		base.Values[index] = CopyValue(value)
+		return value
+
+	case *Array:
+		base.Elements[evl.ArrayIndex(base, index)] = CopyValue(value)
+		return value
+	}
+
+	evl.Throw(NullPointerMessage)
+	return nil
+}
+
+// makes sure an index actually fits into the given array
+func (evl *Evaluator) ArrayIndex(array *Array, index interface{}) int {
+	i := IntegerValue(index)
+	if i < 0 || i >= int64(len(array.Elements)) {
+		evl.Throw("Array index out of range!")
+	}
+
+	return int(i)
+}
+
+func (evl *Evaluator) EvaluateTernaryExpression(expr boundnodes.BoundTernaryExpressionNode) interface{} {
+	if evl.EvaluateExpression(expr.Condition).(bool) {
+		return evl.EvaluateExpression(expr.If)
+	}
+
+	return evl.EvaluateExpression(expr.Else)
+}
+
+func (evl *Evaluator) EvaluateMatchExpression(expr boundnodes.BoundMatchExpressionNode) interface{} {
+	evl.Assign(expr.Variable, false, evl.EvaluateExpression(expr.Expression))
+
+	// the first case that fits gets to decide the value
+	for i, condition := range expr.Conditions {
+		if evl.EvaluateExpression(condition).(bool) {
+			return evl.EvaluateExpression(expr.Values[i])
 		}
-		print.PrintC(print.Red, "How did this even happen..? (invalid type on operator evaluation)")
-		os.Exit(-1)
+	}
+
+	return evl.EvaluateExpression(expr.DefaultValue)
+}
 
+func (evl *Evaluator) EvaluateInterpolatedStringExpression(expr boundnodes.BoundInterpolatedStringExpressionNode) interface{} {
+	var builder strings.Builder
+
+	// all parts are strings already, the binder made sure of that
+	for _, part := range expr.Parts {
+		builder.WriteString(evl.StringValue(evl.EvaluateExpression(part)))
 	}
 
-	return left
+	return builder.String()
 }
 
-func (evl *Evaluator) EvaluateCallExpression(expr boundnodes.BoundCallExpressionNode) interface{} {
-	// Built in functions
-	// they uh... they dont exist anymore
+func (evl *Evaluator) EvaluateLambdaExpression(expr boundnodes.BoundLambdaExpressionNode) interface{} {
+	frame := evl.Frame()
+	env := make(Environment)
 
-	locals := make(map[string]interface{})
-	for i, arg := range expr.Arguments {
-		parameter := expr.Function.Parameters[i]
-		argument := evl.EvaluateExpression(arg)
-		locals[parameter.Fingerprint()] = argument
+	// remember whose locals each captured variable belongs to
+	for _, captured := range expr.Captures {
+		fingerprint := captured.Fingerprint()
+
+		// if we captured it ourselves, pass it along
+		if locals, ok := frame.Environment[fingerprint]; ok {
+			env[fingerprint] = locals
+		} else {
+			env[fingerprint] = frame.Locals
+		}
+	}
+
+	closure := &Closure{Lambda: expr, Environment: env}
+	if expr.CapturesThis {
+		closure.This = frame.This
 	}
 
-	evl.PushTheseLocals(locals)
+	return closure
+}
+
+func (evl *Evaluator) EvaluateFunctionExpression(expr boundnodes.BoundFunctionExpressionNode) interface{} {
+	// class functions need to know where to find themselves
+	if expr.InClass.Exists && !expr.Function.BuiltIn {
+		cls := expr.InClass
+		return &FunctionReference{Function: expr.Function, Class: &cls}
+	}
 
-	body := evl.Functions[expr.Function.Fingerprint()].Body
-	result := evl.EvaluateStatement(body)
+	return &FunctionReference{Function: expr.Function}
+}
 
-	evl.PopLocals()
+func (evl *Evaluator) EvaluateReferenceExpression(expr boundnodes.BoundReferenceExpressionNode) interface{} {
+	variable := expr.Expression.(boundnodes.BoundVariableExpressionNode).Variable
 
-	return result
+	// a reference always points at the variable itself, wherever it lives
+	memory := &Memory{Locals: evl.Storage(variable, false), Name: variable.Fingerprint()}
+	return Pointer{Memory: memory}
 }
 
+// </EXPRESSIONS> -------------------------------------------------------------
+// <TYPECALLS> ----------------------------------------------------------------
+
 func (evl *Evaluator) EvaluateTypeCallExpression(expr boundnodes.BoundTypeCallExpressionNode) interface{} {
-	// get value of variable
-	varValue := evl.EvaluateExpression(expr.Base)
+	base := evl.EvaluateExpression(expr.Base)
+
+	// if this is an object type -> do a null check before calling
+	if expr.Base.Type().IsObject && base == nil {
+		evl.Throw(NullPointerMessage)
+	}
 
-	// check if the given functions fingerprint matches the GetLength() function's fingerprint
-	if expr.Function.Fingerprint() == builtins.GetLength.Fingerprint() {
-		// return the length
-		return len(varValue.(string))
+	switch expr.Function.Fingerprint() {
+	case builtins.GetLength.Fingerprint():
+		return int32(len(base.(string)))
+
+	case builtins.GetBuffer.Fingerprint():
+		return NativeString(base.(string))
+
+	case builtins.GetMessage.Fingerprint():
+		// the exception already is its message
+		return base
+
+	case builtins.Substring.Fingerprint():
+		str := base.(string)
+		start := IntegerValue(evl.EvaluateExpression(expr.Arguments[0]))
+		length := IntegerValue(evl.EvaluateExpression(expr.Arguments[1]))
+
+		// make sure the substring is valid
+		if start < 0 {
+			evl.Throw("Substring start-index cannot be negative!")
+		} else if length < 0 {
+			evl.Throw("Substring length cannot be negative!")
+		} else if start+length > int64(len(str)) {
+			evl.Throw("Substring out of range!")
+		}
 
-	} else if expr.Function.Fingerprint() == builtins.Substring.Fingerprint() {
-		// get index and length of the substring
-		index := evl.EvaluateExpression(expr.Arguments[0])
-		length := evl.EvaluateExpression(expr.Arguments[1])
+		return str[start : start+length]
 
-		// create the substring
-		return varValue.(string)[index.(int) : index.(int)+length.(int)]
-	} else {
-		print.PrintCF(print.Red, "Unknown type function! [%s]", expr.Function.Fingerprint())
-		os.Exit(-1)
+	case builtins.GetArrayLength.Fingerprint():
+		return int32(len(base.(*Array).Elements))
+
+	case builtins.Push.Fingerprint(), builtins.PPush.Fingerprint():
+		array := base.(*Array)
+		array.Elements = append(array.Elements, CopyValue(evl.EvaluateExpression(expr.Arguments[0])))
+		return nil
+
+	case builtins.MapGetLength.Fingerprint():
+		return int32(len(base.(*Map).Keys))
+
+	case builtins.Kill.Fingerprint():
+		evl.KillThread(base.(*Thread))
+		return nil
 
+	case builtins.Start.Fingerprint():
+		evl.StartThread(base.(*Thread))
+		return nil
+
+	case builtins.Join.Fingerprint():
+		evl.JoinThread(base.(*Thread))
 		return nil
 	}
-}
 
-func (evl *Evaluator) EvaluateConversionExpression(expr boundnodes.BoundConversionExpressionNode) interface{} {
-	value := evl.EvaluateExpression(expr.Expression)
+	// the funky ones:
+	// (these cant be identified by their fingerprint because its generated procedurally)
+	if expr.Function.OriginType.Fingerprint() == builtins.Map.Fingerprint() {
+		return evl.EvaluateMapFunction(base.(*Map), expr)
+	}
 
-	if expr.ToType.Fingerprint() == builtins.Any.Fingerprint() {
-		return value
+	switch expr.Function.Name {
+	case builtins.Run.Name:
+		return evl.RunAction(base, evl.EvaluateArguments(expr.Arguments[:len(expr.Function.Parameters)]))
 
-		// to string conversion
-	} else if expr.ToType.Fingerprint() == builtins.String.Fingerprint() {
-		switch value.(type) {
-		case string:
-			return value.(string)
-		case bool:
-			return fmt.Sprintf("%t", value.(bool))
-		case int:
-			return fmt.Sprintf("%d", value.(int))
-		case float32:
-			return fmt.Sprintf("%g", value.(float32))
-		default:
-			if value == nil {
-				return "cringe"
-			}
+	case builtins.RunThread.Name:
+		thread := CreateThread(base, evl.EvaluateArguments(expr.Arguments[:len(expr.Function.Parameters)]))
+		evl.StartThread(thread)
+		return thread
+	}
 
-			print.PrintCF(print.Red, "No Conversion! (cringe) [%s -> %s]", expr.Expression.Type().Fingerprint(), expr.ToType.Fingerprint())
-			os.Exit(-1)
-		}
+	evl.Die("Unknown type function! [%s]", expr.Function.Fingerprint())
+	return nil
+}
 
-		// string -> bool
-	} else if expr.ToType.Fingerprint() == builtins.Bool.Fingerprint() {
-		switch value.(type) {
-		case string:
-			val, _ := strconv.ParseBool(value.(string))
-			return val
-		default:
-			print.PrintCF(print.Red, "No Conversion! (cringe) [%s -> %s]", expr.Expression.Type().Fingerprint(), expr.ToType.Fingerprint())
-			os.Exit(-1)
-		}
-	} else if expr.ToType.Fingerprint() == builtins.Int.Fingerprint() {
-		switch value.(type) {
-		case string:
-			val, _ := strconv.Atoi(value.(string))
-			return val
-		default:
-			print.PrintCF(print.Red, "No Conversion! (cringe) [%s -> %s]", expr.Expression.Type().Fingerprint(), expr.ToType.Fingerprint())
-			os.Exit(-1)
+func (evl *Evaluator) EvaluateMapFunction(mp *Map, expr boundnodes.BoundTypeCallExpressionNode) interface{} {
+	switch expr.Function.Name {
+	case builtins.Keys.Name:
+		// hand out a copy so nobody messes with our order
+		keys := make([]interface{}, len(mp.Keys))
+		copy(keys, mp.Keys)
+		return CreateArray(expr.Type(), keys)
+
+	case builtins.Has.Name:
+		_, ok := mp.Values[evl.EvaluateExpression(expr.Arguments[0])]
+		return ok
+
+	case builtins.Remove.Name:
+		key := evl.EvaluateExpression(expr.Arguments[0])
+		if _, ok := mp.Values[key]; !ok {
+			return nil
 		}
-	} else if expr.ToType.Fingerprint() == builtins.Float.Fingerprint() {
-		switch value.(type) {
-		case string:
-			val, _ := strconv.ParseFloat(value.(string), 32)
-			return val
-		default:
-			print.PrintCF(print.Red, "No Conversion! (cringe) [%s -> %s]", expr.Expression.Type().Fingerprint(), expr.ToType.Fingerprint())
-			os.Exit(-1)
+
+		delete(mp.Values, key)
+
+		for i, k := range mp.Keys {
+			if k == key {
+				mp.Keys = append(mp.Keys[:i], mp.Keys[i+1:]...)
+				break
+			}
 		}
+
+		return nil
 	}
 
-	print.PrintC(print.Red, "idek how this happened (conversion went completely wrong)")
-	os.Exit(-1)
-	return value
+	evl.Die("Unknown map function! [%s]", expr.Function.Fingerprint())
+	return nil
+}
+
+// </TYPECALLS> ---------------------------------------------------------------
+// <POINTERS> -----------------------------------------------------------------
+
+// moves a pointer by the given number of elements
+func Offset(pointer Pointer, index interface{}) Pointer {
+	pointer.Offset += int(IntegerValue(index))
+	return pointer
+}
+
+func (evl *Evaluator) Load(pointer Pointer) interface{} {
+	memory := evl.Memory(pointer)
+
+	if memory.Locals != nil {
+		return memory.Locals[memory.Name]
+	}
+
+	return memory.Cells[pointer.Offset]
+}
+
+func (evl *Evaluator) Store(pointer Pointer, value interface{}) {
+	memory := evl.Memory(pointer)
+
+	if memory.Locals != nil {
+		memory.Locals[memory.Name] = CopyValue(value)
+		return
+	}
+
+	memory.Cells[pointer.Offset] = CopyValue(value)
 }
+
+// makes sure a pointer actually points at something
+func (evl *Evaluator) Memory(pointer Pointer) *Memory {
+	memory := pointer.Memory
+
+	valid := memory != nil
+	if valid && memory.Locals != nil {
+		valid = pointer.Offset == 0
+	} else if valid {
+		valid = pointer.Offset >= 0 && pointer.Offset < len(memory.Cells)
+	}
+
+	if !valid {
+		evl.Die("Segmentation fault! (invalid memory access at offset %d)", pointer.Offset)
+	}
+
+	return memory
+}
+
+// </POINTERS> ----------------------------------------------------------------
@@ -0,0 +1,321 @@
+package evaluator
+
+import (
+	"math"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+)
+
+// <UNARY> --------------------------------------------------------------------
+
+func (evl *Evaluator) EvaluateUnaryExpression(expr boundnodes.BoundUnaryExpressionNode) interface{} {
+	value := evl.EvaluateExpression(expr.Expression)
+
+	switch expr.Op.OperatorKind {
+	case boundnodes.Identity:
+		return value
+
+	case boundnodes.Negation:
+		switch number := value.(type) {
+		case float32:
+			return -number
+		case float64:
+			return -number
+		default:
+			return WrapInteger(-IntegerValue(value), value)
+		}
+
+	case boundnodes.LogicalNegation:
+		return !value.(bool)
+	}
+
+	evl.Die("Unknown unary operation! [%s]", expr.Op.OperatorKind)
+	return nil
+}
+
+// </UNARY> -------------------------------------------------------------------
+// <BINARY> -------------------------------------------------------------------
+
+func (evl *Evaluator) EvaluateBinaryExpression(expr boundnodes.BoundBinaryExpressionNode) interface{} {
+	// both sides always get evaluated, even for && and || (just like in compiled programs)
+	left := evl.EvaluateExpression(expr.Left)
+	right := evl.EvaluateExpression(expr.Right)
+
+	if expr.Left.Type().Fingerprint() == builtins.String.Fingerprint() {
+		return evl.StringOperation(expr.Op.OperatorKind, left, right)
+	}
+
+	if expr.Left.Type().Name == builtins.Pointer.Name {
+		return evl.PointerOperation(expr.Op.OperatorKind, left, right)
+	}
+
+	switch l := left.(type) {
+	case bool:
+		return evl.BoolOperation(expr.Op.OperatorKind, l, right.(bool))
+	case float32:
+		return evl.FloatOperation(expr.Op.OperatorKind, float64(l), float64(right.(float32)), func(result float64) interface{} { return float32(result) })
+	case float64:
+		return evl.FloatOperation(expr.Op.OperatorKind, l, right.(float64), func(result float64) interface{} { return result })
+	case byte, int32, uint32, int64, uint64:
+		return evl.IntegerOperation(expr.Op.OperatorKind, left, right)
+	}
+
+	// everything else (objects, arrays, ...) can only be compared
+	switch expr.Op.OperatorKind {
+	case boundnodes.Equals:
+		return left == right
+	case boundnodes.NotEquals:
+		return left != right
+	}
+
+	evl.Die("How did this even happen..? (invalid type on operator evaluation) [%s %s]", expr.Left.Type().Fingerprint(), expr.Op.OperatorKind)
+	return nil
+}
+
+func (evl *Evaluator) IntegerOperation(kind boundnodes.BoundBinaryOperatorType, left interface{}, right interface{}) interface{} {
+	a, b := IntegerValue(left), IntegerValue(right)
+	ua, ub := UnsignedValue(left), UnsignedValue(right)
+	unsigned := IsUnsigned(left)
+
+	switch kind {
+	case boundnodes.Addition:
+		return WrapInteger(a+b, left)
+	case boundnodes.Subtraction:
+		return WrapInteger(a-b, left)
+	case boundnodes.Multiplication:
+		return WrapInteger(a*b, left)
+
+	case boundnodes.Division:
+		if b == 0 {
+			evl.Die("Division by 0 is illegal!")
+		}
+
+		if unsigned {
+			return WrapInteger(int64(ua/ub), left)
+		}
+		return WrapInteger(a/b, left)
+
+	case boundnodes.Modulus:
+		if b == 0 {
+			evl.Die("Division by 0 is illegal!")
+		}
+
+		if unsigned {
+			return WrapInteger(int64(ua%ub), left)
+		}
+		return WrapInteger(a%b, left)
+
+	case boundnodes.BitwiseAnd:
+		return WrapInteger(a&b, left)
+	case boundnodes.BitwiseOr:
+		return WrapInteger(a|b, left)
+	case boundnodes.BitwiseXor:
+		return WrapInteger(a^b, left)
+
+	case boundnodes.BitshiftLeft:
+		return WrapInteger(a<<ub, left)
+	case boundnodes.BitshiftRight:
+		// shifting right is always a logical shift
+		return WrapInteger(int64(ua>>ub), left)
+
+	case boundnodes.Equals:
+		return a == b
+	case boundnodes.NotEquals:
+		return a != b
+	}
+
+	// comparisons care about signedness
+	if unsigned {
+		switch kind {
+		case boundnodes.Greater:
+			return ua > ub
+		case boundnodes.GreaterOrEquals:
+			return ua >= ub
+		case boundnodes.Less:
+			return ua < ub
+		case boundnodes.LessOrEquals:
+			return ua <= ub
+		}
+	} else {
+		switch kind {
+		case boundnodes.Greater:
+			return a > b
+		case boundnodes.GreaterOrEquals:
+			return a >= b
+		case boundnodes.Less:
+			return a < b
+		case boundnodes.LessOrEquals:
+			return a <= b
+		}
+	}
+
+	evl.Die("How did this even happen..? (invalid integer operation) [%s]", kind)
+	return nil
+}
+
+func (evl *Evaluator) FloatOperation(kind boundnodes.BoundBinaryOperatorType, a float64, b float64, wrap func(float64) interface{}) interface{} {
+	switch kind {
+	case boundnodes.Addition:
+		return wrap(a + b)
+	case boundnodes.Subtraction:
+		return wrap(a - b)
+	case boundnodes.Multiplication:
+		return wrap(a * b)
+	case boundnodes.Division:
+		return wrap(a / b)
+	case boundnodes.Modulus:
+		return wrap(math.Mod(a, b))
+
+	// all comparisons are ordered, so NaN is never equal (or unequal) to anything
+	case boundnodes.Equals:
+		return a == b
+	case boundnodes.NotEquals:
+		return a < b || a > b
+	case boundnodes.Greater:
+		return a > b
+	case boundnodes.GreaterOrEquals:
+		return a >= b
+	case boundnodes.Less:
+		return a < b
+	case boundnodes.LessOrEquals:
+		return a <= b
+	}
+
+	evl.Die("How did this even happen..? (invalid float operation) [%s]", kind)
+	return nil
+}
+
+func (evl *Evaluator) BoolOperation(kind boundnodes.BoundBinaryOperatorType, a bool, b bool) interface{} {
+	switch kind {
+	case boundnodes.LogicalAnd, boundnodes.BitwiseAnd:
+		return a && b
+	case boundnodes.LogicalOr, boundnodes.BitwiseOr:
+		return a || b
+	case boundnodes.Equals:
+		return a == b
+	case boundnodes.NotEquals, boundnodes.BitwiseXor:
+		return a != b
+	}
+
+	evl.Die("How did this even happen..? (invalid bool operation) [%s]", kind)
+	return nil
+}
+
+func (evl *Evaluator) StringOperation(kind boundnodes.BoundBinaryOperatorType, left interface{}, right interface{}) interface{} {
+	switch kind {
+	case boundnodes.Addition:
+		return evl.StringValue(left) + evl.StringValue(right)
+	case boundnodes.Equals:
+		return left == right
+	case boundnodes.NotEquals:
+		return left != right
+	}
+
+	evl.Die("How did this even happen..? (invalid string operation) [%s]", kind)
+	return nil
+}
+
+// pointers are just numbers to the compiled program, so they get to do all the things numbers do
+func (evl *Evaluator) PointerOperation(kind boundnodes.BoundBinaryOperatorType, left interface{}, right interface{}) interface{} {
+	l, r := AsPointer(left), AsPointer(right)
+
+	switch kind {
+	case boundnodes.Equals:
+		return l == r
+	case boundnodes.NotEquals:
+		return l != r
+	}
+
+	result := evl.IntegerOperation(kind, int64(l.Offset), int64(r.Offset))
+	if offset, ok := result.(int64); ok {
+		memory := l.Memory
+		if memory == nil {
+			memory = r.Memory
+		}
+
+		return Pointer{Memory: memory, Offset: int(offset)}
+	}
+
+	return result
+}
+
+// </BINARY> ------------------------------------------------------------------
+// <HELPERS> ------------------------------------------------------------------
+
+// reads any integer as a 64 bit one (signed ones get sign extended)
+func IntegerValue(value interface{}) int64 {
+	switch value := value.(type) {
+	case byte:
+		return int64(int8(value))
+	case int32:
+		return int64(value)
+	case uint32:
+		return int64(value)
+	case int64:
+		return value
+	case uint64:
+		return int64(value)
+	}
+
+	return 0
+}
+
+// reads the raw bits of any integer (no sign extension)
+func UnsignedValue(value interface{}) uint64 {
+	switch value := value.(type) {
+	case byte:
+		return uint64(value)
+	case int32:
+		return uint64(uint32(value))
+	case uint32:
+		return uint64(value)
+	case int64:
+		return uint64(value)
+	case uint64:
+		return value
+	}
+
+	return 0
+}
+
+// puts a 64 bit value back into the same integer type as the given value
+func WrapInteger(value int64, like interface{}) interface{} {
+	switch like.(type) {
+	case byte:
+		return byte(value)
+	case int32:
+		return int32(value)
+	case uint32:
+		return uint32(value)
+	case int64:
+		return value
+	case uint64:
+		return uint64(value)
+	}
+
+	return nil
+}
+
+func IsUnsigned(value interface{}) bool {
+	switch value.(type) {
+	case uint32, uint64:
+		return true
+	}
+
+	return false
+}
+
+// ints that get used as pointers are just an offset into nothing
+func AsPointer(value interface{}) Pointer {
+	switch value := value.(type) {
+	case Pointer:
+		return value
+	case byte, int32, uint32, int64, uint64:
+		return Pointer{Offset: int(IntegerValue(value))}
+	}
+
+	return Pointer{}
+}
+
+// </HELPERS> -----------------------------------------------------------------
@@ -0,0 +1,115 @@
+package evaluator
+
+import (
+	"strings"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// packages are LLVM modules, so the interpreter cant just run them
+// instead, every package function gets a go implementation registered in here
+
+// a go implementation of a package function
+type NativeFunction func(evl *Evaluator, args []interface{}) interface{}
+
+// a registered package function, the signature has to match the one the packager reads from the module
+type NativeBinding struct {
+	Parameters []symbols.TypeSymbol
+	Type       symbols.TypeSymbol
+	Function   NativeFunction
+}
+
+// package name -> function name -> binding
+var NativePackages = make(map[string]map[string]NativeBinding)
+
+func RegisterNativeFunction(pkg string, name string, params []symbols.TypeSymbol, returnType symbols.TypeSymbol, fnc NativeFunction) {
+	if _, ok := NativePackages[pkg]; !ok {
+		NativePackages[pkg] = make(map[string]NativeBinding)
+	}
+
+	NativePackages[pkg][name] = NativeBinding{Parameters: params, Type: returnType, Function: fnc}
+}
+
+func LookupNativeFunction(pkg string, name string) (NativeBinding, bool) {
+	functions, ok := NativePackages[pkg]
+	if !ok {
+		return NativeBinding{}, false
+	}
+
+	binding, ok := functions[name]
+	return binding, ok
+}
+
+// binds a function for just this evaluator (like the ones a host program hands to an embedded interpreter)
+func (evl *Evaluator) Bind(pkg string, name string, binding NativeBinding) {
+	if _, ok := evl.Natives[pkg]; !ok {
+		evl.Natives[pkg] = make(map[string]NativeBinding)
+	}
+
+	evl.Natives[pkg][name] = binding
+}
+
+// looks in this evaluator's own bindings first and in the global ones after that
+func (evl *Evaluator) LookupNativeFunction(pkg string, name string) (NativeBinding, bool) {
+	if binding, ok := evl.Natives[pkg][name]; ok {
+		return binding, true
+	}
+
+	return LookupNativeFunction(pkg, name)
+}
+
+// aliases still call into the original package
+func PackageName(pkg symbols.PackageSymbol) string {
+	if pkg.IsAlias && pkg.Original != nil {
+		return pkg.Original.Name
+	}
+
+	return pkg.Name
+}
+
+// makes sure every binding for the packages this program uses fits the functions the package actually has
+// (missing bindings are fine until someone tries to call them)
+func (evl *Evaluator) CheckNativeBindings() {
+	for _, pkg := range evl.Program.Packages {
+		name := PackageName(pkg)
+
+		for _, fnc := range pkg.Functions {
+			binding, ok := evl.LookupNativeFunction(name, fnc.Name)
+			if !ok {
+				continue
+			}
+
+			expected := make([]string, 0)
+			for _, param := range fnc.Parameters {
+				expected = append(expected, param.Type.Fingerprint())
+			}
+
+			got := make([]string, 0)
+			for _, param := range binding.Parameters {
+				got = append(got, param.Fingerprint())
+			}
+
+			if strings.Join(expected, ", ") != strings.Join(got, ", ") || fnc.Type.Fingerprint() != binding.Type.Fingerprint() {
+				evl.Die(
+					"Interpreter binding for '%s::%s' does not match the package! (expected (%s) %s but got (%s) %s)",
+					name, fnc.Name,
+					strings.Join(expected, ", "), fnc.Type.Fingerprint(),
+					strings.Join(got, ", "), binding.Type.Fingerprint(),
+				)
+			}
+		}
+	}
+}
+
+func (evl *Evaluator) EvaluatePackageCallExpression(expr boundnodes.BoundPackageCallExpressionNode) interface{} {
+	arguments := evl.EvaluateArguments(expr.Arguments)
+	name := PackageName(expr.Package)
+
+	binding, ok := evl.LookupNativeFunction(name, expr.Function.Name)
+	if !ok {
+		evl.Die("Package function '%s::%s' has no interpreter binding! (it only works in compiled programs)", name, expr.Function.Name)
+	}
+
+	return binding.Function(evl, arguments)
+}
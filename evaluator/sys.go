@@ -0,0 +1,117 @@
+package evaluator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// go versions of everything in packages/sys
+// (they do the same things the C versions do)
+
+// clock() counts from when the program started
+var startTime = time.Now()
+
+func init() {
+	RegisterNativeFunction("sys", "Print", []symbols.TypeSymbol{builtins.String}, builtins.Void, sysPrint)
+	RegisterNativeFunction("sys", "Write", []symbols.TypeSymbol{builtins.String}, builtins.Void, sysWrite)
+	RegisterNativeFunction("sys", "Input", []symbols.TypeSymbol{}, builtins.String, sysInput)
+	RegisterNativeFunction("sys", "Clear", []symbols.TypeSymbol{}, builtins.Void, sysClear)
+	RegisterNativeFunction("sys", "SetCursor", []symbols.TypeSymbol{builtins.Int, builtins.Int}, builtins.Void, sysSetCursor)
+	RegisterNativeFunction("sys", "SetCursorVisible", []symbols.TypeSymbol{builtins.Bool}, builtins.Void, sysSetCursorVisible)
+	RegisterNativeFunction("sys", "GetCursorVisible", []symbols.TypeSymbol{}, builtins.Bool, sysGetCursorVisible)
+	RegisterNativeFunction("sys", "Random", []symbols.TypeSymbol{builtins.Int}, builtins.Int, sysRandom)
+	RegisterNativeFunction("sys", "Sleep", []symbols.TypeSymbol{builtins.Int}, builtins.Void, sysSleep)
+	RegisterNativeFunction("sys", "Sqrt", []symbols.TypeSymbol{builtins.Int}, builtins.Int, sysSqrt)
+	RegisterNativeFunction("sys", "Now", []symbols.TypeSymbol{}, builtins.Int, sysNow)
+	RegisterNativeFunction("sys", "Char", []symbols.TypeSymbol{builtins.Int}, builtins.String, sysChar)
+}
+
+func sysPrint(evl *Evaluator, args []interface{}) interface{} {
+	fmt.Fprintln(evl.Stdout, evl.StringValue(args[0]))
+	return nil
+}
+
+func sysWrite(evl *Evaluator, args []interface{}) interface{} {
+	fmt.Fprint(evl.Stdout, evl.StringValue(args[0]))
+	return nil
+}
+
+// reads a line (without the line break)
+func sysInput(evl *Evaluator, args []interface{}) interface{} {
+	var line string
+
+	// waiting for input shouldnt keep the other threads from running
+	evl.Unlocked(func() {
+		read, err := evl.Stdin.ReadString('\n')
+		if err != nil && err != io.EOF {
+			evl.Die("Couldn't read input! (%s)", err)
+		}
+
+		line = strings.TrimSuffix(read, "\n")
+	})
+
+	return line
+}
+
+func sysClear(evl *Evaluator, args []interface{}) interface{} {
+	fmt.Fprint(evl.Stdout, "\033[2J\033[H")
+	return nil
+}
+
+func sysSetCursor(evl *Evaluator, args []interface{}) interface{} {
+	fmt.Fprintf(evl.Stdout, "%c[%d;%df", 27, args[1].(int32), args[0].(int32))
+	return nil
+}
+
+func sysSetCursorVisible(evl *Evaluator, args []interface{}) interface{} {
+	cursorVisible = args[0].(bool)
+
+	if cursorVisible {
+		fmt.Fprint(evl.Stdout, "\033[?25h")
+	} else {
+		fmt.Fprint(evl.Stdout, "\033[?25l")
+	}
+
+	return nil
+}
+
+func sysGetCursorVisible(evl *Evaluator, args []interface{}) interface{} {
+	return cursorVisible
+}
+
+func sysRandom(evl *Evaluator, args []interface{}) interface{} {
+	max := args[0].(int32)
+	if max == 0 {
+		evl.Die("Division by 0 is illegal!")
+	}
+
+	return rand.Int31() % max
+}
+
+func sysSleep(evl *Evaluator, args []interface{}) interface{} {
+	duration := time.Duration(args[0].(int32)) * time.Millisecond
+
+	// sleeping threads let the others have a go
+	evl.Unlocked(func() { time.Sleep(duration) })
+	return nil
+}
+
+func sysSqrt(evl *Evaluator, args []interface{}) interface{} {
+	return int32(math.Floor(math.Sqrt(float64(args[0].(int32)))))
+}
+
+// microseconds, just like clock()
+func sysNow(evl *Evaluator, args []interface{}) interface{} {
+	return int32(time.Since(startTime).Microseconds())
+}
+
+func sysChar(evl *Evaluator, args []interface{}) interface{} {
+	return string([]byte{byte(args[0].(int32))})
+}
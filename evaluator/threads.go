@@ -0,0 +1,145 @@
+package evaluator
+
+import (
+	"os"
+	"runtime"
+	"sync"
+)
+
+// only one thread of a program gets to evaluate at a time, they take turns every few statements
+// (everything in the interpreter is shared, so this is the easiest way to keep it sane)
+// every evaluator has its own, the threads it starts share it with it
+type scheduler struct {
+	lock sync.Mutex
+
+	// how many threads are currently running (if its none, nobody needs to take turns)
+	runningThreads int
+}
+
+// lets code outside of the interpreter (like an embedding host) wait for its turn
+func (evl *Evaluator) TakeTurn() {
+	evl.scheduler.lock.Lock()
+}
+
+func (evl *Evaluator) EndTurn() {
+	evl.scheduler.lock.Unlock()
+}
+
+// how many statements a thread gets to run before it has to let the others have a go
+const timeSlice = 1000
+
+// a thread value, can be started multiple times (just like the compiled ones)
+type Thread struct {
+	Action    interface{}
+	Arguments []interface{}
+
+	// the current run of this thread
+	Run *ThreadRun
+}
+
+// a single run of a thread
+type ThreadRun struct {
+	Done   chan struct{}
+	Killed bool
+}
+
+// panicked inside of a thread that just got killed
+type threadKilled struct{}
+
+func CreateThread(action interface{}, arguments []interface{}) *Thread {
+	return &Thread{Action: action, Arguments: arguments}
+}
+
+func (evl *Evaluator) StartThread(thread *Thread) {
+	evl.SpawnThread(thread, func(child *Evaluator) {
+		child.RunAction(thread.Action, thread.Arguments)
+	})
+}
+
+// starts a new run of the given thread and lets it do whatever run does
+// (the vm calls actions its own way, everything else about threads works the same for it)
+func (evl *Evaluator) SpawnThread(thread *Thread, run func(child *Evaluator)) {
+	threadRun := &ThreadRun{Done: make(chan struct{})}
+	thread.Run = threadRun
+	evl.scheduler.runningThreads++
+
+	// the new thread gets its own stack but shares everything else
+	child := *evl
+	child.Frames = []Frame{{Name: "thread", Locals: make(map[string]interface{}), Environment: make(Environment)}}
+	child.CaughtException = ""
+	child.CurrentThread = threadRun
+
+	go func() {
+		child.TakeTurn()
+
+		defer func() {
+			child.scheduler.runningThreads--
+			close(threadRun.Done)
+			child.EndTurn()
+		}()
+
+		err := child.Protected(func() {
+			defer func() {
+				// getting killed is a perfectly normal way to stop
+				if r := recover(); r != nil {
+					if _, ok := r.(threadKilled); !ok {
+						panic(r)
+					}
+				}
+			}()
+
+			run(&child)
+		})
+
+		if err != nil {
+			if child.OnThreadError != nil {
+				child.OnThreadError(err)
+				return
+			}
+
+			child.Report(err)
+			os.Exit(-1)
+		}
+	}()
+}
+
+func (evl *Evaluator) JoinThread(thread *Thread) {
+	// never started, nothing to wait for
+	if thread.Run == nil {
+		return
+	}
+
+	// let the thread do its thing while we wait
+	done := thread.Run.Done
+	evl.Unlocked(func() { <-done })
+}
+
+func (evl *Evaluator) KillThread(thread *Thread) {
+	if thread.Run != nil {
+		thread.Run.Killed = true
+	}
+}
+
+// runs something that doesnt touch the interpreter (like waiting), so other threads get to go in the meantime
+func (evl *Evaluator) Unlocked(action func()) {
+	evl.EndTurn()
+	defer evl.TakeTurn()
+
+	action()
+}
+
+// gets called before every statement, gives other threads a turn every now and then
+func (evl *Evaluator) Step() {
+	if evl.CurrentThread != nil && evl.CurrentThread.Killed {
+		panic(threadKilled{})
+	}
+
+	if evl.scheduler.runningThreads == 0 {
+		return
+	}
+
+	evl.steps++
+	if evl.steps%timeSlice == 0 {
+		evl.Unlocked(runtime.Gosched)
+	}
+}
@@ -0,0 +1,317 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// runtime values
+// --------------
+// bool    -> bool
+// byte    -> byte
+// int     -> int32
+// uint    -> uint32
+// long    -> int64
+// ulong   -> uint64
+// float   -> float32
+// double  -> float64
+// string  -> string (or nil if its null)
+// enums   -> int32
+// the rest is down below
+
+// an instance of a class
+type Object struct {
+	Class  symbols.ClassSymbol
+	Fields map[string]interface{}
+}
+
+// a struct, these get copied whenever they're stored somewhere
+type Struct struct {
+	Symbol symbols.StructSymbol
+	Fields map[string]interface{}
+}
+
+// an array, object and primitive arrays both end up in here
+type Array struct {
+	Type     symbols.TypeSymbol
+	Elements []interface{}
+}
+
+// a map value, remembers the order its keys were added in
+type Map struct {
+	Type   symbols.TypeSymbol
+	Keys   []interface{}
+	Values map[interface{}]interface{}
+}
+
+// a pointer, somewhere into a piece of memory
+type Pointer struct {
+	Memory *Memory
+	Offset int
+}
+
+// whatever a pointer points into, either a buffer (like a native string) or a variable
+type Memory struct {
+	Cells []interface{}
+
+	Locals map[string]interface{}
+	Name   string
+}
+
+// a lambda value, remembers where its captured variables are
+type Closure struct {
+	Lambda      boundnodes.BoundLambdaExpressionNode
+	Environment Environment
+	This        *Object // the object it was created in (if it uses it)
+}
+
+// a function used as a value, class functions also remember their class
+type FunctionReference struct {
+	Function symbols.FunctionSymbol
+	Class    *symbols.ClassSymbol
+}
+
+// <VALUES> -------------------------------------------------------------------
+
+// the value a variable of the given type starts out with
+func (evl *Evaluator) DefaultValue(typ symbols.TypeSymbol) interface{} {
+	switch typ.Fingerprint() {
+	case builtins.Bool.Fingerprint():
+		return false
+	case builtins.Byte.Fingerprint():
+		return byte(0)
+	case builtins.Int.Fingerprint():
+		return int32(0)
+	case builtins.UInt.Fingerprint():
+		return uint32(0)
+	case builtins.Long.Fingerprint():
+		return int64(0)
+	case builtins.ULong.Fingerprint():
+		return uint64(0)
+	case builtins.Float.Fingerprint():
+		return float32(0)
+	case builtins.Double.Fingerprint():
+		return float64(0)
+	}
+
+	// enums are just ints in a trenchcoat
+	if typ.IsEnum {
+		return int32(0)
+	}
+
+	// structs get all their fields filled in
+	if typ.IsUserDefined && !typ.IsObject {
+		if stc, ok := evl.Structs[typ.Name]; ok {
+			return evl.CreateStruct(stc)
+		}
+	}
+
+	// everything else is a reference, so its null
+	return nil
+}
+
+func (evl *Evaluator) CreateStruct(stc symbols.StructSymbol) *Struct {
+	value := &Struct{Symbol: stc, Fields: make(map[string]interface{})}
+
+	for _, field := range stc.Fields {
+		value.Fields[field.Fingerprint()] = evl.DefaultValue(field.VarType())
+	}
+
+	return value
+}
+
+func (evl *Evaluator) CreateObject(cls symbols.ClassSymbol) *Object {
+	object := &Object{Class: cls, Fields: make(map[string]interface{})}
+
+	// the class' fields already include the ones of its parents
+	for _, field := range cls.Fields {
+		object.Fields[field.Fingerprint()] = evl.DefaultValue(field.VarType())
+	}
+
+	return object
+}
+
+func CreateArray(typ symbols.TypeSymbol, elements []interface{}) *Array {
+	return &Array{Type: typ, Elements: elements}
+}
+
+func CreateMap(typ symbols.TypeSymbol) *Map {
+	return &Map{Type: typ, Keys: make([]interface{}, 0), Values: make(map[interface{}]interface{})}
+}
+
+// structs are values, so storing one somewhere means storing a copy of it
+func CopyValue(value interface{}) interface{} {
+	stc, ok := value.(*Struct)
+	if !ok {
+		return value
+	}
+
+	copied := &Struct{Symbol: stc.Symbol, Fields: make(map[string]interface{})}
+	for fingerprint, field := range stc.Fields {
+		copied.Fields[fingerprint] = CopyValue(field)
+	}
+
+	return copied
+}
+
+// native strings are null terminated buffers, just like in C
+func NativeString(str string) Pointer {
+	cells := make([]interface{}, len(str)+1)
+	for i := 0; i < len(str); i++ {
+		cells[i] = str[i]
+	}
+
+	cells[len(str)] = byte(0)
+	return Pointer{Memory: &Memory{Cells: cells}}
+}
+
+// </VALUES> ------------------------------------------------------------------
+// <CLASSES> ------------------------------------------------------------------
+
+// the type this value would have in a compiled program
+// (this is what the runtime's cast checks look at)
+func (evl *Evaluator) RuntimeType(value interface{}) symbols.TypeSymbol {
+	switch value := value.(type) {
+	case bool:
+		return builtins.Bool
+	case byte:
+		return builtins.Byte
+	case int32:
+		return builtins.Int
+	case uint32:
+		return builtins.UInt
+	case int64:
+		return builtins.Long
+	case uint64:
+		return builtins.ULong
+	case float32:
+		return builtins.Float
+	case float64:
+		return builtins.Double
+	case string:
+		return builtins.String
+	case *Object:
+		return value.Class.Type
+	case *Array:
+		// primitive arrays are created as parrays
+		typ := value.Type
+		if !typ.SubTypes[0].IsObject {
+			typ.Name = builtins.PArray.Name
+		}
+		return typ
+	case *Map:
+		return value.Type
+	case *Thread:
+		return builtins.Thread
+	case *Closure, *FunctionReference, Pointer:
+		// actions and pointers get boxed as longs
+		return builtins.Long
+	}
+
+	return builtins.Any
+}
+
+// the name of the runtime class a type belongs to
+func ClassName(typ symbols.TypeSymbol) string {
+	if typ.IsUserDefined {
+		return typ.Name
+	}
+
+	// the primitive array class is a bit special
+	if typ.Name == builtins.PArray.Name {
+		return "pArray"
+	}
+
+	return strings.ToUpper(typ.Name[:1]) + typ.Name[1:]
+}
+
+// the name an instance reports as its class
+func InstanceClassName(typ symbols.TypeSymbol) string {
+	if typ.IsUserDefined {
+		return typ.Name
+	}
+
+	return strings.ToUpper(typ.Name[:1]) + typ.Name[1:]
+}
+
+// makes sure a value can actually be treated as the given type (just like exc_ThrowIfInvalidCast)
+func (evl *Evaluator) CheckCast(value interface{}, target symbols.TypeSymbol) {
+	// null is the same, no matter the type
+	if value == nil {
+		return
+	}
+
+	// primitive arrays are created as parrays, so thats what we need to check against
+	if target.Name == builtins.Array.Name && len(target.SubTypes) > 0 && !target.SubTypes[0].IsObject {
+		target.Name = builtins.PArray.Name
+	}
+
+	from := evl.RuntimeType(value)
+
+	// it's already what we want
+	if from.Fingerprint() == target.Fingerprint() {
+		return
+	}
+
+	// everything is allowed to be "any"
+	targetName := ClassName(target)
+	if targetName == "Any" {
+		return
+	}
+
+	// check if the source inherits from the goal
+	if object, ok := value.(*Object); ok {
+		for cls := &object.Class; cls != nil; cls = cls.Parent {
+			if cls.Name == targetName {
+				return
+			}
+		}
+	}
+
+	// if the names are equal -> show the fingerprints
+	fromName := InstanceClassName(from)
+	if fromName == targetName {
+		fromName = from.Fingerprint()
+		targetName = target.Fingerprint()
+	}
+
+	evl.Throw(fmt.Sprintf("Object of type %s could not be casted to type %s!", fromName, targetName))
+}
+
+// makes sure an object implements the given interface
+func (evl *Evaluator) CheckInterfaceCast(value interface{}, iface symbols.InterfaceSymbol) {
+	// null is allowed to be anything
+	if value == nil {
+		return
+	}
+
+	// the only objects that can implement an interface are instances of local classes
+	if object, ok := value.(*Object); ok && object.Class.Implements(iface) {
+		return
+	}
+
+	evl.Throw("Object could not be casted to interface " + iface.Name + " as it does not implement it!")
+}
+
+// looks up a class function, starting at the given class and going up the chain
+func (evl *Evaluator) LookupMethod(cls symbols.ClassSymbol, fingerprint string) (binder.BoundFunction, bool) {
+	for current := &cls; current != nil; current = current.Parent {
+		class, ok := evl.Classes[current.Name]
+		if !ok {
+			continue
+		}
+
+		if method, ok := class.Functions[fingerprint]; ok {
+			return method, true
+		}
+	}
+
+	return binder.BoundFunction{}, false
+}
+
+// </CLASSES> -----------------------------------------------------------------
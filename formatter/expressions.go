@@ -0,0 +1,233 @@
+package formatter
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+)
+
+// Expression prints any kind of expression
+func (fmtr *Formatter) Expression(expression nodes.ExpressionNode) {
+	switch expr := expression.(type) {
+	case nodes.LiteralExpressionNode:
+		fmtr.token(expr.LiteralToken)
+	case nodes.InterpolatedStringExpressionNode:
+		fmtr.InterpolatedString(expr)
+	case nodes.NameExpressionNode:
+		fmtr.main(expr.InMain)
+		fmtr.token(expr.Identifier)
+	case nodes.AssignmentExpressionNode:
+		fmtr.main(expr.InMain)
+		fmtr.token(expr.Identifier)
+		fmtr.write(" <- ")
+		fmtr.Expression(expr.Expression)
+	case nodes.VariableEditorExpressionNode:
+		fmtr.token(expr.Identifier)
+
+		// i++ and i--
+		if expr.IsSingleStep {
+			fmtr.token(expr.Operator)
+			fmtr.write(expr.Operator.Value)
+			return
+		}
+
+		// i <-+ 1
+		fmtr.write(" <-")
+		fmtr.token(expr.Operator)
+		fmtr.write(" ")
+		fmtr.Expression(expr.Expression)
+	case nodes.CallExpressionNode:
+		fmtr.main(expr.InMain)
+
+		// complex casts and generic calls have a whole type in front of them
+		if expr.CastingType.ClauseIsSet {
+			fmtr.TypeClause(expr.CastingType)
+		} else {
+			fmtr.token(expr.Identifier)
+		}
+
+		fmtr.Arguments(expr.Arguments, expr.ClosingParenthesis)
+	case nodes.PackageCallExpressionNode:
+		fmtr.token(expr.Package)
+		fmtr.write("::")
+		fmtr.token(expr.Identifier)
+		fmtr.Arguments(expr.Arguments, expr.ClosingToken)
+	case nodes.TypeCallExpressionNode:
+		fmtr.Expression(expr.Base)
+		fmtr.write("->")
+		fmtr.token(expr.CallIdentifier)
+		fmtr.Arguments(expr.Arguments, expr.ClosingToken)
+	case nodes.ClassFieldAccessExpressionNode:
+		fmtr.Expression(expr.Base)
+		fmtr.write("->")
+		fmtr.token(expr.FieldIdentifier)
+	case nodes.ClassFieldAssignmentExpressionNode:
+		fmtr.Expression(expr.Base)
+		fmtr.write("->")
+		fmtr.token(expr.FieldIdentifier)
+		fmtr.write(" <- ")
+		fmtr.Expression(expr.Value)
+	case nodes.ArrayAccessExpressionNode:
+		fmtr.Expression(expr.Base)
+		fmtr.write("[")
+		fmtr.Expression(expr.Index)
+		fmtr.punctuation(expr.ClosingBracket, "]")
+	case nodes.ArrayAssignmentExpressionNode:
+		fmtr.Expression(expr.Base)
+		fmtr.write("[")
+		fmtr.Expression(expr.Index)
+		fmtr.write("] <- ")
+		fmtr.Expression(expr.Value)
+	case nodes.UnaryExpressionNode:
+		fmtr.token(expr.Operator)
+		fmtr.Expression(expr.Operand)
+	case nodes.BinaryExpressionNode:
+		fmtr.Expression(expr.Left)
+		fmtr.write(" ")
+		fmtr.token(expr.Operator)
+		fmtr.write(" ")
+		fmtr.Expression(expr.Right)
+	case nodes.TernaryExpressionNode:
+		fmtr.Expression(expr.Condition)
+		fmtr.write(" ? ")
+		fmtr.Expression(expr.If)
+		fmtr.write(" : ")
+		fmtr.Expression(expr.Else)
+	case nodes.ParenthesisedExpressionNode:
+		fmtr.punctuation(expr.OpenParenthesis, "(")
+		fmtr.Expression(expr.Expression)
+		fmtr.punctuation(expr.ClosedParenthesis, ")")
+	case nodes.MakeExpressionNode:
+		fmtr.token(expr.MakeKeyword)
+		fmtr.write(" ")
+
+		if expr.Package != nil {
+			fmtr.token(*expr.Package)
+			fmtr.write("::")
+		}
+
+		fmtr.token(expr.BaseType)
+
+		if len(expr.TypeArguments) != 0 {
+			fmtr.write("[")
+			for i, arg := range expr.TypeArguments {
+				if i != 0 {
+					fmtr.write(", ")
+				}
+
+				fmtr.TypeClause(arg)
+			}
+			fmtr.write("]")
+		}
+
+		fmtr.Arguments(expr.Arguments, expr.ClosingToken)
+	case nodes.MakeArrayExpressionNode:
+		fmtr.token(expr.MakeKeyword)
+		fmtr.write(" ")
+		fmtr.TypeClause(expr.Type)
+
+		if expr.IsLiteral {
+			fmtr.write(" array {")
+			fmtr.Expressions(expr.LiteralValues)
+			fmtr.punctuation(expr.ClosingToken, "}")
+			return
+		}
+
+		fmtr.write(" array(")
+		fmtr.Expression(expr.Length)
+		fmtr.punctuation(expr.ClosingToken, ")")
+	case nodes.MakeStructExpressionNode:
+		fmtr.token(expr.MakeKeyword)
+		fmtr.write(" ")
+		fmtr.token(expr.Type)
+		fmtr.write(" {")
+		fmtr.Expressions(expr.LiteralValues)
+		fmtr.punctuation(expr.ClosingToken, "}")
+	case nodes.LambdaExpressionNode:
+		fmtr.token(expr.LambdaKeyword)
+		fmtr.write("(")
+		fmtr.Parameters(expr.Parameters)
+		fmtr.write(")")
+		fmtr.ReturnType(expr.TypeClause)
+		fmtr.write(" ")
+		fmtr.Block(expr.Body)
+	case nodes.MatchExpressionNode:
+		fmtr.token(expr.Keyword)
+		fmtr.write(" (")
+		fmtr.Expression(expr.Expression)
+		fmtr.write(") {")
+
+		// short matches that were written on one line can stay that way
+		if expr.Keyword.Span.StartLine == expr.ClosingBrace.Span.EndLine {
+			fmtr.InlineCases(expr.Cases)
+			fmtr.punctuation(expr.ClosingBrace, " }")
+			return
+		}
+
+		fmtr.Cases(expr.Cases)
+		fmtr.newline()
+		fmtr.punctuation(expr.ClosingBrace, "}")
+	case nodes.ReferenceExpressionNode:
+		fmtr.token(expr.RefKeyword)
+		fmtr.write(" ")
+		fmtr.Expression(expr.Expression)
+	case nodes.DereferenceExpressionNode:
+		fmtr.token(expr.DerefKeyword)
+		fmtr.write(" ")
+		fmtr.Expression(expr.Expression)
+	case nodes.ThisExpressionNode:
+		fmtr.token(expr.ThisKeyword)
+	}
+}
+
+// main prints the main-> in front of things that explicitly live in main
+func (fmtr *Formatter) main(inMain bool) {
+	if inMain {
+		fmtr.write("main->")
+	}
+}
+
+// Arguments prints (a, b, c)
+func (fmtr *Formatter) Arguments(args []nodes.ExpressionNode, closing lexer.Token) {
+	fmtr.write("(")
+	fmtr.Expressions(args)
+	fmtr.punctuation(closing, ")")
+}
+
+// Expressions prints a comma separated list of expressions
+func (fmtr *Formatter) Expressions(exprs []nodes.ExpressionNode) {
+	for i, expr := range exprs {
+		if i != 0 {
+			fmtr.write(", ")
+		}
+
+		fmtr.Expression(expr)
+	}
+}
+
+// InterpolatedString prints $"text {hole} text", the text is printed exactly like it was written
+func (fmtr *Formatter) InterpolatedString(expr nodes.InterpolatedStringExpressionNode) {
+	fmtr.token(expr.StartToken)
+
+	for _, part := range expr.Parts {
+		if literal, ok := part.(nodes.LiteralExpressionNode); ok && fmtr.isInterpolationText(literal.LiteralToken) {
+			fmtr.token(literal.LiteralToken)
+			continue
+		}
+
+		fmtr.write("{")
+		fmtr.Expression(part)
+		fmtr.write("}")
+	}
+
+	fmtr.token(expr.EndToken)
+}
+
+// isInterpolationText checks if a string token is text inside an interpolated string (and not a string literal in a hole)
+// string literals always start with a quote, any quote in the text itself would have to be escaped
+func (fmtr *Formatter) isInterpolationText(token lexer.Token) bool {
+	if token.Kind != lexer.StringToken || token.Span.File == "" || token.Span.StartIndex >= len(fmtr.Code) {
+		return false
+	}
+
+	return fmtr.Code[token.Span.StartIndex] != '"'
+}
@@ -0,0 +1,405 @@
+package formatter
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/parser"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"sort"
+	"strings"
+)
+
+// the formatter pretty-prints a file straight from its syntax tree
+// comments aren't part of the tree, so they get put back in afterwards, right next to the code they were written next to
+
+// IndentString is what one level of indentation looks like
+const IndentString = "    "
+
+// Formatter : internal struct for building up the formatted code
+type Formatter struct {
+	Code    []rune
+	Lines   []Line
+	Indent  int
+	Emitted []EmittedToken // every source token we printed and which line it ended up on
+}
+
+// Line is a single line of formatted code
+type Line struct {
+	Indent      int
+	Text        string
+	Trailing    []string // comments that go at the end of this line
+	SourceStart int      // first and last source line this line was made from (0 if we dont know)
+	SourceEnd   int
+}
+
+// EmittedToken remembers where a source token ended up in the output
+type EmittedToken struct {
+	Token lexer.Token
+	Line  int
+	Start int // where on that line the token starts
+}
+
+// a comment that ended up in the middle of a line, the line gets split up around it
+type lineSplit struct {
+	At       int
+	Comment  lexer.Token
+	Trailing bool // the comment came right after some code, so it stays on that code's line
+	Brace    bool // the code in front of it was a closing brace
+}
+
+// Format formats a whole file and makes sure that doing so didn't change what the code means
+func Format(code string, filename string) (formatted string, err error) {
+	// the parser crashes on anything it can't make sense of, we'd rather just tell the caller
+	crashMode := print.PanicOnCrash
+	print.PanicOnCrash = true
+	defer func() {
+		print.PanicOnCrash = crashMode
+		if r := recover(); r != nil {
+			if _, ok := r.(print.CrashPanic); !ok {
+				panic(r)
+			}
+
+			err = fmt.Errorf("%s has syntax errors, fix those before formatting", filename)
+		}
+	}()
+
+	// the lexer and parser never see any \r, so neither do we
+	crlf := strings.Contains(code, "\r\n")
+	code = strings.Replace(code, "\r", "", -1)
+
+	formatted, err = format(code, filename)
+	if err != nil {
+		return "", err
+	}
+
+	// the only things allowed to change are whitespace, semicolons and commas
+	err = compareTokens(code, formatted, filename)
+	if err != nil {
+		return "", err
+	}
+
+	// and formatting formatted code shouldn't do anything at all
+	again, err := format(formatted, filename)
+	if err != nil || again != formatted {
+		return "", fmt.Errorf("formatting %s isn't stable, this is a bug in the formatter", filename)
+	}
+
+	if crlf {
+		formatted = strings.Replace(formatted, "\n", "\r\n", -1)
+	}
+
+	return formatted, nil
+}
+
+// format does the actual lexing, parsing and printing
+func format(code string, filename string) (string, error) {
+	errorCount := len(print.ErrorList)
+
+	tokens, comments := lexer.LexWithComments([]rune(code), filename)
+	members := parser.Parse(tokens)
+
+	if len(print.ErrorList) != errorCount {
+		return "", fmt.Errorf("%s has syntax errors, fix those before formatting", filename)
+	}
+
+	fmtr := CreateFormatter([]rune(code))
+	fmtr.Members(members)
+
+	return fmtr.Finish(comments), nil
+}
+
+// compareTokens makes sure the formatted code still consists of the same tokens and comments as the original
+func compareTokens(original string, formatted string, filename string) error {
+	originalTokens, originalComments := lexer.LexWithComments([]rune(original), filename)
+	formattedTokens, formattedComments := lexer.LexWithComments([]rune(formatted), filename)
+
+	originalTokens = significantTokens(originalTokens)
+	formattedTokens = significantTokens(formattedTokens)
+
+	if len(originalTokens) != len(formattedTokens) || len(originalComments) != len(formattedComments) {
+		return fmt.Errorf("formatting %s would change its meaning, this is a bug in the formatter", filename)
+	}
+
+	for i := range originalTokens {
+		if originalTokens[i].Kind != formattedTokens[i].Kind || originalTokens[i].Value != formattedTokens[i].Value {
+			return fmt.Errorf("formatting %s would change its meaning at %d:%d, this is a bug in the formatter",
+				filename, originalTokens[i].Span.StartLine, originalTokens[i].Span.StartColumn)
+		}
+	}
+
+	for i := range originalComments {
+		if strings.TrimSpace(originalComments[i].Value) != strings.TrimSpace(formattedComments[i].Value) {
+			return fmt.Errorf("formatting %s would lose a comment at %d:%d, this is a bug in the formatter",
+				filename, originalComments[i].Span.StartLine, originalComments[i].Span.StartColumn)
+		}
+	}
+
+	return nil
+}
+
+// significantTokens drops all tokens the formatter is allowed to add or remove
+func significantTokens(tokens []lexer.Token) []lexer.Token {
+	significant := make([]lexer.Token, 0, len(tokens))
+
+	for i, token := range tokens {
+		// semicolons and commas are optional in a bunch of places
+		if token.Kind == lexer.Semicolon || token.Kind == lexer.CommaToken {
+			continue
+		}
+
+		// so is the -> in front of return types (function Add(a int, b int) -> int)
+		if token.Kind == lexer.AccessToken && i > 0 && tokens[i-1].Kind == lexer.CloseParenthesisToken {
+			continue
+		}
+
+		significant = append(significant, token)
+	}
+
+	return significant
+}
+
+// CreateFormatter creates a formatter for the given source code
+func CreateFormatter(code []rune) *Formatter {
+	return &Formatter{
+		Code:    code,
+		Lines:   []Line{{}},
+		Emitted: make([]EmittedToken, 0),
+	}
+}
+
+// <OUTPUT> -------------------------------------------------------------------
+
+// write appends some text to the current line
+func (fmtr *Formatter) write(text string) {
+	line := &fmtr.Lines[len(fmtr.Lines)-1]
+
+	// the indentation is decided by whatever is first on the line
+	if line.Text == "" {
+		line.Indent = fmtr.Indent
+	}
+
+	line.Text += text
+}
+
+// newline starts a new line (unless the current one is still empty)
+func (fmtr *Formatter) newline() {
+	if fmtr.Lines[len(fmtr.Lines)-1].Text == "" {
+		return
+	}
+
+	fmtr.Lines = append(fmtr.Lines, Line{})
+}
+
+// token writes a token exactly like it was written in the source code
+func (fmtr *Formatter) token(token lexer.Token) {
+	if token.Span.File == "" || token.Span.EndIndex > len(fmtr.Code) {
+		fmtr.write(token.Value)
+		return
+	}
+
+	text := string(fmtr.Code[token.Span.StartIndex:token.Span.EndIndex])
+	fmtr.write(text)
+	fmtr.remember(token, text)
+}
+
+// punctuation writes some fixed text, if we know where it came from we'll also remember that
+func (fmtr *Formatter) punctuation(token lexer.Token, text string) {
+	fmtr.write(text)
+
+	if token.Span.File != "" {
+		fmtr.remember(token, text)
+	}
+}
+
+// remember keeps track of which line a source token ended up on
+func (fmtr *Formatter) remember(token lexer.Token, text string) {
+	index := len(fmtr.Lines) - 1
+	line := &fmtr.Lines[index]
+
+	if line.SourceStart == 0 || token.Span.StartLine < line.SourceStart {
+		line.SourceStart = token.Span.StartLine
+	}
+
+	if token.Span.EndLine > line.SourceEnd {
+		line.SourceEnd = token.Span.EndLine
+	}
+
+	fmtr.Emitted = append(fmtr.Emitted, EmittedToken{Token: token, Line: index, Start: len(line.Text) - len(text)})
+}
+
+// Finish puts the comments back in and gives back the finished code
+func (fmtr *Formatter) Finish(comments []lexer.Token) string {
+	lines := fmtr.Lines
+	if lines[len(lines)-1].Text == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	sort.SliceStable(fmtr.Emitted, func(i, j int) bool {
+		return fmtr.Emitted[i].Token.Span.StartIndex < fmtr.Emitted[j].Token.Span.StartIndex
+	})
+
+	// comments on their own lines, stored by the line they go in front of
+	before := make(map[int][]lexer.Token)
+	atEnd := make([]lexer.Token, 0)
+
+	// comments that would end up in the middle of a line, stored by that line
+	splits := make(map[int][]lineSplit)
+
+	for _, comment := range comments {
+		// find the tokens right before and after this comment
+		next := sort.Search(len(fmtr.Emitted), func(i int) bool {
+			return fmtr.Emitted[i].Token.Span.StartIndex > comment.Span.StartIndex
+		})
+
+		// the code around the comment got joined into one line (like a parameter list or "} else {")
+		// -> break that line up again in front of whatever came after the comment, so the comment stays in place
+		if next > 0 && next < len(fmtr.Emitted) && fmtr.Emitted[next].Line == fmtr.Emitted[next-1].Line {
+			previous := fmtr.Emitted[next-1]
+			splits[previous.Line] = append(splits[previous.Line], lineSplit{
+				At:       fmtr.Emitted[next].Start,
+				Comment:  comment,
+				Trailing: previous.Token.Span.EndLine == comment.Span.StartLine,
+				Brace:    previous.Token.Kind == lexer.CloseBraceToken,
+			})
+			continue
+		}
+
+		// if the comment came after some code on the same line, it stays behind that code
+		if next > 0 && fmtr.Emitted[next-1].Token.Span.EndLine == comment.Span.StartLine {
+			line := fmtr.Emitted[next-1].Line
+			lines[line].Trailing = append(lines[line].Trailing, commentText(comment))
+			continue
+		}
+
+		// otherwise it goes in front of whatever came after it
+		if next < len(fmtr.Emitted) {
+			line := fmtr.Emitted[next].Line
+			before[line] = append(before[line], comment)
+		} else {
+			atEnd = append(atEnd, comment)
+		}
+	}
+
+	// put everything together
+	result := make([]Line, 0, len(lines)+len(comments))
+	for i, line := range lines {
+		for _, comment := range before[i] {
+			indent := line.Indent
+
+			// a comment in front of a closing brace still belongs inside the block
+			if strings.HasPrefix(line.Text, "}") {
+				indent++
+			}
+
+			result = append(result, Line{
+				Indent:      indent,
+				Text:        commentText(comment),
+				SourceStart: comment.Span.StartLine,
+				SourceEnd:   comment.Span.StartLine,
+			})
+		}
+
+		if len(splits[i]) > 0 {
+			result = append(result, splitLine(line, splits[i])...)
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	for _, comment := range atEnd {
+		result = append(result, Line{
+			Text:        commentText(comment),
+			SourceStart: comment.Span.StartLine,
+			SourceEnd:   comment.Span.StartLine,
+		})
+	}
+
+	return render(result)
+}
+
+// splitLine breaks a line up around the comments that were written in the middle of it
+// everything after a split is indented one level deeper, unless it follows a closing brace (like "else" does)
+// or is a closing bracket itself
+func splitLine(line Line, splits []lineSplit) []Line {
+	result := make([]Line, 0, len(splits)+1)
+
+	// only the first and last piece remember where they came from, so no blank lines get put in between
+	current := Line{Indent: line.Indent, SourceStart: line.SourceStart}
+	start := 0
+
+	for _, split := range splits {
+		current.Text += strings.TrimSpace(line.Text[start:split.At])
+		start = split.At
+
+		indent := line.Indent + 1
+		if split.Brace || strings.ContainsAny(line.Text[split.At:split.At+1], ")]}") {
+			indent = line.Indent
+		}
+
+		if split.Trailing && current.Text != "" {
+			current.Trailing = append(current.Trailing, commentText(split.Comment))
+			result = append(result, current)
+		} else {
+			if current.Text != "" || len(current.Trailing) > 0 {
+				result = append(result, current)
+			}
+
+			result = append(result, Line{Indent: indent, Text: commentText(split.Comment)})
+		}
+
+		current = Line{Indent: indent}
+	}
+
+	current.Text += strings.TrimLeft(line.Text[start:], " ")
+	current.Trailing = append(current.Trailing, line.Trailing...)
+	current.SourceEnd = line.SourceEnd
+
+	return append(result, current)
+}
+
+// render turns the lines into text, keeping (at most one) blank line wherever the source had some
+func render(lines []Line) string {
+	var output strings.Builder
+	previous := -1
+	previousEnd := 0
+
+	for i, line := range lines {
+		if previous >= 0 && line.SourceStart != 0 && previousEnd != 0 &&
+			line.SourceStart-previousEnd > 1 &&
+			!strings.HasSuffix(lines[previous].Text, "{") &&
+			!strings.HasPrefix(line.Text, "}") {
+			output.WriteString("\n")
+		}
+
+		output.WriteString(strings.Repeat(IndentString, line.Indent))
+		output.WriteString(line.Text)
+		for _, comment := range line.Trailing {
+			output.WriteString(" " + comment)
+		}
+		output.WriteString("\n")
+
+		previous = i
+		if line.SourceEnd != 0 {
+			previousEnd = line.SourceEnd
+		}
+	}
+
+	return output.String()
+}
+
+// commentText gives back a comment without any trailing whitespace
+func commentText(comment lexer.Token) string {
+	return strings.TrimRight(comment.Value, " \t")
+}
+
+// </OUTPUT> ------------------------------------------------------------------
+
+// Members prints all members of a file
+func (fmtr *Formatter) Members(members []nodes.MemberNode) {
+	for _, member := range members {
+		fmtr.newline()
+		fmtr.Member(member)
+	}
+}
@@ -0,0 +1,263 @@
+package formatter
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"sort"
+	"strings"
+)
+
+// Member prints any kind of member
+func (fmtr *Formatter) Member(member nodes.MemberNode) {
+	switch mem := member.(type) {
+	case nodes.GlobalStatementMember:
+		fmtr.Statement(mem.Statement)
+	case nodes.FunctionDeclarationMember:
+		fmtr.FunctionDeclaration(mem)
+	case nodes.ExternalFunctionDeclarationMember:
+		fmtr.ExternalFunctionDeclaration(mem)
+	case nodes.ClassDeclarationMember:
+		fmtr.ClassDeclaration(mem)
+	case nodes.InterfaceDeclarationMember:
+		fmtr.InterfaceDeclaration(mem)
+	case nodes.StructDeclarationMember:
+		fmtr.StructDeclaration(mem)
+	case nodes.EnumDeclarationMember:
+		fmtr.EnumDeclaration(mem)
+	case nodes.PackageReferenceMember:
+		fmtr.token(mem.PackageKeyword)
+		fmtr.write(" ")
+		fmtr.token(mem.Package)
+		fmtr.write(";")
+	case nodes.PackageAliasMember:
+		fmtr.token(mem.PackageKeyword)
+		fmtr.write(" ")
+		fmtr.token(mem.Package)
+		fmtr.write(" ")
+		fmtr.token(mem.Alias)
+		fmtr.write(";")
+	case nodes.PackageUseMember:
+		fmtr.token(mem.PackageKeyword)
+		fmtr.write(" ")
+		fmtr.token(mem.Package)
+		fmtr.write(";")
+	}
+}
+
+// FunctionDeclaration prints function Name[T](a int) int { ... }
+func (fmtr *Formatter) FunctionDeclaration(mem nodes.FunctionDeclarationMember) {
+	if mem.IsPublic {
+		fmtr.write("set ")
+	}
+
+	fmtr.signature(mem)
+	fmtr.write(" ")
+	fmtr.Block(mem.Body)
+}
+
+// signature prints everything of a function up to its body
+func (fmtr *Formatter) signature(mem nodes.FunctionDeclarationMember) {
+	fmtr.token(mem.FunctionKeyword)
+	fmtr.write(" ")
+	fmtr.token(mem.Identifier)
+	fmtr.TypeParameters(mem.TypeParameters)
+	fmtr.write("(")
+	fmtr.Parameters(mem.Parameters)
+	fmtr.write(")")
+	fmtr.ReturnType(mem.TypeClause)
+}
+
+// ExternalFunctionDeclaration prints external c_variadic name(a int) int;
+func (fmtr *Formatter) ExternalFunctionDeclaration(mem nodes.ExternalFunctionDeclarationMember) {
+	fmtr.token(mem.ExternalKeyword)
+	fmtr.write(" ")
+
+	if mem.IsVariadic {
+		fmtr.write("c_variadic ")
+	} else if mem.IsAdapted {
+		fmtr.write("c_adapted ")
+	}
+
+	fmtr.token(mem.Identifier)
+	fmtr.write("(")
+	fmtr.Parameters(mem.Parameters)
+	fmtr.punctuation(mem.ClosingToken, ")")
+	fmtr.ReturnType(mem.TypeClause)
+	fmtr.write(";")
+}
+
+// ClassDeclaration prints class Name[T] : Base, Interface { ... }
+func (fmtr *Formatter) ClassDeclaration(mem nodes.ClassDeclarationMember) {
+	fmtr.token(mem.ClassKeyword)
+	fmtr.write(" ")
+	fmtr.token(mem.Identifier)
+	fmtr.TypeParameters(mem.TypeParameters)
+
+	if len(mem.BaseTypes) != 0 {
+		fmtr.write(" : ")
+		fmtr.tokenList(mem.BaseTypes)
+	}
+
+	fmtr.write(" {")
+	fmtr.Indent++
+	fmtr.Members(mem.Members)
+	fmtr.Indent--
+	fmtr.newline()
+	fmtr.punctuation(mem.ClosingToken, "}")
+}
+
+// InterfaceDeclaration prints interface Name { function Signature() int; }
+func (fmtr *Formatter) InterfaceDeclaration(mem nodes.InterfaceDeclarationMember) {
+	fmtr.token(mem.InterfaceKeyword)
+	fmtr.write(" ")
+	fmtr.token(mem.Identifier)
+	fmtr.write(" {")
+	fmtr.Indent++
+
+	for _, function := range mem.Functions {
+		fmtr.newline()
+		fmtr.signature(function)
+		fmtr.write(";")
+	}
+
+	fmtr.Indent--
+	fmtr.newline()
+	fmtr.punctuation(mem.ClosingToken, "}")
+}
+
+// StructDeclaration prints all fields of a struct on their own lines with their types lined up
+func (fmtr *Formatter) StructDeclaration(mem nodes.StructDeclarationMember) {
+	fmtr.token(mem.StructKeyword)
+	fmtr.write(" ")
+	fmtr.token(mem.Identifier)
+	fmtr.write(" {")
+	fmtr.Indent++
+
+	longest := 0
+	for _, field := range mem.Fields {
+		if len([]rune(field.Identifier.Value)) > longest {
+			longest = len([]rune(field.Identifier.Value))
+		}
+	}
+
+	for i, field := range mem.Fields {
+		fmtr.newline()
+		fmtr.token(field.Identifier)
+		fmtr.write(strings.Repeat(" ", longest-len([]rune(field.Identifier.Value))+1))
+		fmtr.TypeClause(field.TypeClause)
+
+		if i != len(mem.Fields)-1 {
+			fmtr.write(",")
+		}
+	}
+
+	fmtr.Indent--
+	fmtr.newline()
+	fmtr.punctuation(mem.ClosingToken, "}")
+}
+
+// EnumDeclaration prints all fields of an enum on their own lines
+func (fmtr *Formatter) EnumDeclaration(mem nodes.EnumDeclarationMember) {
+	fmtr.token(mem.StructKeyword)
+	fmtr.write(" ")
+	fmtr.token(mem.Identifier)
+	fmtr.write(" {")
+	fmtr.Indent++
+
+	// the fields live in a map, so we need to put them back into the order they were written in
+	fields := make([]lexer.Token, 0, len(mem.Fields))
+	for field := range mem.Fields {
+		fields = append(fields, field)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Span.StartIndex < fields[j].Span.StartIndex
+	})
+
+	for i, field := range fields {
+		fmtr.newline()
+		fmtr.token(field)
+
+		if value := mem.Fields[field]; value != nil {
+			fmtr.write(" <- ")
+			fmtr.token(value.LiteralToken)
+		}
+
+		if i != len(fields)-1 {
+			fmtr.write(",")
+		}
+	}
+
+	fmtr.Indent--
+	fmtr.newline()
+	fmtr.punctuation(mem.ClosingToken, "}")
+}
+
+// <CLAUSES> ------------------------------------------------------------------
+
+// TypeClause prints a type like int, pkg::Thing or array[map[string, int]]
+func (fmtr *Formatter) TypeClause(clause nodes.TypeClauseNode) {
+	if clause.Package != nil {
+		fmtr.token(*clause.Package)
+		fmtr.write("::")
+	}
+
+	fmtr.token(clause.TypeIdentifier)
+
+	if len(clause.SubClauses) != 0 {
+		fmtr.write("[")
+		for i, sub := range clause.SubClauses {
+			if i != 0 {
+				fmtr.write(", ")
+			}
+
+			fmtr.TypeClause(sub)
+		}
+		fmtr.punctuation(clause.ClosingBracket, "]")
+	}
+}
+
+// ReturnType prints the return type behind a function's parameters, if it has one
+func (fmtr *Formatter) ReturnType(clause nodes.TypeClauseNode) {
+	if clause.ClauseIsSet {
+		fmtr.write(" ")
+		fmtr.TypeClause(clause)
+	}
+}
+
+// TypeParameters prints the [T, U] of generic classes and functions
+func (fmtr *Formatter) TypeParameters(params []lexer.Token) {
+	if len(params) == 0 {
+		return
+	}
+
+	fmtr.write("[")
+	fmtr.tokenList(params)
+	fmtr.write("]")
+}
+
+// Parameters prints a comma separated list of parameters
+func (fmtr *Formatter) Parameters(params []nodes.ParameterNode) {
+	for i, param := range params {
+		if i != 0 {
+			fmtr.write(", ")
+		}
+
+		fmtr.token(param.Identifier)
+		fmtr.write(" ")
+		fmtr.TypeClause(param.TypeClause)
+	}
+}
+
+// tokenList prints a comma separated list of tokens
+func (fmtr *Formatter) tokenList(tokens []lexer.Token) {
+	for i, token := range tokens {
+		if i != 0 {
+			fmtr.write(", ")
+		}
+
+		fmtr.token(token)
+	}
+}
+
+// </CLAUSES> -----------------------------------------------------------------
@@ -0,0 +1,237 @@
+package formatter
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+)
+
+// Statement prints a statement, simple statements get a semicolon at the end
+func (fmtr *Formatter) Statement(statement nodes.StatementNode) {
+	switch stmt := statement.(type) {
+	case nodes.BlockStatementNode:
+		fmtr.Block(stmt)
+	case nodes.IfStatementNode:
+		fmtr.IfStatement(stmt)
+	case nodes.WhileStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" (")
+		fmtr.Expression(stmt.Condition)
+		fmtr.write(")")
+		fmtr.Body(stmt.Statement)
+	case nodes.ForStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" (")
+		fmtr.SimpleStatement(stmt.Initaliser)
+		fmtr.write("; ")
+		fmtr.Expression(stmt.Condition)
+		fmtr.write("; ")
+		fmtr.SimpleStatement(stmt.Updation)
+		fmtr.write(")")
+		fmtr.Body(stmt.Statement)
+	case nodes.FromToStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" (")
+		fmtr.token(stmt.Identifier)
+		fmtr.write(" <- ")
+		fmtr.Expression(stmt.LowerBound)
+		fmtr.write(") to ")
+		fmtr.Expression(stmt.UpperBound)
+		fmtr.Body(stmt.Statement)
+	case nodes.ForEachStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" (var ")
+		fmtr.token(stmt.Identifier)
+		fmtr.write(" in ")
+		fmtr.Expression(stmt.Collection)
+		fmtr.write(")")
+		fmtr.Body(stmt.Statement)
+	case nodes.SwitchStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" (")
+		fmtr.Expression(stmt.Expression)
+		fmtr.write(") {")
+		fmtr.Cases(stmt.Cases)
+		fmtr.newline()
+		fmtr.punctuation(stmt.ClosingBrace, "}")
+	case nodes.TryStatementNode:
+		fmtr.TryStatement(stmt)
+	default:
+		fmtr.SimpleStatement(statement)
+		fmtr.write(";")
+	}
+}
+
+// SimpleStatement prints statements that don't contain any other statements (without a semicolon)
+func (fmtr *Formatter) SimpleStatement(statement nodes.StatementNode) {
+	switch stmt := statement.(type) {
+	case nodes.VariableDeclarationStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" ")
+
+		if stmt.TypeClause.ClauseIsSet {
+			fmtr.TypeClause(stmt.TypeClause)
+			fmtr.write(" ")
+		}
+
+		fmtr.token(stmt.Identifier)
+
+		if stmt.Initializer != nil {
+			fmtr.write(" <- ")
+			fmtr.Expression(stmt.Initializer)
+		}
+	case nodes.ExpressionStatementNode:
+		fmtr.Expression(stmt.Expression)
+	case nodes.ReturnStatementNode:
+		fmtr.token(stmt.Keyword)
+
+		if stmt.Expression != nil {
+			fmtr.write(" ")
+			fmtr.Expression(stmt.Expression)
+		}
+	case nodes.ThrowStatementNode:
+		fmtr.token(stmt.Keyword)
+		fmtr.write(" ")
+		fmtr.Expression(stmt.Expression)
+	case nodes.BreakStatementNode:
+		fmtr.token(stmt.Keyword)
+	case nodes.ContinueStatementNode:
+		fmtr.token(stmt.Keyword)
+	default:
+		// anything else isn't simple, but it'd still be better to print it than to lose it
+		fmtr.Statement(statement)
+	}
+}
+
+// Block prints a block statement, the opening brace stays on the current line
+func (fmtr *Formatter) Block(block nodes.BlockStatementNode) {
+	fmtr.punctuation(block.OpenBrace, "{")
+	fmtr.Indent++
+
+	for _, stmt := range block.Statements {
+		fmtr.newline()
+		fmtr.Statement(stmt)
+	}
+
+	fmtr.Indent--
+	fmtr.newline()
+	fmtr.punctuation(block.CloseBrace, "}")
+}
+
+// Body prints the statement belonging to an if, loop, etc.
+// blocks go on the same line, anything else gets its own (indented) line
+func (fmtr *Formatter) Body(statement nodes.StatementNode) {
+	if block, ok := statement.(nodes.BlockStatementNode); ok {
+		fmtr.write(" ")
+		fmtr.Block(block)
+		return
+	}
+
+	fmtr.Indent++
+	fmtr.newline()
+	fmtr.Statement(statement)
+	fmtr.Indent--
+}
+
+// continuation starts a clause like else or catch, right behind a closing brace if there is one
+func (fmtr *Formatter) continuation(previous nodes.StatementNode) {
+	if _, ok := previous.(nodes.BlockStatementNode); ok {
+		fmtr.write(" ")
+		return
+	}
+
+	fmtr.newline()
+}
+
+// IfStatement prints if (...) { ... } else if (...) { ... } else { ... }
+func (fmtr *Formatter) IfStatement(stmt nodes.IfStatementNode) {
+	fmtr.token(stmt.IfKeyword)
+	fmtr.write(" (")
+	fmtr.Expression(stmt.Condition)
+	fmtr.write(")")
+	fmtr.Body(stmt.ThenStatement)
+
+	if !stmt.ElseClause.ClauseIsSet {
+		return
+	}
+
+	fmtr.continuation(stmt.ThenStatement)
+	fmtr.token(stmt.ElseClause.ElseKeyword)
+
+	// else if chains stay flat
+	if elseIf, ok := stmt.ElseClause.ElseStatement.(nodes.IfStatementNode); ok {
+		fmtr.write(" ")
+		fmtr.IfStatement(elseIf)
+		return
+	}
+
+	fmtr.Body(stmt.ElseClause.ElseStatement)
+}
+
+// TryStatement prints try { ... } catch (e) { ... } finally { ... }
+func (fmtr *Formatter) TryStatement(stmt nodes.TryStatementNode) {
+	fmtr.token(stmt.Keyword)
+	fmtr.Body(stmt.TryStatement)
+	previous := stmt.TryStatement
+
+	if stmt.CatchClause.ClauseIsSet {
+		fmtr.continuation(previous)
+		fmtr.token(stmt.CatchClause.CatchKeyword)
+
+		if stmt.CatchClause.Identifier.Kind != "" {
+			fmtr.write(" (")
+			fmtr.token(stmt.CatchClause.Identifier)
+			fmtr.write(")")
+		}
+
+		fmtr.Body(stmt.CatchClause.CatchStatement)
+		previous = stmt.CatchClause.CatchStatement
+	}
+
+	if stmt.FinallyClause.ClauseIsSet {
+		fmtr.continuation(previous)
+		fmtr.token(stmt.FinallyClause.FinallyKeyword)
+		fmtr.Body(stmt.FinallyClause.FinallyStatement)
+	}
+}
+
+// Cases prints the cases of a switch or match, each on their own line
+func (fmtr *Formatter) Cases(cases []nodes.CaseClauseNode) {
+	fmtr.Indent++
+
+	for _, clause := range cases {
+		fmtr.newline()
+		fmtr.caseLabel(clause)
+
+		// match cases result in a value
+		if clause.Statement == nil {
+			fmtr.Expression(clause.Value)
+			fmtr.write(";")
+			continue
+		}
+
+		fmtr.Statement(clause.Statement)
+	}
+
+	fmtr.Indent--
+}
+
+// InlineCases prints the cases of a match all on the current line
+func (fmtr *Formatter) InlineCases(cases []nodes.CaseClauseNode) {
+	for _, clause := range cases {
+		fmtr.write(" ")
+		fmtr.caseLabel(clause)
+		fmtr.Expression(clause.Value)
+		fmtr.write(";")
+	}
+}
+
+// caseLabel prints the case 1, 2: or default: in front of a case
+func (fmtr *Formatter) caseLabel(clause nodes.CaseClauseNode) {
+	fmtr.token(clause.Keyword)
+
+	if len(clause.Values) != 0 {
+		fmtr.write(" ")
+		fmtr.Expressions(clause.Values)
+	}
+
+	fmtr.write(": ")
+}
@@ -0,0 +1,282 @@
+package langserver
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/packager"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/parser"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/preprocessor"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"unicode/utf8"
+)
+
+// Analyse runs a document through the compiler and publishes the results
+func (srv *Server) Analyse(doc *Document) {
+	files, reports := srv.Check(doc)
+
+	// sort everything we got by the file it's in
+	diagnostics := make(map[string][]Diagnostic)
+	for _, file := range files {
+		diagnostics[file] = make([]Diagnostic, 0)
+	}
+
+	for _, report := range reports {
+		file := doc.Path
+		if report.Report.Span.File != "" {
+			file = AbsolutePath(doc.Path, report.Report.Span.File)
+		}
+
+		diagnostics[file] = append(diagnostics[file], srv.CreateDiagnostic(report))
+	}
+
+	// files which dropped out since the last check shouldnt keep their old diagnostics
+	for _, file := range doc.Files {
+		_, ok := diagnostics[file]
+		if !ok {
+			diagnostics[file] = make([]Diagnostic, 0)
+		}
+	}
+
+	doc.Files = files
+
+	for file, list := range diagnostics {
+		srv.PublishDiagnostics(srv.FileURI(file), list)
+	}
+}
+
+func (srv *Server) PublishDiagnostics(uri string, diagnostics []Diagnostic) {
+	srv.Notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+// Report is an error or warning the compiler gave us
+type Report struct {
+	Report   print.ErrorReport
+	Severity DiagnosticSeverity
+}
+
+// Check does what the cli does before emitting: preprocessing, lexing, parsing, and binding
+// it returns all the files that got looked at and everything the compiler had to say about them
+func (srv *Server) Check(doc *Document) (checked []string, reports []Report) {
+	ResetCompiler()
+	srv.LastChecked = doc
+
+	// relative #source paths are relative to the document
+	cwd, _ := os.Getwd()
+	os.Chdir(filepath.Dir(doc.Path))
+	defer os.Chdir(cwd)
+
+	files := []string{doc.Path}
+	checked = make([]string, 0)
+
+	// whatever happens, we want our reports
+	defer func() {
+		reports = CollectReports()
+
+		if len(langserverinterface.Scopes) > 0 {
+			doc.Scopes = langserverinterface.Scopes
+		}
+
+		if r := recover(); r != nil {
+			// crashes are just the compiler giving up, anything else is a bug
+			if _, ok := r.(print.CrashPanic); !ok {
+				srv.Log("compiler panicked while checking '%s': %v\n%s", doc.Path, r, debug.Stack())
+				reports = append(reports, Report{
+					Report: print.ErrorReport{
+						Area:        "LANGSERVER",
+						ErrType:     "CompilerCrash",
+						Message:     "The compiler crashed while checking this file! (%v)",
+						MessageArgs: []interface{}{r},
+					},
+					Severity: SeverityError,
+				})
+			}
+		}
+	}()
+
+	// preprocess and lex every file
+	arguments := make([]string, 0)
+	lexes := make([][]lexer.Token, 0)
+
+	for i := 0; i < len(files); i++ {
+		files[i] = AbsolutePath(doc.Path, files[i])
+
+		code := srv.ReadSource(files[i])
+		checked = append(checked, files[i])
+
+		code = preprocessor.PreprocessCode(files[i], code, &files, &arguments)
+		if len(print.ErrorList) > 0 {
+			return
+		}
+
+		// lexer and parser errors dont stop us, the parser recovers and the binder can still find more
+		lexes = append(lexes, lexer.Lex([]rune(code), files[i]))
+	}
+
+	// parse everything
+	members := make([]nodes.MemberNode, 0)
+	for _, tokens := range lexes {
+		members = append(parser.Parse(tokens), members...)
+	}
+
+	binder.BindProgram(members)
+	return
+}
+
+// ReadSource gets a file's code, open documents are preferred over whatever is on disk
+func (srv *Server) ReadSource(path string) string {
+	code := ""
+
+	doc, ok := srv.Documents[path]
+	if ok {
+		code = doc.Text
+	} else {
+		code = string(preprocessor.ReadFile(path, print.TextSpan{}))
+	}
+
+	code = strings.Replace(code, "\r", "", -1)
+	print.SourceFiles[path] = code
+
+	return code
+}
+
+// ResetCompiler forgets everything the last check left behind
+func ResetCompiler() {
+	print.ErrorList = make([]print.ErrorReport, 0)
+	print.WarningList = make([]print.ErrorReport, 0)
+	print.SourceFiles = make(map[string]string)
+	print.WarningSuppressions = make([]print.WarningSuppression, 0)
+	packager.PackagesSoFar = make([]symbols.PackageSymbol, 0)
+	langserverinterface.Reset()
+}
+
+func CollectReports() []Report {
+	reports := make([]Report, 0)
+
+	for _, report := range print.ErrorList {
+		reports = append(reports, Report{report, SeverityError})
+	}
+
+	for _, report := range print.WarningList {
+		reports = append(reports, Report{report, SeverityWarning})
+	}
+
+	return reports
+}
+
+func (srv *Server) CreateDiagnostic(report Report) Diagnostic {
+	return Diagnostic{
+		Range:    SpanToRange(report.Report.Span),
+		Severity: report.Severity,
+		Code:     int(print.ErrorTypeToCode(report.Report.ErrType)),
+		Source:   "rgoc",
+		Message:  fmt.Sprintf(report.Report.Message, report.Report.MessageArgs...),
+	}
+}
+
+// <POSITIONS> ----------------------------------------------------------------
+
+// SpanToRange converts our spans (1-indexed lines and rune columns) into LSP ranges
+func SpanToRange(span print.TextSpan) Range {
+	// no location? -> top of the file it is
+	if span.StartLine <= 0 {
+		return Range{}
+	}
+
+	lines := strings.Split(print.SourceFiles[span.File], "\n")
+
+	return Range{
+		Start: ColumnToPosition(lines, span.StartLine-1, span.StartColumn-1),
+		End:   ColumnToPosition(lines, span.EndLine-1, span.EndColumn-1),
+	}
+}
+
+func ColumnToPosition(lines []string, line int, column int) Position {
+	if line < 0 {
+		line = 0
+	}
+
+	if column < 0 {
+		column = 0
+	}
+
+	// outside the file? (the preprocessor might have added some code) -> just trust the numbers
+	if line >= len(lines) {
+		return Position{line, column}
+	}
+
+	return Position{line, RuneColumnToUTF16(lines[line], column)}
+}
+
+// PositionToColumn converts an LSP position back into our 1-indexed line and rune column
+func PositionToColumn(path string, pos Position) (int, int) {
+	lines := strings.Split(print.SourceFiles[path], "\n")
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return pos.Line + 1, pos.Character + 1
+	}
+
+	units := 0
+	for i, r := range []rune(lines[pos.Line]) {
+		if units >= pos.Character {
+			return pos.Line + 1, i + 1
+		}
+
+		if r >= 0x10000 && utf8.ValidRune(r) {
+			units += 2
+		} else {
+			units++
+		}
+	}
+
+	return pos.Line + 1, utf8.RuneCountInString(lines[pos.Line]) + pos.Character - units + 1
+}
+
+// RuneColumnToUTF16 converts a column counted in runes to one counted in UTF-16 code units (which LSP uses)
+func RuneColumnToUTF16(line string, column int) int {
+	units := 0
+	for i, r := range []rune(line) {
+		if i >= column {
+			return units
+		}
+
+		if r >= 0x10000 && utf8.ValidRune(r) {
+			units += 2
+		} else {
+			units++
+		}
+	}
+
+	// anything after the end of the line counts as one unit per column
+	return units + column - utf8.RuneCountInString(line)
+}
+
+// </POSITIONS> ---------------------------------------------------------------
+
+// AbsolutePath resolves file paths relative to the given document's directory
+func AbsolutePath(docPath string, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(filepath.Dir(docPath), path)
+}
+
+// FileURI gives back the uri the client uses for a file (or makes one up if it's not open)
+func (srv *Server) FileURI(path string) string {
+	doc, ok := srv.Documents[path]
+	if ok {
+		return doc.URI
+	}
+
+	return PathToURI(path)
+}
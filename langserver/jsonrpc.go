@@ -0,0 +1,85 @@
+package langserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// LSP talks JSON-RPC 2.0, every message is a small header followed by a JSON body:
+//
+//   Content-Length: 42\r\n
+//   \r\n
+//   {"jsonrpc":"2.0","method":"...",...}
+
+// Message is anything that goes over the wire
+// (requests have an ID and a method, notifications only a method, responses only an ID)
+type Message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *ResponseError   `json:"error,omitempty"`
+}
+
+type ResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// error codes defined by JSON-RPC and LSP
+const (
+	ParseError           = -32700
+	InvalidRequest       = -32600
+	MethodNotFound       = -32601
+	InvalidParams        = -32602
+	InternalError        = -32603
+	ServerNotInitialized = -32002
+	RequestFailed        = -32803
+)
+
+// IsRequest tells requests (which want an answer) apart from notifications (which don't)
+func (msg Message) IsRequest() bool {
+	return msg.ID != nil
+}
+
+// ReadMessage reads the next message from the client
+func ReadMessage(in *bufio.Reader) (Message, error) {
+	headers, err := textproto.NewReader(in).ReadMIMEHeader()
+	if err != nil {
+		return Message{}, err
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(headers.Get("Content-Length")))
+	if err != nil {
+		return Message{}, fmt.Errorf("invalid Content-Length header: %v", err)
+	}
+
+	body := make([]byte, length)
+	_, err = io.ReadFull(in, body)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg := Message{}
+	err = json.Unmarshal(body, &msg)
+	return msg, err
+}
+
+// WriteMessage sends a message to the client
+func WriteMessage(out io.Writer, msg Message) error {
+	msg.JSONRPC = "2.0"
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
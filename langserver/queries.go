@@ -0,0 +1,126 @@
+package langserver
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// the compiler state only ever belongs to one document, make sure it's the one we're asking about
+func (srv *Server) Prepare(uri string) (*Document, bool) {
+	doc, ok := srv.Documents[URIToPath(uri)]
+	if !ok {
+		return nil, false
+	}
+
+	if srv.LastChecked != doc {
+		srv.Check(doc)
+	}
+
+	return doc, true
+}
+
+// Definition answers textDocument/definition
+func (srv *Server) Definition(params TextDocumentPositionParams) []Location {
+	locations := make([]Location, 0)
+
+	doc, ok := srv.Prepare(params.TextDocument.URI)
+	if !ok {
+		return locations
+	}
+
+	line, column := PositionToColumn(doc.Path, params.Position)
+	definition, ok := langserverinterface.DefinitionAt(doc.Path, line, column)
+	if !ok {
+		return locations
+	}
+
+	return append(locations, srv.SpanToLocation(definition.Span))
+}
+
+// References answers textDocument/references
+func (srv *Server) References(params ReferenceParams) []Location {
+	locations := make([]Location, 0)
+
+	doc, ok := srv.Prepare(params.TextDocument.URI)
+	if !ok {
+		return locations
+	}
+
+	line, column := PositionToColumn(doc.Path, params.Position)
+	definition, ok := langserverinterface.DefinitionAt(doc.Path, line, column)
+	if !ok {
+		return locations
+	}
+
+	for _, reference := range langserverinterface.ReferencesOf(definition) {
+		if reference.IsDeclaration && !params.Context.IncludeDeclaration {
+			continue
+		}
+
+		locations = append(locations, srv.SpanToLocation(reference.Token.Span))
+	}
+
+	return locations
+}
+
+func (srv *Server) SpanToLocation(span print.TextSpan) Location {
+	return Location{
+		URI:   srv.FileURI(span.File),
+		Range: SpanToRange(span),
+	}
+}
+
+// Completion answers textDocument/completion
+func (srv *Server) Completion(params TextDocumentPositionParams) []CompletionItem {
+	items := make([]CompletionItem, 0)
+
+	doc, ok := srv.Prepare(params.TextDocument.URI)
+	if !ok {
+		return items
+	}
+
+	// half typed code rarely makes it to the binder, the scopes from the last time it did are close enough
+	if len(langserverinterface.Scopes) == 0 {
+		langserverinterface.Scopes = doc.Scopes
+	}
+
+	line, column := PositionToColumn(doc.Path, params.Position)
+	for _, completion := range langserverinterface.CompletionsAt(doc.Path, line, column) {
+		items = append(items, CompletionItem{
+			Label:  completion.Label,
+			Kind:   CompletionItemKindOf(completion.Kind),
+			Detail: completion.Detail,
+		})
+	}
+
+	return items
+}
+
+func CompletionItemKindOf(kind langserverinterface.CompletionKind) CompletionItemKind {
+	switch kind {
+	case langserverinterface.MethodCompletion, langserverinterface.TypeFunctionCompletion:
+		return CompletionKindMethod
+	case langserverinterface.FunctionCompletion:
+		return CompletionKindFunction
+	case langserverinterface.FieldCompletion:
+		return CompletionKindField
+	case langserverinterface.ClassCompletion:
+		return CompletionKindClass
+	case langserverinterface.InterfaceCompletion:
+		return CompletionKindInterface
+	case langserverinterface.StructCompletion:
+		return CompletionKindStruct
+	case langserverinterface.EnumCompletion:
+		return CompletionKindEnum
+	case langserverinterface.EnumFieldCompletion:
+		return CompletionKindEnumMember
+	case langserverinterface.PackageCompletion:
+		return CompletionKindModule
+	case langserverinterface.TypeCompletion:
+		return CompletionKindTypeParameter
+	case langserverinterface.KeywordCompletion:
+		return CompletionKindKeyword
+	}
+
+	return CompletionKindVariable
+}
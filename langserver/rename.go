@@ -0,0 +1,129 @@
+package langserver
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Rename answers textDocument/rename
+func (srv *Server) Rename(params RenameParams) (*WorkspaceEdit, error) {
+	doc, ok := srv.Prepare(params.TextDocument.URI)
+	if !ok {
+		return nil, fmt.Errorf("this document isn't open")
+	}
+
+	line, column := PositionToColumn(doc.Path, params.Position)
+	edits, err := langserverinterface.Rename(doc.Path, line, column, params.NewName)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceEdit := &WorkspaceEdit{Changes: make(map[string][]TextEdit)}
+	for _, edit := range edits {
+		uri := srv.FileURI(edit.Span.File)
+		workspaceEdit.Changes[uri] = append(workspaceEdit.Changes[uri], TextEdit{
+			Range:   SpanToRange(edit.Span),
+			NewText: edit.NewText,
+		})
+	}
+
+	return workspaceEdit, nil
+}
+
+// RenameOnDisk renames the symbol at the given position (1-indexed line and column) and writes the changes straight into the files
+// it gives back every file that got changed
+func RenameOnDisk(path string, line int, column int, newName string) ([]string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// no client here, we just check the file as it is on disk
+	srv := CreateServer(strings.NewReader(""), nil, "")
+	doc := &Document{Path: path, Text: string(source)}
+
+	_, reports := srv.Check(doc)
+	for _, report := range reports {
+		// renaming in code the compiler doesn't understand would just be guessing
+		if report.Severity == SeverityError {
+			message := fmt.Sprintf(report.Report.Message, report.Report.MessageArgs...)
+			return nil, fmt.Errorf("there are errors in the code, fix those before renaming anything (%s)", message)
+		}
+	}
+
+	edits, err := langserverinterface.Rename(path, line, column, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	byFile := make(map[string][]langserverinterface.TextEdit)
+	for _, edit := range edits {
+		file := AbsolutePath(path, edit.Span.File)
+		byFile[file] = append(byFile[file], edit)
+	}
+
+	changed := make([]string, 0)
+	for file, fileEdits := range byFile {
+		err := ApplyEdits(file, fileEdits)
+		if err != nil {
+			return changed, err
+		}
+
+		changed = append(changed, file)
+	}
+
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// ApplyEdits rewrites a file on disk with the given edits applied
+func ApplyEdits(file string, edits []langserverinterface.TextEdit) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+
+	original, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	// the compiler never sees any \r, so neither do the spans
+	crlf := bytes.Contains(original, []byte("\r\n"))
+	code := []rune(strings.Replace(string(original), "\r", "", -1))
+
+	// back to front, that way earlier offsets stay valid
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].Span.StartLine != edits[j].Span.StartLine {
+			return edits[i].Span.StartLine > edits[j].Span.StartLine
+		}
+
+		return edits[i].Span.StartColumn > edits[j].Span.StartColumn
+	})
+
+	for _, edit := range edits {
+		start := langserverinterface.RuneOffset(code, edit.Span.StartLine, edit.Span.StartColumn)
+		end := langserverinterface.RuneOffset(code, edit.Span.EndLine, edit.Span.EndColumn)
+
+		result := append([]rune{}, code[:start]...)
+		result = append(result, []rune(edit.NewText)...)
+		code = append(result, code[end:]...)
+	}
+
+	output := string(code)
+	if crlf {
+		output = strings.Replace(output, "\n", "\r\n", -1)
+	}
+
+	return os.WriteFile(file, []byte(output), info.Mode().Perm())
+}
@@ -0,0 +1,327 @@
+package langserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// the language server speaks LSP over stdin/stdout
+// editors send us the code they have open, we run it through the compiler (up until the binder)
+// and send back whatever errors and warnings come out the other end
+
+type Server struct {
+	In  *bufio.Reader
+	Out io.Writer
+
+	// the version of the compiler we're reporting to the client
+	Version string
+
+	// all documents the client currently has open, by file path
+	Documents map[string]*Document
+
+	// the document the compiler state currently belongs to
+	// (queries about any other document need to check that one again first)
+	LastChecked *Document
+
+	Initialized      bool
+	ShutdownReceived bool
+}
+
+// Document is an open file, the client tells us whenever it changes
+type Document struct {
+	URI     string
+	Path    string
+	Text    string
+	Version int
+
+	// every file that got checked along with this one last time (itself + #source files)
+	Files []string
+
+	// the scopes from the last time this made it through the binder
+	// (code that's being typed usually doesn't, but completions still need something to go off of)
+	Scopes []langserverinterface.ScopeRecord
+}
+
+func CreateServer(in io.Reader, out io.Writer, version string) *Server {
+	return &Server{
+		In:        bufio.NewReader(in),
+		Out:       out,
+		Version:   version,
+		Documents: make(map[string]*Document),
+	}
+}
+
+// Serve handles messages until the client tells us to exit, the returned value is our exit code
+func (srv *Server) Serve() int {
+	for {
+		msg, err := ReadMessage(srv.In)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// client's gone, no point in sticking around
+			return 1
+		}
+
+		if err != nil {
+			srv.Log("could not read message: %s", err.Error())
+			srv.Respond(msg, nil, &ResponseError{ParseError, err.Error()})
+			continue
+		}
+
+		if msg.Method == "exit" {
+			if srv.ShutdownReceived {
+				return 0
+			}
+			return 1
+		}
+
+		srv.Handle(msg)
+	}
+}
+
+func (srv *Server) Handle(msg Message) {
+	// nothing but initialize is allowed before initialize
+	if !srv.Initialized && msg.Method != "initialize" {
+		if msg.IsRequest() {
+			srv.Respond(msg, nil, &ResponseError{ServerNotInitialized, "server has not been initialized yet"})
+		}
+		return
+	}
+
+	switch msg.Method {
+	case "initialize":
+		srv.Initialized = true
+		srv.Respond(msg, srv.Capabilities(), nil)
+
+	case "initialized":
+		// cool, we know
+
+	case "shutdown":
+		srv.ShutdownReceived = true
+		srv.Respond(msg, nil, nil)
+
+	case "textDocument/didOpen":
+		params := DidOpenTextDocumentParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.OpenDocument(params)
+		}
+
+	case "textDocument/didChange":
+		params := DidChangeTextDocumentParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.ChangeDocument(params)
+		}
+
+	case "textDocument/didSave":
+		params := DidSaveTextDocumentParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.SaveDocument(params)
+		}
+
+	case "textDocument/didClose":
+		params := DidCloseTextDocumentParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.CloseDocument(params)
+		}
+
+	case "textDocument/definition":
+		params := TextDocumentPositionParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.Respond(msg, srv.Definition(params), nil)
+		}
+
+	case "textDocument/references":
+		params := ReferenceParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.Respond(msg, srv.References(params), nil)
+		}
+
+	case "textDocument/completion":
+		params := TextDocumentPositionParams{}
+		if srv.Unmarshal(msg, &params) {
+			srv.Respond(msg, srv.Completion(params), nil)
+		}
+
+	case "textDocument/rename":
+		params := RenameParams{}
+		if srv.Unmarshal(msg, &params) {
+			edit, err := srv.Rename(params)
+			if err != nil {
+				srv.Respond(msg, nil, &ResponseError{RequestFailed, err.Error()})
+			} else {
+				srv.Respond(msg, edit, nil)
+			}
+		}
+
+	default:
+		// notifications we dont know can be ignored, requests need an answer though
+		if msg.IsRequest() {
+			srv.Respond(msg, nil, &ResponseError{MethodNotFound, "method \"" + msg.Method + "\" is not supported"})
+		}
+	}
+}
+
+func (srv *Server) Capabilities() InitializeResult {
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncOptions{
+				OpenClose: true,
+				Change:    SyncFull,
+				Save:      true,
+			},
+			DefinitionProvider: true,
+			ReferencesProvider: true,
+			CompletionProvider: CompletionOptions{
+				TriggerCharacters: []string{">", ":"},
+			},
+			RenameProvider: true,
+		},
+		ServerInfo: ServerInfo{
+			Name:    "rgoc",
+			Version: srv.Version,
+		},
+	}
+}
+
+// <DOCUMENTS> ----------------------------------------------------------------
+
+func (srv *Server) OpenDocument(params DidOpenTextDocumentParams) {
+	path := URIToPath(params.TextDocument.URI)
+
+	doc := &Document{
+		URI:     params.TextDocument.URI,
+		Path:    path,
+		Text:    params.TextDocument.Text,
+		Version: params.TextDocument.Version,
+	}
+
+	srv.Documents[path] = doc
+	srv.Analyse(doc)
+}
+
+func (srv *Server) ChangeDocument(params DidChangeTextDocumentParams) {
+	doc, ok := srv.Documents[URIToPath(params.TextDocument.URI)]
+	if !ok || len(params.ContentChanges) == 0 {
+		return
+	}
+
+	// we asked for full syncs, so the last change holds the entire document
+	doc.Text = params.ContentChanges[len(params.ContentChanges)-1].Text
+	doc.Version = params.TextDocument.Version
+
+	srv.Analyse(doc)
+}
+
+func (srv *Server) SaveDocument(params DidSaveTextDocumentParams) {
+	// files this one depends on might have changed on disk, so just check again
+	doc, ok := srv.Documents[URIToPath(params.TextDocument.URI)]
+	if ok {
+		srv.Analyse(doc)
+	}
+}
+
+func (srv *Server) CloseDocument(params DidCloseTextDocumentParams) {
+	path := URIToPath(params.TextDocument.URI)
+	delete(srv.Documents, path)
+
+	if srv.LastChecked != nil && srv.LastChecked.Path == path {
+		srv.LastChecked = nil
+	}
+
+	// errors of closed files shouldnt stick around in the editor
+	srv.PublishDiagnostics(params.TextDocument.URI, make([]Diagnostic, 0))
+}
+
+// </DOCUMENTS> ---------------------------------------------------------------
+// <MESSAGING> ----------------------------------------------------------------
+
+func (srv *Server) Respond(request Message, result interface{}, err *ResponseError) {
+	// notifications dont get answers, not even if they're broken
+	if !request.IsRequest() && err == nil {
+		return
+	}
+
+	id := request.ID
+	if id == nil {
+		// the spec wants a null id if we couldnt figure out which request this was
+		null := json.RawMessage("null")
+		id = &null
+	}
+
+	response := Message{ID: id, Error: err}
+	if err == nil {
+		// a missing result would make this look like a notification
+		response.Result = result
+		if result == nil {
+			response.Result = json.RawMessage("null")
+		}
+	}
+
+	srv.Send(response)
+}
+
+func (srv *Server) Notify(method string, params interface{}) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		srv.Log("could not encode \"%s\" notification: %s", method, err.Error())
+		return
+	}
+
+	srv.Send(Message{Method: method, Params: body})
+}
+
+func (srv *Server) Send(msg Message) {
+	err := WriteMessage(srv.Out, msg)
+	if err != nil {
+		srv.Log("could not send message: %s", err.Error())
+	}
+}
+
+func (srv *Server) Unmarshal(msg Message, params interface{}) bool {
+	err := json.Unmarshal(msg.Params, params)
+	if err != nil {
+		srv.Respond(msg, nil, &ResponseError{InvalidParams, err.Error()})
+		return false
+	}
+
+	return true
+}
+
+// Log writes to stderr, stdout belongs to the client
+func (srv *Server) Log(message string, fargs ...interface{}) {
+	fmt.Fprintf(os.Stderr, "[LSP] "+message+"\n", fargs...)
+}
+
+// </MESSAGING> ---------------------------------------------------------------
+
+// URIToPath turns a file:// uri into a file path (and leaves everything else alone)
+func URIToPath(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "file" {
+		return uri
+	}
+
+	path := filepath.FromSlash(parsed.Path)
+
+	// windows paths come in as /C:/...
+	if len(path) > 2 && path[2] == ':' && (path[0] == '/' || path[0] == '\\') {
+		path = path[1:]
+	}
+
+	return path
+}
+
+// PathToURI does the opposite of URIToPath
+func PathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
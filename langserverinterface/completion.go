@@ -0,0 +1,856 @@
+package langserverinterface
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// the binder tells us about every scope it opens and which part of the code it covers
+// when somebody asks for completions, we find the innermost scope around the cursor and list what's visible from there
+// (everything that comes before "->" and "::" gets figured out from the text, so this keeps working while the code is half typed)
+
+// CompletionScope is everything we need from a scope (that's the binder's Scope, which we can't import)
+type CompletionScope interface {
+	TryLookupSymbol(name string) symbols.Symbol
+	GetAllVariables() []symbols.VariableSymbol
+	GetAllFunctions() []symbols.FunctionSymbol
+	GetAllClasses() []symbols.ClassSymbol
+	GetAllInterfaces() []symbols.InterfaceSymbol
+	GetAllStructs() []symbols.StructSymbol
+	GetAllEnums() []symbols.EnumSymbol
+	GetAllPackages() []symbols.PackageSymbol
+}
+
+// ScopeRecord is a scope along with the code it covers
+type ScopeRecord struct {
+	Span  print.TextSpan      // empty for the global scope (that one covers everything)
+	Scope CompletionScope     // wat can we see?
+	Class symbols.ClassSymbol // the class we're in (if we're in one)
+}
+
+// Scopes holds every scope the binder registered
+var Scopes []ScopeRecord
+
+type CompletionKind string
+
+const (
+	VariableCompletion     CompletionKind = "Variable"
+	ParameterCompletion    CompletionKind = "Parameter"
+	FieldCompletion        CompletionKind = "Field"
+	FunctionCompletion     CompletionKind = "Function"
+	MethodCompletion       CompletionKind = "Method"
+	TypeFunctionCompletion CompletionKind = "TypeFunction"
+	ClassCompletion        CompletionKind = "Class"
+	InterfaceCompletion    CompletionKind = "Interface"
+	StructCompletion       CompletionKind = "Struct"
+	EnumCompletion         CompletionKind = "Enum"
+	EnumFieldCompletion    CompletionKind = "EnumField"
+	PackageCompletion      CompletionKind = "Package"
+	TypeCompletion         CompletionKind = "Type"
+	KeywordCompletion      CompletionKind = "Keyword"
+)
+
+// Completion is something that could go where the cursor is
+type Completion struct {
+	Label  string
+	Kind   CompletionKind
+	Detail string // the signature or type of whatever this is
+}
+
+// RegisterScope remembers a scope and the span it covers
+func RegisterScope(span print.TextSpan, scope CompletionScope, class symbols.ClassSymbol) {
+	Scopes = append(Scopes, ScopeRecord{Span: span, Scope: scope, Class: class})
+}
+
+// ScopeAt finds the innermost scope around the given position (falls back to the global scope)
+func ScopeAt(file string, line int, column int) (ScopeRecord, bool) {
+	var found ScopeRecord
+	ok := false
+
+	for _, record := range Scopes {
+		if record.Span.File == "" {
+			if !ok {
+				found = record
+				ok = true
+			}
+			continue
+		}
+
+		if record.Span.File != file || !SpanContains(record.Span, line, column) {
+			continue
+		}
+
+		// smaller scopes are further inside
+		if !ok || found.Span.File == "" || SpanLength(record.Span) < SpanLength(found.Span) {
+			found = record
+			ok = true
+		}
+	}
+
+	return found, ok
+}
+
+// GlobalScope finds the scope global statements were bound in
+func GlobalScope() (ScopeRecord, bool) {
+	for _, record := range Scopes {
+		if record.Span.File == "" {
+			return record, true
+		}
+	}
+
+	return ScopeRecord{}, false
+}
+
+// LookupEnum finds an enum by name (enums only live in the global scope, just like the binder's LookupEnum expects)
+func LookupEnum(record ScopeRecord, name string) (symbols.EnumSymbol, bool) {
+	sym := record.Scope.TryLookupSymbol(name)
+	if sym == nil {
+		global, ok := GlobalScope()
+		if !ok {
+			return symbols.EnumSymbol{}, false
+		}
+
+		sym = global.Scope.TryLookupSymbol(name)
+	}
+
+	if sym == nil || sym.SymbolType() != symbols.Enum {
+		return symbols.EnumSymbol{}, false
+	}
+
+	return sym.(symbols.EnumSymbol), true
+}
+
+func SpanLength(span print.TextSpan) int {
+	return span.EndIndex - span.StartIndex
+}
+
+// <COMPLETIONS> --------------------------------------------------------------
+// (lines and columns start at 1, just like everywhere else in here)
+
+// CompletionsAt lists everything that could be typed at the given position
+func CompletionsAt(file string, line int, column int) []Completion {
+	code := []rune(print.SourceFiles[file])
+	offset := RuneOffset(code, line, column)
+
+	record, ok := ScopeAt(file, line, column)
+	if !ok {
+		// the binder never got anywhere, keywords are all we've got
+		return KeywordCompletions()
+	}
+
+	// whatever has been typed of the current word doesn't matter, the editor filters that for us
+	start := offset
+	for start > 0 && IsIdentifierRune(code[start-1]) {
+		start--
+	}
+
+	before := strings.TrimRight(string(code[:start]), " \t")
+
+	// member access (variable->Thing)
+	if strings.HasSuffix(before, "->") {
+		base := []rune(strings.TrimSuffix(before, "->"))
+		return MemberCompletions(record, base)
+	}
+
+	// package access (sys::Thing)
+	if strings.HasSuffix(before, "::") {
+		base := []rune(strings.TrimRight(strings.TrimSuffix(before, "::"), " \t"))
+
+		end := len(base)
+		for end > 0 && IsIdentifierRune(base[end-1]) {
+			end--
+		}
+
+		return PackageCompletions(record, string(base[end:]))
+	}
+
+	return ScopeCompletions(record, file, line, column)
+}
+
+// ScopeCompletions lists everything visible from a scope, along with all types and keywords
+func ScopeCompletions(record ScopeRecord, file string, line int, column int) []Completion {
+	completions := make([]Completion, 0)
+	seen := make(map[string]bool)
+
+	// inner scopes come first, so shadowed symbols get skipped
+	add := func(completion Completion) {
+		if seen[completion.Label] || !IsIdentifier(completion.Label) {
+			return
+		}
+
+		seen[completion.Label] = true
+		completions = append(completions, completion)
+	}
+
+	for _, variable := range record.Scope.GetAllVariables() {
+		// locals declared after the cursor don't exist yet
+		if variable.SymbolType() == symbols.LocalVariable {
+			declaration, ok := VariableDeclarations[variable.Fingerprint()]
+			if ok && declaration.Span.File == file &&
+				(declaration.Span.StartLine > line || (declaration.Span.StartLine == line && declaration.Span.StartColumn >= column)) {
+				continue
+			}
+		}
+
+		kind := VariableCompletion
+		if variable.SymbolType() == symbols.Parameter {
+			kind = ParameterCompletion
+		} else if IsFieldOf(record.Class, variable) {
+			kind = FieldCompletion
+		}
+
+		add(Completion{variable.SymbolName(), kind, TypeName(variable.VarType())})
+	}
+
+	for _, fnc := range record.Scope.GetAllFunctions() {
+		kind := FunctionCompletion
+		if IsFunctionOf(record.Class, fnc) {
+			kind = MethodCompletion
+		}
+
+		add(Completion{fnc.Name, kind, FunctionSignature(fnc.Name, fnc.Parameters, fnc.Type)})
+	}
+
+	for _, cls := range record.Scope.GetAllClasses() {
+		add(Completion{cls.Name, ClassCompletion, "class " + cls.Name})
+	}
+
+	for _, iface := range record.Scope.GetAllInterfaces() {
+		add(Completion{iface.Name, InterfaceCompletion, "interface " + iface.Name})
+	}
+
+	for _, stc := range record.Scope.GetAllStructs() {
+		add(Completion{stc.Name, StructCompletion, "struct " + stc.Name})
+	}
+
+	enums := record.Scope.GetAllEnums()
+	if global, ok := GlobalScope(); ok {
+		enums = append(enums, global.Scope.GetAllEnums()...)
+	}
+
+	for _, enm := range enums {
+		add(Completion{enm.Name, EnumCompletion, "enum " + enm.Name})
+	}
+
+	for _, pck := range record.Scope.GetAllPackages() {
+		add(Completion{pck.Name, PackageCompletion, "package " + pck.Name})
+	}
+
+	for _, typ := range builtins.Types {
+		// these two only exist inside the compiler
+		if typ.Name == builtins.PArray.Name || typ.Name == builtins.Enum.Name {
+			continue
+		}
+
+		add(Completion{typ.Name, TypeCompletion, typ.Name})
+	}
+
+	for _, keyword := range KeywordCompletions() {
+		add(keyword)
+	}
+
+	return completions
+}
+
+// KeywordCompletions lists all keywords the lexer knows
+func KeywordCompletions() []Completion {
+	keywords := make([]string, 0, len(lexer.Keywords))
+	for keyword := range lexer.Keywords {
+		keywords = append(keywords, keyword)
+	}
+
+	sort.Strings(keywords)
+
+	completions := make([]Completion, 0, len(keywords))
+	for _, keyword := range keywords {
+		completions = append(completions, Completion{keyword, KeywordCompletion, "keyword"})
+	}
+
+	return completions
+}
+
+// PackageCompletions lists everything inside of a package
+func PackageCompletions(record ScopeRecord, name string) []Completion {
+	completions := make([]Completion, 0)
+
+	pck, ok := LookupPackage(record, name)
+	if !ok {
+		return completions
+	}
+
+	for _, fnc := range pck.Functions {
+		completions = append(completions, Completion{fnc.Name, FunctionCompletion, FunctionSignature(fnc.Name, fnc.Parameters, fnc.Type)})
+	}
+
+	for _, cls := range pck.Classes {
+		completions = append(completions, Completion{cls.Name, ClassCompletion, "class " + pck.Name + "::" + cls.Name})
+	}
+
+	return completions
+}
+
+// MemberCompletions lists whatever comes after the "->" following the given code
+func MemberCompletions(record ScopeRecord, base []rune) []Completion {
+	completions := make([]Completion, 0)
+
+	chain, ok := ParseAccessChain(base)
+	if !ok {
+		return completions
+	}
+
+	// an enum on its own? -> its fields are what we're after
+	if len(chain) == 1 && !chain[0].IsCall && chain[0].Indices == 0 && chain[0].Package == "" {
+		enm, ok := LookupEnum(record, chain[0].Name)
+		if ok {
+			return EnumFieldCompletions(enm)
+		}
+	}
+
+	typ, ok := ResolveAccessChain(record, chain)
+	if !ok {
+		return completions
+	}
+
+	return TypeMemberCompletions(record, typ)
+}
+
+// TypeMemberCompletions lists every field and function a value of the given type has
+func TypeMemberCompletions(record ScopeRecord, typ symbols.TypeSymbol) []Completion {
+	completions := make([]Completion, 0)
+
+	// built-in types only have type functions
+	if !typ.IsUserDefined {
+		for _, fnc := range TypeFunctionsOf(typ) {
+			completions = append(completions, Completion{fnc.Name, TypeFunctionCompletion, FunctionSignature(fnc.Name, fnc.Parameters, fnc.Type)})
+		}
+
+		return completions
+	}
+
+	switch sym := LookupTypeSource(record, typ).(type) {
+	case symbols.ClassSymbol:
+		for _, fld := range sym.Fields {
+			completions = append(completions, Completion{fld.SymbolName(), FieldCompletion, TypeName(fld.VarType())})
+		}
+
+		for _, fnc := range sym.Functions {
+			// constructors can't be called and private functions are only visible inside their own class
+			if fnc.Name == "Constructor" || (!fnc.Public && record.Class.Name != sym.Name) {
+				continue
+			}
+
+			completions = append(completions, Completion{fnc.Name, MethodCompletion, FunctionSignature(fnc.Name, fnc.Parameters, fnc.Type)})
+		}
+
+	case symbols.InterfaceSymbol:
+		for _, fnc := range sym.Functions {
+			completions = append(completions, Completion{fnc.Name, MethodCompletion, FunctionSignature(fnc.Name, fnc.Parameters, fnc.Type)})
+		}
+
+	case symbols.StructSymbol:
+		for _, fld := range sym.Fields {
+			completions = append(completions, Completion{fld.SymbolName(), FieldCompletion, TypeName(fld.VarType())})
+		}
+	}
+
+	return completions
+}
+
+// EnumFieldCompletions lists all fields of an enum (in the order they were declared in)
+func EnumFieldCompletions(enm symbols.EnumSymbol) []Completion {
+	fields := make([]lexer.Token, 0, len(enm.Declaration.Fields))
+	for field := range enm.Declaration.Fields {
+		fields = append(fields, field)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Span.StartIndex < fields[j].Span.StartIndex
+	})
+
+	completions := make([]Completion, 0, len(fields))
+	for _, field := range fields {
+		value, ok := enm.Fields[field.Value]
+		if !ok {
+			continue
+		}
+
+		completions = append(completions, Completion{field.Value, EnumFieldCompletion, fmt.Sprintf("%s = %d", enm.Name, value)})
+	}
+
+	return completions
+}
+
+// TypeFunctionsOf lists the built-in functions of a type (the same ones the binder's LookupTypeFunction hands out)
+func TypeFunctionsOf(typ symbols.TypeSymbol) []symbols.TypeFunctionSymbol {
+	switch typ.Name {
+	case builtins.String.Name:
+		return []symbols.TypeFunctionSymbol{builtins.GetLength, builtins.GetBuffer, builtins.Substring}
+
+	case builtins.Array.Name:
+		push := builtins.Push
+		if len(typ.SubTypes) > 0 {
+			push.Parameters = []symbols.ParameterSymbol{symbols.CreateParameterSymbol("element", 0, typ.SubTypes[0])}
+		}
+
+		return []symbols.TypeFunctionSymbol{builtins.GetArrayLength, push}
+
+	case builtins.Map.Name:
+		has, remove, keys := builtins.Has, builtins.Remove, builtins.Keys
+		if len(typ.SubTypes) > 0 {
+			has.Parameters = []symbols.ParameterSymbol{symbols.CreateParameterSymbol("key", 0, typ.SubTypes[0])}
+			remove.Parameters = has.Parameters
+			keys.Type = symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{typ.SubTypes[0]}, true, false, false, symbols.PackageSymbol{}, nil)
+		}
+
+		return []symbols.TypeFunctionSymbol{builtins.MapGetLength, has, remove, keys}
+
+	case builtins.Action.Name:
+		run, runThread := builtins.Run, builtins.RunThread
+		if len(typ.SubTypes) > 0 {
+			run.Type = typ.SubTypes[len(typ.SubTypes)-1]
+
+			for i, sub := range typ.SubTypes[:len(typ.SubTypes)-1] {
+				run.Parameters = append(run.Parameters, symbols.CreateParameterSymbol(fmt.Sprintf("prm_%d", i), i, sub))
+			}
+
+			runThread.Parameters = run.Parameters
+		}
+
+		return []symbols.TypeFunctionSymbol{run, runThread}
+
+	case builtins.Thread.Name:
+		return []symbols.TypeFunctionSymbol{builtins.Join, builtins.Kill}
+
+	case builtins.Exception.Name:
+		return []symbols.TypeFunctionSymbol{builtins.GetMessage}
+	}
+
+	return []symbols.TypeFunctionSymbol{}
+}
+
+// </COMPLETIONS> -------------------------------------------------------------
+// <ACCESS CHAINS> ------------------------------------------------------------
+
+// AccessSegment is one piece of something like "pkg::a(1)->b->c[2]"
+type AccessSegment struct {
+	Name    string
+	Package string // the package it's in (if it was written like pkg::Name)
+	IsCall  bool   // Name(...)
+	Indices int    // Name[...][...]
+	Literal bool   // a string literal instead of a name
+}
+
+// ParseAccessChain goes backwards through the given code and picks apart the access chain at its end
+func ParseAccessChain(code []rune) ([]AccessSegment, bool) {
+	chain := make([]AccessSegment, 0)
+	i := len(code)
+
+	for {
+		i = skipSpacesBackwards(code, i)
+		segment := AccessSegment{}
+
+		// calls and indices
+		for i > 0 && (code[i-1] == ')' || code[i-1] == ']') {
+			if code[i-1] == ')' {
+				segment.IsCall = true
+			} else {
+				segment.Indices++
+			}
+
+			i = matchBracketBackwards(code, i-1)
+			if i < 0 {
+				return nil, false
+			}
+
+			i = skipSpacesBackwards(code, i)
+		}
+
+		// the name itself (or a string)
+		end := i
+		for i > 0 && IsIdentifierRune(code[i-1]) {
+			i--
+		}
+
+		segment.Name = string(code[i:end])
+
+		if segment.Name == "" {
+			if i == 0 || code[i-1] != '"' || segment.IsCall {
+				return nil, false
+			}
+
+			i = skipStringBackwards(code, i-1)
+			if i < 0 {
+				return nil, false
+			}
+
+			segment.Literal = true
+		}
+
+		// is this inside of a package?
+		if i >= 2 && code[i-1] == ':' && code[i-2] == ':' {
+			end = i - 2
+			i = end
+			for i > 0 && IsIdentifierRune(code[i-1]) {
+				i--
+			}
+
+			segment.Package = string(code[i:end])
+		}
+
+		chain = append([]AccessSegment{segment}, chain...)
+
+		// is there more chain in front of this?
+		i = skipSpacesBackwards(code, i)
+		if i >= 2 && code[i-1] == '>' && code[i-2] == '-' {
+			i -= 2
+			continue
+		}
+
+		return chain, true
+	}
+}
+
+// ResolveAccessChain figures out what type an access chain ends up with
+func ResolveAccessChain(record ScopeRecord, chain []AccessSegment) (symbols.TypeSymbol, bool) {
+	typ, ok := ResolveFirstSegment(record, chain[0])
+	if !ok {
+		return symbols.TypeSymbol{}, false
+	}
+
+	typ, ok = ResolveIndices(typ, chain[0].Indices)
+	if !ok {
+		return symbols.TypeSymbol{}, false
+	}
+
+	for _, segment := range chain[1:] {
+		typ, ok = ResolveMember(record, typ, segment)
+		if !ok {
+			return symbols.TypeSymbol{}, false
+		}
+
+		typ, ok = ResolveIndices(typ, segment.Indices)
+		if !ok {
+			return symbols.TypeSymbol{}, false
+		}
+	}
+
+	return typ, true
+}
+
+func ResolveFirstSegment(record ScopeRecord, segment AccessSegment) (symbols.TypeSymbol, bool) {
+	if segment.Literal {
+		return builtins.String, true
+	}
+
+	if segment.Name == "this" {
+		return record.Class.Type, record.Class.Exists
+	}
+
+	// something from a package
+	if segment.Package != "" {
+		pck, ok := LookupPackage(record, segment.Package)
+		if !ok || !segment.IsCall {
+			return symbols.TypeSymbol{}, false
+		}
+
+		for _, fnc := range pck.Functions {
+			if fnc.Name == segment.Name {
+				return fnc.Type, true
+			}
+		}
+
+		// package class casts
+		for _, cls := range pck.Classes {
+			if cls.Name == segment.Name {
+				return cls.Type, true
+			}
+		}
+
+		return symbols.TypeSymbol{}, false
+	}
+
+	sym := record.Scope.TryLookupSymbol(segment.Name)
+	if sym == nil {
+		// maybe a primitive cast?
+		if segment.IsCall {
+			for _, typ := range builtins.Types {
+				if typ.Name == segment.Name {
+					return typ, true
+				}
+			}
+		}
+
+		return symbols.TypeSymbol{}, false
+	}
+
+	switch sym.SymbolType() {
+	case symbols.LocalVariable, symbols.GlobalVariable, symbols.Parameter:
+		if !segment.IsCall {
+			return sym.(symbols.VariableSymbol).VarType(), true
+		}
+
+	case symbols.Function:
+		if fnc, ok := sym.(symbols.FunctionSymbol); ok && segment.IsCall {
+			return fnc.Type, true
+		}
+
+	case symbols.Class:
+		// class casts
+		if segment.IsCall {
+			return sym.(symbols.ClassSymbol).Type, true
+		}
+	}
+
+	return symbols.TypeSymbol{}, false
+}
+
+// ResolveMember figures out the type of a field access or function call on a value
+func ResolveMember(record ScopeRecord, typ symbols.TypeSymbol, segment AccessSegment) (symbols.TypeSymbol, bool) {
+	if !typ.IsUserDefined {
+		if !segment.IsCall {
+			return symbols.TypeSymbol{}, false
+		}
+
+		for _, fnc := range TypeFunctionsOf(typ) {
+			if fnc.Name == segment.Name {
+				return fnc.Type, true
+			}
+		}
+
+		return symbols.TypeSymbol{}, false
+	}
+
+	switch sym := LookupTypeSource(record, typ).(type) {
+	case symbols.ClassSymbol:
+		if segment.IsCall {
+			for _, fnc := range sym.Functions {
+				if fnc.Name == segment.Name {
+					return fnc.Type, true
+				}
+			}
+		} else {
+			for _, fld := range sym.Fields {
+				if fld.SymbolName() == segment.Name {
+					return fld.VarType(), true
+				}
+			}
+		}
+
+	case symbols.InterfaceSymbol:
+		for _, fnc := range sym.Functions {
+			if fnc.Name == segment.Name && segment.IsCall {
+				return fnc.Type, true
+			}
+		}
+
+	case symbols.StructSymbol:
+		for _, fld := range sym.Fields {
+			if fld.SymbolName() == segment.Name && !segment.IsCall {
+				return fld.VarType(), true
+			}
+		}
+	}
+
+	return symbols.TypeSymbol{}, false
+}
+
+// ResolveIndices figures out what type you end up with after indexing into something a few times
+func ResolveIndices(typ symbols.TypeSymbol, indices int) (symbols.TypeSymbol, bool) {
+	for i := 0; i < indices; i++ {
+		switch typ.Name {
+		case builtins.Array.Name, builtins.PArray.Name, builtins.Pointer.Name:
+			if len(typ.SubTypes) == 0 {
+				return symbols.TypeSymbol{}, false
+			}
+			typ = typ.SubTypes[0]
+
+		case builtins.Map.Name:
+			if len(typ.SubTypes) < 2 {
+				return symbols.TypeSymbol{}, false
+			}
+			typ = typ.SubTypes[1]
+
+		case builtins.String.Name:
+			typ = builtins.Byte
+
+		default:
+			return symbols.TypeSymbol{}, false
+		}
+	}
+
+	return typ, true
+}
+
+// LookupTypeSource finds the class, interface, or struct behind a user defined type
+// (just like the binder's LookupClassFunction and LookupClassField do, minus the errors)
+func LookupTypeSource(record ScopeRecord, typ symbols.TypeSymbol) symbols.Symbol {
+	sym := record.Scope.TryLookupSymbol(typ.Name)
+	if sym != nil {
+		switch sym.SymbolType() {
+		case symbols.Class, symbols.Interface, symbols.Struct:
+			return sym
+		}
+	}
+
+	// if that failed -> look through packages
+	for _, pck := range record.Scope.GetAllPackages() {
+		for _, cls := range pck.Classes {
+			if cls.Name == typ.Name {
+				return cls
+			}
+		}
+	}
+
+	return nil
+}
+
+func LookupPackage(record ScopeRecord, name string) (symbols.PackageSymbol, bool) {
+	sym := record.Scope.TryLookupSymbol(name)
+	if sym == nil || sym.SymbolType() != symbols.Package {
+		return symbols.PackageSymbol{}, false
+	}
+
+	return sym.(symbols.PackageSymbol), true
+}
+
+func skipSpacesBackwards(code []rune, i int) int {
+	for i > 0 && unicode.IsSpace(code[i-1]) {
+		i--
+	}
+
+	return i
+}
+
+// goes back from a closing bracket to the one opening it, gives back -1 if there is none
+func matchBracketBackwards(code []rune, i int) int {
+	depth := 0
+
+	for ; i >= 0; i-- {
+		switch code[i] {
+		case ')', ']':
+			depth++
+		case '(', '[':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '"':
+			i = skipStringBackwards(code, i)
+			if i < 0 {
+				return -1
+			}
+		}
+	}
+
+	return -1
+}
+
+// goes back from a closing quote to the one opening it, gives back -1 if there is none
+func skipStringBackwards(code []rune, i int) int {
+	for i--; i >= 0; i-- {
+		if code[i] == '"' && (i == 0 || code[i-1] != '\\') {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// </ACCESS CHAINS> -----------------------------------------------------------
+
+// FunctionSignature writes a function out the way it'd be declared
+func FunctionSignature(name string, parameters []symbols.ParameterSymbol, returnType symbols.TypeSymbol) string {
+	params := make([]string, 0, len(parameters))
+	for _, param := range parameters {
+		// builtin and package functions don't always name their parameters, those just get their type
+		if param.Name == "" {
+			params = append(params, TypeName(param.Type))
+			continue
+		}
+
+		params = append(params, param.Name+" "+TypeName(param.Type))
+	}
+
+	signature := "function " + name + "(" + strings.Join(params, ", ") + ")"
+	if returnType.Name != "" && returnType.Name != builtins.Void.Name {
+		signature += " " + TypeName(returnType)
+	}
+
+	return signature
+}
+
+// TypeName writes a type out the way it'd be written in code
+func TypeName(typ symbols.TypeSymbol) string {
+	if len(typ.SubTypes) == 0 {
+		return typ.Name
+	}
+
+	subTypes := make([]string, 0, len(typ.SubTypes))
+	for _, sub := range typ.SubTypes {
+		subTypes = append(subTypes, TypeName(sub))
+	}
+
+	return typ.Name + "[" + strings.Join(subTypes, ", ") + "]"
+}
+
+func IsFieldOf(cls symbols.ClassSymbol, variable symbols.VariableSymbol) bool {
+	for _, fld := range cls.Fields {
+		if fld.Fingerprint() == variable.Fingerprint() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsFunctionOf(cls symbols.ClassSymbol, fnc symbols.FunctionSymbol) bool {
+	for _, member := range cls.Functions {
+		if member.Name == fnc.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsIdentifierRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func IsIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if !IsIdentifierRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RuneOffset turns a line and column into an index into the code
+func RuneOffset(code []rune, line int, column int) int {
+	currentLine := 1
+	i := 0
+
+	for ; i < len(code) && currentLine < line; i++ {
+		if code[i] == '\n' {
+			currentLine++
+		}
+	}
+
+	for col := 1; col < column && i < len(code) && code[i] != '\n'; col++ {
+		i++
+	}
+
+	return i
+}
@@ -65,6 +65,17 @@ func (m ClassTokenMeaning) Type() TokenMeaningType {
 	return ClassMeaning
 }
 
+// InterfaceTokenMeaning holds data about interface ID tokens
+type InterfaceTokenMeaning struct {
+	TokenMeaning
+
+	Interface symbols.InterfaceSymbol // wat dis iface?
+}
+
+func (m InterfaceTokenMeaning) Type() TokenMeaningType {
+	return InterfaceMeaning
+}
+
 // StructTokenMeaning holds data about struct ID tokens
 type StructTokenMeaning struct {
 	TokenMeaning
@@ -87,10 +98,12 @@ func (m EnumTokenMeaning) Type() TokenMeaningType {
 	return EnumMeaning
 }
 
-// EnumFieldTokenMeaning holds data about enum ID tokens
+// EnumFieldTokenMeaning holds data about enum field tokens
 type EnumFieldTokenMeaning struct {
 	TokenMeaning
 
+	Enum  symbols.EnumSymbol // wat dis fld belong to?
+	Name  string
 	Value int
 }
 
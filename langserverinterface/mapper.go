@@ -6,7 +6,6 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
-	"os"
 )
 
 var labelCounter int = 0
@@ -33,19 +32,31 @@ func MapStatement(stmt boundnodes.BoundStatementNode) {
 		MapForStatement(stmt.(boundnodes.BoundForStatementNode))
 	case boundnodes.BoundFromToStatement:
 		MapFromToStatement(stmt.(boundnodes.BoundFromToStatementNode))
+	case boundnodes.BoundForEachStatement:
+		MapForEachStatement(stmt.(boundnodes.BoundForEachStatementNode))
 	case boundnodes.BoundLabelStatement:
 		MapLabelStatement(stmt.(boundnodes.BoundLabelStatementNode))
 	case boundnodes.BoundGotoStatement:
 		MapGotoStatement(stmt.(boundnodes.BoundGotoStatementNode))
 	case boundnodes.BoundConditionalGotoStatement:
 		MapConditionalGotoStatement(stmt.(boundnodes.BoundConditionalGotoStatementNode))
+	case boundnodes.BoundTryStartStatement:
+		MapTryStartStatement(stmt.(boundnodes.BoundTryStartStatementNode))
+	case boundnodes.BoundTryEndStatement:
+		MapTryEndStatement(stmt.(boundnodes.BoundTryEndStatementNode))
 	case boundnodes.BoundReturnStatement:
 		MapReturnStatement(stmt.(boundnodes.BoundReturnStatementNode))
 	case boundnodes.BoundExpressionStatement:
 		MapExpressionStatement(stmt.(boundnodes.BoundExpressionStatementNode))
+	case boundnodes.BoundTryStatement:
+		MapTryStatement(stmt.(boundnodes.BoundTryStatementNode))
+	case boundnodes.BoundThrowStatement:
+		MapThrowStatement(stmt.(boundnodes.BoundThrowStatementNode))
+	case boundnodes.BoundSwitchStatement:
+		MapSwitchStatement(stmt.(boundnodes.BoundSwitchStatementNode))
 	default:
 		print.PrintC(print.Red, "Statement unaccounted for in mapper! (stuff being in here is important for the language server lol)")
-		os.Exit(-1) // we crashin
+		print.Crash(-1) // we crashin
 	}
 }
 
@@ -88,6 +99,62 @@ func MapFromToStatement(stmt boundnodes.BoundFromToStatementNode) {
 	MapStatement(stmt.Body)
 }
 
+func MapForEachStatement(stmt boundnodes.BoundForEachStatementNode) {
+	TokenMapping[stmt.UnboundSource.(nodes.ForEachStatementNode).Identifier] = VariableTokenMeaning{Variable: stmt.Variable}
+
+	MapExpression(stmt.Collection)
+	MapStatement(stmt.Body)
+}
+
+func MapTryStatement(stmt boundnodes.BoundTryStatementNode) {
+	MapStatement(stmt.Body)
+
+	if stmt.CatchBody != nil {
+		if stmt.CatchVariable != nil {
+			TokenMapping[stmt.UnboundSource.(nodes.TryStatementNode).CatchClause.Identifier] = VariableTokenMeaning{Variable: stmt.CatchVariable}
+		}
+
+		MapStatement(stmt.CatchBody)
+	}
+
+	if stmt.FinallyBody != nil {
+		MapStatement(stmt.FinallyBody)
+	}
+}
+
+func MapThrowStatement(stmt boundnodes.BoundThrowStatementNode) {
+	MapExpression(stmt.Expression)
+}
+
+func MapSwitchStatement(stmt boundnodes.BoundSwitchStatementNode) {
+	MapExpression(stmt.Expression)
+
+	for i, condition := range stmt.Conditions {
+		MapCaseCondition(condition)
+		MapStatement(stmt.Bodies[i])
+	}
+
+	if stmt.DefaultBody != nil {
+		MapStatement(stmt.DefaultBody)
+	}
+}
+
+// case conditions compare a hidden variable against each value (tmp = a || tmp = b)
+// only the values actually exist in the source code, so those are the only thing we map
+func MapCaseCondition(expr boundnodes.BoundExpressionNode) {
+	if expr.NodeType() != boundnodes.BoundBinaryExpression {
+		return
+	}
+
+	binary := expr.(boundnodes.BoundBinaryExpressionNode)
+	if binary.Left.NodeType() == boundnodes.BoundBinaryExpression {
+		MapCaseCondition(binary.Left)
+		MapCaseCondition(binary.Right)
+	} else {
+		MapExpression(binary.Right)
+	}
+}
+
 // -----------------------------------------------------------------------
 // no idea why these are even declared (they shouldnt actually exist here)
 // -----------------------------------------------------------------------
@@ -101,6 +168,13 @@ func MapGotoStatement(stmt boundnodes.BoundGotoStatementNode) {
 func MapConditionalGotoStatement(stmt boundnodes.BoundConditionalGotoStatementNode) {
 }
 
+// these (and caught exceptions) only show up in lambda bodies, which are already lowered by the time we get them
+func MapTryStartStatement(stmt boundnodes.BoundTryStartStatementNode) {
+}
+
+func MapTryEndStatement(stmt boundnodes.BoundTryEndStatementNode) {
+}
+
 // -----------------------------------------------------------------------
 
 func MapReturnStatement(stmt boundnodes.BoundReturnStatementNode) {
@@ -149,12 +223,18 @@ func MapExpression(expr boundnodes.BoundExpressionNode) {
 		MapMakeExpression(expr.(boundnodes.BoundMakeExpressionNode))
 	case boundnodes.BoundMakeArrayExpression:
 		MapMakeArrayExpression(expr.(boundnodes.BoundMakeArrayExpressionNode))
+	case boundnodes.BoundMakeMapExpression:
+		MapMakeMapExpression(expr.(boundnodes.BoundMakeMapExpressionNode))
 	case boundnodes.BoundMakeStructExpression:
 		MapMakeStructExpression(expr.(boundnodes.BoundMakeStructExpressionNode))
 	case boundnodes.BoundFunctionExpression:
 		MapFunctionExpression(expr.(boundnodes.BoundFunctionExpressionNode))
 	case boundnodes.BoundTernaryExpression:
 		MapTernaryExpression(expr.(boundnodes.BoundTernaryExpressionNode))
+	case boundnodes.BoundMatchExpression:
+		MapMatchExpression(expr.(boundnodes.BoundMatchExpressionNode))
+	case boundnodes.BoundInterpolatedStringExpression:
+		MapInterpolatedStringExpression(expr.(boundnodes.BoundInterpolatedStringExpressionNode))
 	case boundnodes.BoundReferenceExpression:
 		MapReferenceExpression(expr.(boundnodes.BoundReferenceExpressionNode))
 	case boundnodes.BoundDereferenceExpression:
@@ -165,9 +245,11 @@ func MapExpression(expr boundnodes.BoundExpressionNode) {
 		MapThisExpression(expr.(boundnodes.BoundThisExpressionNode))
 	case boundnodes.BoundEnumExpression:
 		MapEnumExpression(expr.(boundnodes.BoundEnumExpressionNode))
+	case boundnodes.BoundCaughtExceptionExpression:
+		MapCaughtExceptionExpression(expr.(boundnodes.BoundCaughtExceptionExpressionNode))
 	default:
 		print.PrintC(print.Red, "Expression unaccounted for in mappr! (stuff being in here is important for the language server lol)")
-		os.Exit(-1) // we crashin
+		print.Crash(-1) // we crashin
 	}
 }
 
@@ -215,8 +297,12 @@ func MapPackageCallExpression(expr boundnodes.BoundPackageCallExpressionNode) {
 func MapConversionExpression(expr boundnodes.BoundConversionExpressionNode) {
 
 	if expr.Source().NodeType() == nodes.CallExpression {
-		// classes
-		if expr.ToType.IsObject && expr.ToType.IsUserDefined {
+		// interfaces
+		if iface, ok := expr.ToType.SourceSymbol.(symbols.InterfaceSymbol); ok {
+			TokenMapping[expr.Source().(nodes.CallExpressionNode).Identifier] = InterfaceTokenMeaning{Interface: iface}
+
+			// classes
+		} else if expr.ToType.IsObject && expr.ToType.IsUserDefined {
 			TokenMapping[expr.Source().(nodes.CallExpressionNode).Identifier] = ClassTokenMeaning{Class: expr.ToType.SourceSymbol.(symbols.ClassSymbol)}
 
 			// structs
@@ -237,8 +323,11 @@ func MapConversionExpression(expr boundnodes.BoundConversionExpressionNode) {
 		}
 	} else if expr.Source().NodeType() == nodes.PackageCallExpression {
 		// packages can only contain classes
-		TokenMapping[expr.Source().(nodes.PackageCallExpressionNode).Package] = PackageTokenMeaning{Package: expr.ToType.Package}
-		TokenMapping[expr.Source().(nodes.PackageCallExpressionNode).Identifier] = ClassTokenMeaning{Class: expr.ToType.SourceSymbol.(symbols.ClassSymbol)}
+		// (if its not one, this is just a conversion of whatever a package function returned)
+		if cls, ok := expr.ToType.SourceSymbol.(symbols.ClassSymbol); ok {
+			TokenMapping[expr.Source().(nodes.PackageCallExpressionNode).Package] = PackageTokenMeaning{Package: expr.ToType.Package}
+			TokenMapping[expr.Source().(nodes.PackageCallExpressionNode).Identifier] = ClassTokenMeaning{Class: cls}
+		}
 	}
 
 	MapExpression(expr.Expression)
@@ -261,6 +350,12 @@ func MapClassCallExpression(expr boundnodes.BoundClassCallExpressionNode) {
 		MapExpression(arg)
 	}
 
+	// base(...) calls inside of constructors
+	if expr.Source().NodeType() == nodes.CallExpression {
+		TokenMapping[expr.Source().(nodes.CallExpressionNode).Identifier] = FunctionTokenMeaning{Function: expr.Function}
+		return
+	}
+
 	TokenMapping[expr.Source().(nodes.TypeCallExpressionNode).CallIdentifier] = FunctionTokenMeaning{Function: expr.Function}
 }
 
@@ -273,7 +368,7 @@ func MapClassFieldAssignmentExpression(expr boundnodes.BoundClassFieldAssignment
 	MapExpression(expr.Base)
 	MapExpression(expr.Value)
 
-	TokenMapping[expr.Source().(nodes.ClassFieldAccessExpressionNode).FieldIdentifier] = VariableTokenMeaning{Variable: expr.Field}
+	TokenMapping[expr.Source().(nodes.ClassFieldAssignmentExpressionNode).FieldIdentifier] = VariableTokenMeaning{Variable: expr.Field}
 }
 
 func MapArrayAccessExpression(expr boundnodes.BoundArrayAccessExpressionNode) {
@@ -312,6 +407,11 @@ func MapMakeArrayExpression(expr boundnodes.BoundMakeArrayExpressionNode) {
 	MapExpression(expr.Length)
 }
 
+func MapMakeMapExpression(expr boundnodes.BoundMakeMapExpressionNode) {
+	src := expr.Source().(nodes.MakeExpressionNode)
+	MapGenericType(nodes.CreateTypeClauseNode(nil, src.BaseType, src.TypeArguments, src.ClosingToken), expr.MapType)
+}
+
 func MapMakeStructExpression(expr boundnodes.BoundMakeStructExpressionNode) {
 	for _, literal := range expr.Literals {
 		MapExpression(literal)
@@ -330,6 +430,23 @@ func MapTernaryExpression(expr boundnodes.BoundTernaryExpressionNode) {
 	MapExpression(expr.Else)
 }
 
+func MapMatchExpression(expr boundnodes.BoundMatchExpressionNode) {
+	MapExpression(expr.Expression)
+
+	for i, condition := range expr.Conditions {
+		MapCaseCondition(condition)
+		MapExpression(expr.Values[i])
+	}
+
+	MapExpression(expr.DefaultValue)
+}
+
+func MapInterpolatedStringExpression(expr boundnodes.BoundInterpolatedStringExpressionNode) {
+	for _, part := range expr.Parts {
+		MapExpression(part)
+	}
+}
+
 func MapReferenceExpression(expr boundnodes.BoundReferenceExpressionNode) {
 	MapExpression(expr.Expression)
 }
@@ -346,9 +463,17 @@ func MapThisExpression(expr boundnodes.BoundThisExpressionNode) {
 	// nothing to do here right now ('this' is a keyword)
 }
 
+func MapCaughtExceptionExpression(expr boundnodes.BoundCaughtExceptionExpressionNode) {
+	// nothing to do here either, the lowerer makes these up for catch variables
+}
+
 func MapEnumExpression(expr boundnodes.BoundEnumExpressionNode) {
 	TokenMapping[expr.Source().(nodes.ClassFieldAccessExpressionNode).Base.(nodes.NameExpressionNode).Identifier] = EnumTokenMeaning{Enum: expr.Enum}
-	TokenMapping[expr.Source().(nodes.ClassFieldAccessExpressionNode).FieldIdentifier] = EnumFieldTokenMeaning{Value: expr.Value}
+	TokenMapping[expr.Source().(nodes.ClassFieldAccessExpressionNode).FieldIdentifier] = EnumFieldTokenMeaning{
+		Enum:  expr.Enum,
+		Name:  expr.Source().(nodes.ClassFieldAccessExpressionNode).FieldIdentifier.Value,
+		Value: expr.Value,
+	}
 }
 
 func MapComplexType(clause nodes.TypeClauseNode, typ symbols.TypeSymbol) {
@@ -365,24 +490,32 @@ func MapGenericType(clause nodes.TypeClauseNode, typ symbols.TypeSymbol) {
 	// this is a class from a package
 	if clause.Package != nil {
 		TokenMapping[*clause.Package] = PackageTokenMeaning{Package: typ.Package}
-		TokenMapping[clause.TypeIdentifier] = ClassTokenMeaning{Class: typ.SourceSymbol.(symbols.ClassSymbol)}
+
+		if cls, ok := typ.SourceSymbol.(symbols.ClassSymbol); ok {
+			TokenMapping[clause.TypeIdentifier] = ClassTokenMeaning{Class: cls}
+		}
 		return
 	}
 
 	// this is from  s o m e w h e r e
-	if typ.IsObject && typ.IsUserDefined {
-		TokenMapping[clause.TypeIdentifier] = ClassTokenMeaning{Class: typ.SourceSymbol.(symbols.ClassSymbol)}
+	// (types which are still being bound dont have a source symbol yet, those just count as plain types)
+	if iface, ok := typ.SourceSymbol.(symbols.InterfaceSymbol); ok {
+		TokenMapping[clause.TypeIdentifier] = InterfaceTokenMeaning{Interface: iface}
+
+		// classes
+	} else if cls, ok := typ.SourceSymbol.(symbols.ClassSymbol); ok && typ.IsObject && typ.IsUserDefined {
+		TokenMapping[clause.TypeIdentifier] = ClassTokenMeaning{Class: cls}
 
 		// structs
-	} else if !typ.IsObject && typ.IsUserDefined {
-		TokenMapping[clause.TypeIdentifier] = StructTokenMeaning{Struct: typ.SourceSymbol.(symbols.StructSymbol)}
+	} else if stc, ok := typ.SourceSymbol.(symbols.StructSymbol); ok && !typ.IsObject && typ.IsUserDefined {
+		TokenMapping[clause.TypeIdentifier] = StructTokenMeaning{Struct: stc}
 
 		// enums
-	} else if typ.IsEnum {
-		TokenMapping[clause.TypeIdentifier] = EnumTokenMeaning{Enum: typ.SourceSymbol.(symbols.EnumSymbol)}
+	} else if enm, ok := typ.SourceSymbol.(symbols.EnumSymbol); ok && typ.IsEnum {
+		TokenMapping[clause.TypeIdentifier] = EnumTokenMeaning{Enum: enm}
 
 		// complex type (type with subtypes)
-	} else if len(clause.SubClauses) > 0 {
+	} else if len(clause.SubClauses) > 0 && len(clause.SubClauses) == len(typ.SubTypes) {
 		MapComplexType(clause, typ)
 
 		// simple type
@@ -9,6 +9,7 @@ const (
 	FunctionMeaning       TokenMeaningType = "Function"
 	TypeFunctionMeaning   TokenMeaningType = "TypeFunction"
 	ClassMeaning          TokenMeaningType = "Class"
+	InterfaceMeaning      TokenMeaningType = "Interface"
 	StructMeaning         TokenMeaningType = "Struct"
 	EnumMeaning           TokenMeaningType = "Enum"
 	EnumFieldMeaning      TokenMeaningType = "EnumField"
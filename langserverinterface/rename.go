@@ -0,0 +1,490 @@
+package langserverinterface
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// renaming works off of the same occurrences go-to-definition and find-references use
+// the declaration and every reference get an edit, class functions take their overrides
+// (and everything implementing the same interface function) along with them so nothing stops overriding anything
+
+// TextEdit replaces a span of code with some new text
+type TextEdit struct {
+	Span    print.TextSpan
+	NewText string
+}
+
+// Rename works out every edit needed to rename the symbol at the given position
+func Rename(file string, line int, column int, newName string) ([]TextEdit, error) {
+	occurrence, ok := SymbolAt(file, line, column)
+	if !ok {
+		return nil, fmt.Errorf("there is nothing that could be renamed here")
+	}
+
+	definition, ok := DefinitionOf(occurrence.Meaning)
+	if !ok {
+		return nil, fmt.Errorf("\"%s\" is not declared in any ReCT file, so it can't be renamed", occurrence.Token.Value)
+	}
+
+	err := CheckRenamable(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckNewName(newName)
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing to do
+	if newName == definition.Name {
+		return []TextEdit{}, nil
+	}
+
+	definitions := RelatedDefinitions(definition)
+
+	err = CheckCollisions(definition, definitions, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	edits := make([]TextEdit, 0)
+	seen := make(map[print.TextSpan]bool)
+
+	for _, def := range definitions {
+		for _, reference := range ReferencesOf(def) {
+			// generics get bound once per instance, but they're all the same code
+			if seen[reference.Token.Span] {
+				continue
+			}
+
+			// some tokens only stand for a symbol without spelling out its name (like base(...) calls), those stay as they are
+			if SpanText(reference.Token.Span) != definition.Name {
+				continue
+			}
+
+			seen[reference.Token.Span] = true
+			edits = append(edits, TextEdit{Span: reference.Token.Span, NewText: newName})
+		}
+	}
+
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].Span.File != edits[j].Span.File {
+			return edits[i].Span.File < edits[j].Span.File
+		}
+
+		return edits[i].Span.StartIndex < edits[j].Span.StartIndex
+	})
+
+	return edits, nil
+}
+
+// CheckRenamable makes sure renaming this won't break anything outside of our control
+func CheckRenamable(definition Definition) error {
+	switch m := definition.Meaning.(type) {
+	case FunctionTokenMeaning:
+		if m.Function.External {
+			return fmt.Errorf("\"%s\" is an external function, its name has to match the one it's linked against", definition.Name)
+		}
+
+		if m.Function.Name == "Constructor" || m.Function.Name == "Die" {
+			return fmt.Errorf("\"%s\" is a reserved function name and can't be renamed", definition.Name)
+		}
+
+	case PackageTokenMeaning:
+		if !m.Package.IsAlias {
+			return fmt.Errorf("packages are named after their module, only aliases of \"%s\" can be renamed", definition.Name)
+		}
+	}
+
+	return nil
+}
+
+// CheckNewName makes sure the new name is something the lexer will actually see as a name
+func CheckNewName(newName string) error {
+	if !IsIdentifier(newName) || unicode.IsDigit([]rune(newName)[0]) {
+		return fmt.Errorf("\"%s\" is not a valid name", newName)
+	}
+
+	if lexer.CheckIfKeyword(newName) != lexer.IdToken {
+		return fmt.Errorf("\"%s\" is a keyword", newName)
+	}
+
+	for _, typ := range builtins.Types {
+		if typ.Name == newName {
+			return fmt.Errorf("\"%s\" is a built-in type", newName)
+		}
+	}
+
+	return nil
+}
+
+// <RELATIONS> ----------------------------------------------------------------
+
+// RelatedDefinitions finds every declaration that has to be renamed together with the given one
+// (that's only ever more than one for functions of classes and interfaces)
+func RelatedDefinitions(definition Definition) []Definition {
+	definitions := []Definition{definition}
+
+	fnc, ok := definition.Meaning.(FunctionTokenMeaning)
+	if !ok {
+		return definitions
+	}
+
+	classes, interfaces := FunctionOwners(definition.Span, fnc.Function.Name)
+	for _, cls := range classes {
+		declaration, ok := OwnFunctionDeclaration(cls, fnc.Function.Name)
+		if ok && declaration.Identifier.Span != definition.Span {
+			definitions = append(definitions, Definition{Name: definition.Name, Span: declaration.Identifier.Span, Meaning: definition.Meaning})
+		}
+	}
+
+	for _, iface := range interfaces {
+		for _, function := range iface.Functions {
+			if function.Name == fnc.Function.Name && function.Declaration.Identifier.Span != definition.Span {
+				definitions = append(definitions, Definition{Name: definition.Name, Span: function.Declaration.Identifier.Span, Meaning: definition.Meaning})
+			}
+		}
+	}
+
+	return definitions
+}
+
+// FunctionOwners finds the class or interface a function was declared in,
+// along with every class and interface that declares a function it overrides, implements, or is overridden by
+func FunctionOwners(span print.TextSpan, name string) ([]symbols.ClassSymbol, []symbols.InterfaceSymbol) {
+	classes := make([]symbols.ClassSymbol, 0)
+	interfaces := make([]symbols.InterfaceSymbol, 0)
+
+	global, ok := GlobalScope()
+	if !ok {
+		return classes, interfaces
+	}
+
+	allClasses := global.Scope.GetAllClasses()
+	allInterfaces := global.Scope.GetAllInterfaces()
+
+	inClasses := make(map[string]bool)
+	inInterfaces := make(map[string]bool)
+
+	// where was this declared?
+	for _, cls := range allClasses {
+		declaration, ok := OwnFunctionDeclaration(cls, name)
+		if ok && declaration.Identifier.Span == span {
+			classes = append(classes, cls)
+			inClasses[cls.Name] = true
+		}
+	}
+
+	for _, iface := range allInterfaces {
+		for _, fnc := range iface.Functions {
+			if fnc.Name == name && fnc.Declaration.Identifier.Span == span {
+				interfaces = append(interfaces, iface)
+				inInterfaces[iface.Name] = true
+			}
+		}
+	}
+
+	// a global function -> nobody else is involved
+	if len(classes) == 0 && len(interfaces) == 0 {
+		return classes, interfaces
+	}
+
+	// keep pulling in relatives until there are no new ones
+	for changed := true; changed; {
+		changed = false
+
+		for _, cls := range allClasses {
+			if inClasses[cls.Name] {
+				continue
+			}
+
+			if _, ok := OwnFunctionDeclaration(cls, name); !ok {
+				continue
+			}
+
+			related := false
+			for _, other := range classes {
+				if cls.InheritsFrom(other) || other.InheritsFrom(cls) {
+					related = true
+				}
+			}
+
+			for _, iface := range interfaces {
+				if cls.Implements(iface) {
+					related = true
+				}
+			}
+
+			if related {
+				classes = append(classes, cls)
+				inClasses[cls.Name] = true
+				changed = true
+			}
+		}
+
+		for _, iface := range allInterfaces {
+			if inInterfaces[iface.Name] || !InterfaceHasFunction(iface, name) {
+				continue
+			}
+
+			for _, cls := range classes {
+				if cls.Implements(iface) {
+					interfaces = append(interfaces, iface)
+					inInterfaces[iface.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return classes, interfaces
+}
+
+// OwnFunctionDeclaration finds a function declared by the class itself (not inherited from a base)
+func OwnFunctionDeclaration(cls symbols.ClassSymbol, name string) (nodes.FunctionDeclarationMember, bool) {
+	for _, member := range cls.Declaration.Members {
+		if member.NodeType() != nodes.FunctionDeclaration {
+			continue
+		}
+
+		fnc := member.(nodes.FunctionDeclarationMember)
+		if fnc.Identifier.Value == name {
+			return fnc, true
+		}
+	}
+
+	return nodes.FunctionDeclarationMember{}, false
+}
+
+func InterfaceHasFunction(iface symbols.InterfaceSymbol, name string) bool {
+	for _, fnc := range iface.Functions {
+		if fnc.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// </RELATIONS> ---------------------------------------------------------------
+// <COLLISIONS> ---------------------------------------------------------------
+
+// CheckCollisions makes sure the new name doesn't clash with anything wherever the symbol is used
+func CheckCollisions(definition Definition, definitions []Definition, newName string) error {
+	global, ok := GlobalScope()
+	if !ok {
+		return nil
+	}
+
+	switch m := definition.Meaning.(type) {
+	case EnumFieldTokenMeaning:
+		if _, ok := m.Enum.Fields[newName]; ok {
+			return fmt.Errorf("enum \"%s\" already has a field called \"%s\"", m.Enum.Name, newName)
+		}
+		return nil
+
+	case VariableTokenMeaning:
+		// struct fields only ever show up behind a "->"
+		for _, stc := range global.Scope.GetAllStructs() {
+			if ContainsVariable(stc.Fields, m.Variable) {
+				for _, fld := range stc.Fields {
+					if fld.SymbolName() == newName {
+						return fmt.Errorf("struct \"%s\" already has a field called \"%s\"", stc.Name, newName)
+					}
+				}
+				return nil
+			}
+		}
+
+		// class fields need to be unique within their class (and all classes inheriting them)
+		isField := false
+		for _, cls := range global.Scope.GetAllClasses() {
+			if ContainsVariable(cls.Fields, m.Variable) {
+				isField = true
+
+				if HasMember(cls, newName) {
+					return fmt.Errorf("class \"%s\" already has a member called \"%s\"", cls.Name, newName)
+				}
+			}
+		}
+
+		return CheckScopeCollisions(definitions, newName, isField)
+
+	case FunctionTokenMeaning:
+		// every class that has this function (declared or inherited) can't have anything else with the new name
+		for _, cls := range global.Scope.GetAllClasses() {
+			for _, fnc := range cls.Functions {
+				if fnc.Name == m.Function.Name && IsDefinedAt(fnc.Declaration.Identifier.Span, definitions) && HasMember(cls, newName) {
+					return fmt.Errorf("class \"%s\" already has a member called \"%s\"", cls.Name, newName)
+				}
+			}
+		}
+
+		classes, interfaces := FunctionOwners(definition.Span, m.Function.Name)
+
+		for _, iface := range interfaces {
+			if InterfaceHasFunction(iface, newName) {
+				return fmt.Errorf("interface \"%s\" already has a function called \"%s\"", iface.Name, newName)
+			}
+		}
+
+		return CheckScopeCollisions(definitions, newName, len(classes)+len(interfaces) > 0)
+	}
+
+	// types and package aliases live right in the global scope
+	sym := global.Scope.TryLookupSymbol(newName)
+	if sym != nil && !IsSameSymbol(sym, definitions) {
+		return fmt.Errorf("there already is a %s called \"%s\"", SymbolKindName(sym), newName)
+	}
+
+	// ...but they can be seen from everywhere, so nothing in a nested scope can use the new name either
+	for _, record := range Scopes {
+		sym := record.Scope.TryLookupSymbol(newName)
+		if sym == nil || IsSameSymbol(sym, definitions) {
+			continue
+		}
+
+		// point at the declaration if we know where it is
+		span := record.Span
+		if meaning, ok := MeaningOf(sym); ok {
+			if definition, ok := DefinitionOf(meaning); ok {
+				span = definition.Span
+			}
+		}
+
+		return fmt.Errorf("there already is a %s called \"%s\" at %s:%d:%d",
+			SymbolKindName(sym), newName, span.File, span.StartLine, span.StartColumn)
+	}
+
+	return CheckScopeCollisions(definitions, newName, false)
+}
+
+// CheckScopeCollisions looks at every place the symbol is used and makes sure the new name doesn't mean something else there
+// (members accessed through "->" are looked up in their class instead, so those get skipped if asked to)
+func CheckScopeCollisions(definitions []Definition, newName string, skipQualified bool) error {
+	for _, def := range definitions {
+		for _, reference := range ReferencesOf(def) {
+			if skipQualified && IsQualified(reference.Token) {
+				continue
+			}
+
+			span := reference.Token.Span
+			record, ok := ScopeAt(span.File, span.StartLine, span.StartColumn)
+			if !ok {
+				continue
+			}
+
+			sym := record.Scope.TryLookupSymbol(newName)
+			if sym == nil {
+				continue
+			}
+
+			if !IsSameSymbol(sym, definitions) {
+				return fmt.Errorf("\"%s\" already means a %s at %s:%d:%d, renaming would change what the code there refers to",
+					newName, SymbolKindName(sym), span.File, span.StartLine, span.StartColumn)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsSameSymbol checks if a symbol is one of the ones being renamed
+func IsSameSymbol(sym symbols.Symbol, definitions []Definition) bool {
+	meaning, ok := MeaningOf(sym)
+	if !ok {
+		return false
+	}
+
+	definition, ok := DefinitionOf(meaning)
+	if !ok {
+		return false
+	}
+
+	return IsDefinedAt(definition.Span, definitions)
+}
+
+func IsDefinedAt(span print.TextSpan, definitions []Definition) bool {
+	for _, def := range definitions {
+		if def.Span == span {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsQualified checks if a token comes right after a "->" or "::"
+func IsQualified(token lexer.Token) bool {
+	code := []rune(print.SourceFiles[token.Span.File])
+	i := skipSpacesBackwards(code, RuneOffset(code, token.Span.StartLine, token.Span.StartColumn))
+
+	if i < 2 {
+		return false
+	}
+
+	before := string(code[i-2 : i])
+	return before == "->" || before == "::"
+}
+
+func HasMember(cls symbols.ClassSymbol, name string) bool {
+	for _, fld := range cls.Fields {
+		if fld.SymbolName() == name {
+			return true
+		}
+	}
+
+	for _, fnc := range cls.Functions {
+		if fnc.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ContainsVariable(variables []symbols.VariableSymbol, variable symbols.VariableSymbol) bool {
+	for _, v := range variables {
+		if v.Fingerprint() == variable.Fingerprint() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func SymbolKindName(sym symbols.Symbol) string {
+	switch sym.SymbolType() {
+	case symbols.LocalVariable, symbols.GlobalVariable:
+		return "variable"
+	case symbols.Parameter:
+		return "parameter"
+	}
+
+	return strings.ToLower(strings.TrimSuffix(string(sym.SymbolType()), "Symbol"))
+}
+
+// </COLLISIONS> --------------------------------------------------------------
+
+// SpanText gives back the code a span covers
+func SpanText(span print.TextSpan) string {
+	code := []rune(print.SourceFiles[span.File])
+	start := RuneOffset(code, span.StartLine, span.StartColumn)
+	end := RuneOffset(code, span.EndLine, span.EndColumn)
+
+	if end < start {
+		return ""
+	}
+
+	return string(code[start:end])
+}
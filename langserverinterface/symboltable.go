@@ -0,0 +1,369 @@
+package langserverinterface
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+	"sort"
+	"strings"
+)
+
+// TokenMapping only knows what a token means, not where the thing it means came from
+// so the binder tells us about every declaration it makes, which lets us answer things like
+// "what is declared here?", "where was this declared?" and "where is this used?"
+
+// DeclarationMapping holds every token where something was declared
+var DeclarationMapping map[lexer.Token]TokenMeaning
+
+// VariableDeclarations remembers where variables were declared (their symbols have no idea)
+var VariableDeclarations map[string]lexer.Token
+
+// PackageDeclarations remembers where packages were referenced and aliases were created
+var PackageDeclarations map[string]lexer.Token
+
+// all occurrences sorted by file and position, built when somebody first asks for it
+var occurrenceIndex map[string][]Occurrence
+
+// Occurrence is a spot in the code where a symbol shows up
+type Occurrence struct {
+	Token         lexer.Token
+	Meaning       TokenMeaning
+	IsDeclaration bool
+
+	// where the symbol was declared (if we know that)
+	Definition    print.TextSpan
+	HasDefinition bool
+}
+
+// Definition is where a symbol was declared
+type Definition struct {
+	Name    string
+	Span    print.TextSpan
+	Meaning TokenMeaning
+}
+
+// Reset forgets about everything from the last time the binder ran
+func Reset() {
+	TokenMapping = make(map[lexer.Token]TokenMeaning)
+	DeclarationMapping = make(map[lexer.Token]TokenMeaning)
+	VariableDeclarations = make(map[string]lexer.Token)
+	PackageDeclarations = make(map[string]lexer.Token)
+	Scopes = make([]ScopeRecord, 0)
+	occurrenceIndex = nil
+}
+
+// Snapshot holds everything that's been recorded up to some point
+type Snapshot struct {
+	TokenMapping         map[lexer.Token]TokenMeaning
+	DeclarationMapping   map[lexer.Token]TokenMeaning
+	VariableDeclarations map[string]lexer.Token
+	PackageDeclarations  map[string]lexer.Token
+	Scopes               []ScopeRecord
+}
+
+// TakeSnapshot remembers everything recorded so far
+// (the binder binds some things just to look for errors, those aren't supposed to show up in here)
+func TakeSnapshot() Snapshot {
+	ensureMappings()
+
+	snapshot := Snapshot{
+		TokenMapping:         make(map[lexer.Token]TokenMeaning),
+		DeclarationMapping:   make(map[lexer.Token]TokenMeaning),
+		VariableDeclarations: make(map[string]lexer.Token),
+		PackageDeclarations:  make(map[string]lexer.Token),
+		Scopes:               append(make([]ScopeRecord, 0, len(Scopes)), Scopes...),
+	}
+
+	for token, meaning := range TokenMapping {
+		snapshot.TokenMapping[token] = meaning
+	}
+	for token, meaning := range DeclarationMapping {
+		snapshot.DeclarationMapping[token] = meaning
+	}
+	for fingerprint, token := range VariableDeclarations {
+		snapshot.VariableDeclarations[fingerprint] = token
+	}
+	for name, token := range PackageDeclarations {
+		snapshot.PackageDeclarations[name] = token
+	}
+
+	return snapshot
+}
+
+// RestoreSnapshot forgets everything that's been recorded since the snapshot was taken
+func RestoreSnapshot(snapshot Snapshot) {
+	TokenMapping = snapshot.TokenMapping
+	DeclarationMapping = snapshot.DeclarationMapping
+	VariableDeclarations = snapshot.VariableDeclarations
+	PackageDeclarations = snapshot.PackageDeclarations
+	Scopes = snapshot.Scopes
+	occurrenceIndex = nil
+}
+
+// <RECORDING> ----------------------------------------------------------------
+
+// Declare records that the given symbol has been declared at the given identifier
+func Declare(sym symbols.Symbol, identifier lexer.Token) {
+	// generated stuff (default constructors and such) doesn't exist in any file
+	if identifier.Span.File == "" {
+		return
+	}
+
+	meaning, ok := MeaningOf(sym)
+	if !ok {
+		return
+	}
+
+	ensureMappings()
+	DeclarationMapping[identifier] = meaning
+	occurrenceIndex = nil
+
+	switch sym.SymbolType() {
+	case symbols.LocalVariable, symbols.GlobalVariable, symbols.Parameter:
+		VariableDeclarations[sym.Fingerprint()] = identifier
+
+	case symbols.Package:
+		PackageDeclarations[sym.Fingerprint()] = identifier
+
+	case symbols.Enum:
+		// enum fields are declared along with their enum
+		enm := sym.(symbols.EnumSymbol)
+		for field := range enm.Declaration.Fields {
+			if field.Span.File == "" {
+				continue
+			}
+
+			DeclarationMapping[field] = EnumFieldTokenMeaning{Enum: enm, Name: field.Value, Value: enm.Fields[field.Value]}
+		}
+	}
+}
+
+// MapSymbol records that a token refers to the given symbol
+func MapSymbol(token lexer.Token, sym symbols.Symbol) {
+	meaning, ok := MeaningOf(sym)
+	if !ok {
+		return
+	}
+
+	ensureMappings()
+	TokenMapping[token] = meaning
+	occurrenceIndex = nil
+}
+
+// MeaningOf wraps a symbol into the matching TokenMeaning
+func MeaningOf(sym symbols.Symbol) (TokenMeaning, bool) {
+	if sym == nil {
+		return nil, false
+	}
+
+	switch sym.SymbolType() {
+	case symbols.LocalVariable, symbols.GlobalVariable, symbols.Parameter:
+		return VariableTokenMeaning{Variable: sym.(symbols.VariableSymbol)}, true
+	case symbols.Function:
+		if fnc, ok := sym.(symbols.FunctionSymbol); ok {
+			return FunctionTokenMeaning{Function: fnc}, true
+		}
+		return TypeFunctionTokenMeaning{TypeFunction: sym.(symbols.TypeFunctionSymbol)}, true
+	case symbols.Class:
+		return ClassTokenMeaning{Class: sym.(symbols.ClassSymbol)}, true
+	case symbols.Interface:
+		return InterfaceTokenMeaning{Interface: sym.(symbols.InterfaceSymbol)}, true
+	case symbols.Struct:
+		return StructTokenMeaning{Struct: sym.(symbols.StructSymbol)}, true
+	case symbols.Enum:
+		return EnumTokenMeaning{Enum: sym.(symbols.EnumSymbol)}, true
+	case symbols.Package:
+		return PackageTokenMeaning{Package: sym.(symbols.PackageSymbol)}, true
+	case symbols.Type:
+		return TypeTokenMeaning{TypeSym: sym.(symbols.TypeSymbol)}, true
+	}
+
+	return nil, false
+}
+
+func ensureMappings() {
+	if TokenMapping == nil || DeclarationMapping == nil || VariableDeclarations == nil || PackageDeclarations == nil {
+		Reset()
+	}
+}
+
+// </RECORDING> ---------------------------------------------------------------
+// <QUERIES> ------------------------------------------------------------------
+// (lines and columns start at 1, just like they do in our TextSpans)
+
+// DefinitionOf finds out where whatever a token means was declared
+func DefinitionOf(meaning TokenMeaning) (Definition, bool) {
+	var identifier lexer.Token
+
+	switch m := meaning.(type) {
+	case VariableTokenMeaning:
+		id, ok := VariableDeclarations[m.Variable.Fingerprint()]
+		if !ok {
+			return Definition{}, false
+		}
+		identifier = id
+
+	case FunctionTokenMeaning:
+		identifier = m.Function.Declaration.Identifier
+	case ClassTokenMeaning:
+		identifier = m.Class.Declaration.Identifier
+	case InterfaceTokenMeaning:
+		identifier = m.Interface.Declaration.Identifier
+	case StructTokenMeaning:
+		identifier = m.Struct.Declaration.Identifier
+	case EnumTokenMeaning:
+		identifier = m.Enum.Declaration.Identifier
+
+	case PackageTokenMeaning:
+		id, ok := PackageDeclarations[m.Package.Fingerprint()]
+		if !ok {
+			return Definition{}, false
+		}
+		identifier = id
+
+	case EnumFieldTokenMeaning:
+		for field := range m.Enum.Declaration.Fields {
+			if field.Value == m.Name {
+				identifier = field
+			}
+		}
+
+	default:
+		// built-in types and type functions aren't declared in any ReCT file
+		return Definition{}, false
+	}
+
+	// built-ins and package members don't have a location either
+	if identifier.Span.File == "" {
+		return Definition{}, false
+	}
+
+	// instances of generics carry their type arguments in their name (Stack[int]), we only want the name itself
+	name := strings.Split(identifier.Value, "[")[0]
+
+	return Definition{Name: name, Span: identifier.Span, Meaning: meaning}, true
+}
+
+// SymbolAt finds whatever symbol is at the given position
+func SymbolAt(file string, line int, column int) (Occurrence, bool) {
+	occurrences := Occurrences(file)
+
+	// find the first occurrence which doesn't end before our position
+	i := sort.Search(len(occurrences), func(i int) bool {
+		span := occurrences[i].Token.Span
+		return span.EndLine > line || (span.EndLine == line && span.EndColumn >= column)
+	})
+
+	if i < len(occurrences) && SpanContains(occurrences[i].Token.Span, line, column) {
+		return occurrences[i], true
+	}
+
+	return Occurrence{}, false
+}
+
+// DefinitionAt finds out where the symbol at the given position was declared
+func DefinitionAt(file string, line int, column int) (Definition, bool) {
+	occurrence, ok := SymbolAt(file, line, column)
+	if !ok {
+		return Definition{}, false
+	}
+
+	return DefinitionOf(occurrence.Meaning)
+}
+
+// ReferencesOf lists every occurrence of a symbol (its declaration included), across all files
+func ReferencesOf(definition Definition) []Occurrence {
+	references := make([]Occurrence, 0)
+
+	for _, file := range IndexedFiles() {
+		for _, occurrence := range occurrenceIndex[file] {
+			if occurrence.HasDefinition && occurrence.Definition == definition.Span {
+				references = append(references, occurrence)
+			}
+		}
+	}
+
+	return references
+}
+
+// Occurrences lists every symbol occurrence in a file, sorted by position
+func Occurrences(file string) []Occurrence {
+	BuildIndex()
+	return occurrenceIndex[file]
+}
+
+// IndexedFiles lists every file we know occurrences in (sorted, so results come out the same every time)
+func IndexedFiles() []string {
+	BuildIndex()
+
+	files := make([]string, 0, len(occurrenceIndex))
+	for file := range occurrenceIndex {
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+	return files
+}
+
+// BuildIndex sorts all tokens we know about into files, if that hasn't happened yet
+func BuildIndex() {
+	if occurrenceIndex != nil {
+		return
+	}
+
+	ensureMappings()
+	occurrenceIndex = make(map[string][]Occurrence)
+
+	add := func(token lexer.Token, meaning TokenMeaning, isDeclaration bool) {
+		if token.Span.File == "" {
+			return
+		}
+
+		definition, ok := DefinitionOf(meaning)
+		occurrenceIndex[token.Span.File] = append(occurrenceIndex[token.Span.File], Occurrence{
+			Token:         token,
+			Meaning:       meaning,
+			IsDeclaration: isDeclaration,
+			Definition:    definition.Span,
+			HasDefinition: ok,
+		})
+	}
+
+	for token, meaning := range DeclarationMapping {
+		add(token, meaning, true)
+	}
+
+	for token, meaning := range TokenMapping {
+		// declarations win, no need to list a token twice
+		if _, ok := DeclarationMapping[token]; ok {
+			continue
+		}
+
+		add(token, meaning, false)
+	}
+
+	for _, occurrences := range occurrenceIndex {
+		sort.Slice(occurrences, func(i, j int) bool {
+			return occurrences[i].Token.Span.StartIndex < occurrences[j].Token.Span.StartIndex
+		})
+	}
+}
+
+// SpanContains checks if a position is inside a span (the very end counts too, that's where the cursor sits after typing a name)
+func SpanContains(span print.TextSpan, line int, column int) bool {
+	if line < span.StartLine || line > span.EndLine {
+		return false
+	}
+
+	if line == span.StartLine && column < span.StartColumn {
+		return false
+	}
+
+	if line == span.EndLine && column > span.EndColumn {
+		return false
+	}
+
+	return true
+}
+
+// </QUERIES> -----------------------------------------------------------------
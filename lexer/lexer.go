@@ -17,6 +17,7 @@ type Lexer struct {
 	Column                int
 	Index                 int
 	Tokens                []Token
+	Comments              []Token // kept separately so the parser never has to see them
 	TreatHashtagAsComment bool
 }
 
@@ -25,68 +26,94 @@ func Lex(code []rune, filename string) []Token {
 	return LexInternal(code, filename, true)
 }
 
+// LexWithComments does the same as Lex, but also gives back all comments it found (the formatter needs those)
+func LexWithComments(code []rune, filename string) ([]Token, []Token) {
+	scanner := CreateLexer(code, filename, true)
+	return scanner.Lex(), scanner.Comments
+}
+
 func LexInternal(code []rune, filename string, treatHashtagsAsComments bool) []Token {
+	return CreateLexer(code, filename, treatHashtagsAsComments).Lex()
+}
+
+func CreateLexer(code []rune, filename string, treatHashtagsAsComments bool) *Lexer {
 	// Opens the file and returns its contents as a byte array
 	// It then creates a lexer pointer using the byte array and a few default values.
-	scanner := &Lexer{
+	return &Lexer{
 		Code:                  code,
 		File:                  filename,
 		Line:                  1,
 		Column:                1,
 		Index:                 0,
 		Tokens:                make([]Token, 0),
+		Comments:              make([]Token, 0),
 		TreatHashtagAsComment: treatHashtagsAsComments,
 	}
+}
 
+func (lxr *Lexer) Lex() []Token {
 	// remember this :o
-	RememberSourceFile(code, filename)
+	RememberSourceFile(lxr.Code, lxr.File)
 
 	// Scanning for all the juicy tokens
-	for scanner.Index < len(scanner.Code) {
-		c := scanner.Code[scanner.Index]
+	lxr.scan(len(lxr.Code))
+
+	// Finally, adding an End of File token to help detect the end of the file in the parser (syntax)
+	lxr.Tokens = append(lxr.Tokens, CreateToken("\000", EOF, lxr.GetCurrentTextSpan(0)))
+
+	// hand out all the whitespace and comments we skipped over
+	lxr.attachTrivia()
+	return lxr.Tokens
+}
+
+// scan keeps picking up tokens until it reaches the given index
+// (that's the end of the file, or the end of an interpolated string's hole)
+func (lxr *Lexer) scan(end int) {
+	for lxr.Index < end {
+		c := lxr.Code[lxr.Index]
 
 		peek := func(offset int) rune {
-			if scanner.Index+offset < len(scanner.Code) {
-				return scanner.Code[scanner.Index+offset]
+			if lxr.Index+offset < len(lxr.Code) {
+				return lxr.Code[lxr.Index+offset]
 			}
 			return '\000'
 		}
 
 		if unicode.IsLetter(c) {
-			scanner.getId()
+			lxr.getId()
 		} else if unicode.IsNumber(c) {
-			scanner.getNumber()
+			lxr.getNumber()
 		} else if c == '"' || c == '\'' {
-			scanner.getString()
+			lxr.getString()
+		} else if c == '$' && peek(1) == '"' {
+			lxr.getInterpolatedString()
 		} else if c == '/' && peek(1) == '/' ||
-			(scanner.TreatHashtagAsComment && c == '#') {
-			scanner.getComment()
-		} else if c != ' ' && c != '\n' && c != '\t' && c != '\v' {
-			scanner.getOperator()
+			(lxr.TreatHashtagAsComment && c == '#') {
+			lxr.getComment()
+		} else if c != ' ' && c != '\n' && c != '\t' && c != '\v' && c != '\r' {
+			lxr.getOperator()
 		} else {
-			scanner.Increment()
+			lxr.Increment()
 		}
 	}
-	// Finally, adding an End of File token to help detect the end of the file in the parser (syntax)
-	scanner.Tokens = append(scanner.Tokens, CreateToken("\000", EOF, scanner.GetCurrentTextSpan(0)))
-	return scanner.Tokens
 }
 
 // getNumber keeps getting bytes until it finds a non-number
 // then it generates an integer (or a float) token and slaps it back to the lexer.
 func (lxr *Lexer) getNumber() {
+	start := lxr.Index
 	buffer := string(lxr.Code[lxr.Index])
 	lxr.Increment()
 
-	if lxr.Code[lxr.Index] == 'x' {
+	if lxr.Index < len(lxr.Code) && lxr.Code[lxr.Index] == 'x' {
 		lxr.Increment() // skip the 0x prefix
-		lxr.getNumberHex()
+		lxr.getNumberHex(start)
 		return
 	}
 
-	if lxr.Code[lxr.Index] == 'b' {
+	if lxr.Index < len(lxr.Code) && lxr.Code[lxr.Index] == 'b' {
 		lxr.Increment() // skip the 0b prefix
-		lxr.getNumberBinary()
+		lxr.getNumberBinary(start)
 		return
 	}
 
@@ -119,13 +146,13 @@ func (lxr *Lexer) getNumber() {
 			print.Error(
 				"LEXER",
 				print.RealValueConversionError,
-				lxr.GetCurrentTextSpan(len(buffer)),
+				lxr.GetCurrentTextSpan(lxr.Index-start),
 				"value \"%s\" could not be converted to real value [float] (NumberToken)!",
 				buffer,
 			)
 		}
 
-		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, float32(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(len(buffer))))
+		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, float32(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 
 	} else {
 		// int real value
@@ -136,20 +163,20 @@ func (lxr *Lexer) getNumber() {
 				print.Error(
 					"LEXER",
 					print.RealValueConversionError,
-					lxr.GetCurrentTextSpan(len(buffer)),
+					lxr.GetCurrentTextSpan(lxr.Index-start),
 					"value \"%s\" could not be converted to real value [int] (NumberToken)!",
 					buffer,
 				)
 			}
-			lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, realerValueBuffer, NumberToken, lxr.GetCurrentTextSpan(len(buffer))))
+			lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, realerValueBuffer, NumberToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 		}
-		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, realValueBuffer, NumberToken, lxr.GetCurrentTextSpan(len(buffer))))
+		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, realValueBuffer, NumberToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 	}
 }
 
 // getNumberHex keeps getting bytes until it finds a character that isn't 0-9 or A-F
 // then it generates an integer token and slaps it back to the lexer.
-func (lxr *Lexer) getNumberHex() {
+func (lxr *Lexer) getNumberHex(start int) {
 	allowedChars := "abcdefABCDEF"
 
 	// buffer for our number string
@@ -178,17 +205,17 @@ func (lxr *Lexer) getNumberHex() {
 		print.Error(
 			"LEXER",
 			print.RealValueConversionError,
-			lxr.GetCurrentTextSpan(len(buffer)),
+			lxr.GetCurrentTextSpan(lxr.Index-start),
 			"hex value \"%s\" could not be converted to real value [int] (NumberToken)!",
 			buffer,
 		)
 	}
-	lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, int(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(len(buffer))))
+	lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, int(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 }
 
 // getNumberBinary keeps getting bytes until it finds a character that isn't 0 or 1
 // then it generates an integer token and slaps it back to the lexer.
-func (lxr *Lexer) getNumberBinary() {
+func (lxr *Lexer) getNumberBinary(start int) {
 	// buffer for our number string
 	buffer := string(lxr.Code[lxr.Index])
 	lxr.Increment()
@@ -210,18 +237,19 @@ func (lxr *Lexer) getNumberBinary() {
 		print.Error(
 			"LEXER",
 			print.RealValueConversionError,
-			lxr.GetCurrentTextSpan(len(buffer)),
+			lxr.GetCurrentTextSpan(lxr.Index-start),
 			"hex value \"%s\" could not be converted to real value [int] (NumberToken)!",
 			buffer,
 		)
 	}
-	lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, int(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(len(buffer))))
+	lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, int(realValueBuffer), NumberToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 }
 
 // getString once it finds an " it'll keep getting bytes until it finds another "
 // Basically it's a string detector, string tokens are given back to the lexer (via Tokens []Token).
 func (lxr *Lexer) getString() {
 	var buffer string
+	start := lxr.Index
 	quote := lxr.Code[lxr.Index]
 	lxr.Increment()
 
@@ -247,21 +275,145 @@ func (lxr *Lexer) getString() {
 	lxr.Increment()
 
 	if quote == '"' {
-		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, buffer, StringToken, lxr.GetCurrentTextSpan(len(buffer)+2)))
+		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, buffer, StringToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
 	} else {
-		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, buffer, NativeStringToken, lxr.GetCurrentTextSpan(len(buffer)+2)))
+		lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, buffer, NativeStringToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
+	}
+}
+
+// getInterpolatedString handles strings like $"x is {x}!"
+// the text parts become normal string tokens, and everything in between { and } gets lexed like normal code
+// the whole thing is wrapped in a start and end token so the parser knows what's going on
+// (use {{ and }} if you want actual braces in there)
+func (lxr *Lexer) getInterpolatedString() {
+	lxr.Increment() // $
+	lxr.Increment() // "
+	lxr.Tokens = append(lxr.Tokens, CreateToken("$\"", InterpolatedStringStartToken, lxr.GetCurrentTextSpan(2)))
+
+	var buffer string
+	start := lxr.Index
+
+	// puts whatever text we've collected so far into a string token
+	flush := func() {
+		if buffer != "" {
+			lxr.Tokens = append(lxr.Tokens, CreateTokenReal(buffer, buffer, StringToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
+		}
+		buffer = ""
+	}
+
+	for lxr.Index < len(lxr.Code) && lxr.Code[lxr.Index] != '"' {
+		c := lxr.Code[lxr.Index]
+
+		next := '\000'
+		if lxr.Index+1 < len(lxr.Code) {
+			next = lxr.Code[lxr.Index+1]
+		}
+
+		// same escapes as normal strings
+		if c == '\\' && next == 'n' {
+			lxr.Increment()
+			lxr.Increment()
+			buffer += "\n"
+			continue
+		}
+		if c == '\\' && next == '"' {
+			lxr.Increment()
+			lxr.Increment()
+			buffer += "\""
+			continue
+		}
+
+		// double braces are just braces
+		if (c == '{' || c == '}') && next == c {
+			lxr.Increment()
+			lxr.Increment()
+			buffer += string(c)
+			continue
+		}
+
+		// the start of a hole -> lex everything up until the matching brace
+		if c == '{' {
+			flush()
+
+			lxr.Increment()
+			lxr.Tokens = append(lxr.Tokens, CreateToken("{", OpenBraceToken, lxr.GetCurrentTextSpan(1)))
+
+			end := lxr.findInterpolationEnd()
+			lxr.scan(end)
+
+			if lxr.Index >= len(lxr.Code) {
+				break
+			}
+
+			lxr.Increment()
+			lxr.Tokens = append(lxr.Tokens, CreateToken("}", CloseBraceToken, lxr.GetCurrentTextSpan(1)))
+
+			start = lxr.Index
+			continue
+		}
+
+		buffer += string(c)
+		lxr.Increment()
+	}
+
+	flush()
+
+	if lxr.Index >= len(lxr.Code) {
+		print.Error(
+			"LEXER",
+			print.UnexpectedCharacterError,
+			lxr.GetCurrentTextSpan(0),
+			"interpolated string was never closed!",
+		)
 	}
+
+	lxr.Increment()
+	lxr.Tokens = append(lxr.Tokens, CreateToken("\"", InterpolatedStringEndToken, lxr.GetCurrentTextSpan(1)))
+}
+
+// findInterpolationEnd looks for the brace closing the current hole of an interpolated string
+// nested braces and strings are skipped over, if there's no closing brace we just go to the end of the file
+func (lxr *Lexer) findInterpolationEnd() int {
+	depth := 0
+
+	for i := lxr.Index; i < len(lxr.Code); i++ {
+		switch lxr.Code[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case '"', '\'':
+			// skip over the string (and its escaped quotes)
+			quote := lxr.Code[i]
+			for i++; i < len(lxr.Code) && lxr.Code[i] != quote; i++ {
+				if lxr.Code[i] == '\\' {
+					i++
+				}
+			}
+		}
+	}
+
+	return len(lxr.Code)
 }
 
 // getComment we don't want to add comments to the Tokens because they have nothing of value
-// for us to process (at least for now), instead we just keep incrementing through them to increase
+// for the parser, instead we just keep incrementing through them to increase
 // the Lexer Column and Line until we find a new line.
+// (they do get put into the Comments list though, the formatter wants to keep them around)
 func (lxr *Lexer) getComment() {
+	start := lxr.Index
 
 	// just increment until we're at the end of file or and of a line
 	for lxr.Index < len(lxr.Code) && lxr.Code[lxr.Index] != '\n' {
 		lxr.Increment()
 	}
+
+	text := string(lxr.Code[start:lxr.Index])
+	lxr.Comments = append(lxr.Comments, CreateToken(text, CommentToken, lxr.GetCurrentTextSpan(lxr.Index-start)))
+
 	lxr.Increment()
 }
 
@@ -269,15 +421,15 @@ func (lxr *Lexer) getComment() {
 // This will also check if the index is out of range (End Of File) but leaves
 // Error handling to the parent function.
 func (lxr *Lexer) Increment() {
-	lxr.Index++
-	lxr.Column++
-
-	if lxr.Index >= len(lxr.Code) {
-		return
-	} else if lxr.Code[lxr.Index] == '\n' {
+	// stepping over a new line puts us at the start of the next one
+	if lxr.Index < len(lxr.Code) && lxr.Code[lxr.Index] == '\n' {
 		lxr.Line++
-		lxr.Column = 0
+		lxr.Column = 1
+	} else {
+		lxr.Column++
 	}
+
+	lxr.Index++
 }
 
 // getId gets and identifier Token and appends it to the Lexer Tokens
@@ -429,7 +581,7 @@ func (lxr *Lexer) getOperator() {
 	// (that is why they are separated).
 
 	lxr.Increment()
-	buffer := string(lxr.Code)[startIndex:lxr.Index]
+	buffer := string(lxr.Code[startIndex:lxr.Index])
 
 	// Generalised this a little because we now got a few multi-char operators - Red, thanks - Tokorv xD
 	lxr.Tokens = append(
@@ -438,7 +590,7 @@ func (lxr *Lexer) getOperator() {
 			buffer,
 			_token,
 			lxr.GetCurrentTextSpan(len(buffer)),
-			peek(1) == ' ',
+			unicode.IsSpace(peek(0)), // we've already stepped past the operator, so peek(0) is whatever comes after it
 		),
 	)
 }
@@ -488,70 +640,58 @@ func RememberSourceFile(contents []rune, filename string) {
 	print.SourceFiles[filename] = string(contents)
 }
 
+// Keywords holds every keyword there is, along with the kind of token it turns into
+var Keywords = map[string]TokenKind{
+	"var":        VarKeyword,
+	"set":        SetKeyword,
+	"to":         ToKeyword,
+	"if":         IfKeyword,
+	"else":       ElseKeyword,
+	"true":       TrueKeyword,
+	"false":      FalseKeyword,
+	"function":   FunctionKeyword,
+	"class":      ClassKeyword,
+	"from":       FromKeyword,
+	"for":        ForKeyword,
+	"foreach":    ForEachKeyword,
+	"in":         InKeyword,
+	"return":     ReturnKeyword,
+	"while":      WhileKeyword,
+	"break":      BreakKeyword,
+	"continue":   ContinueKeyword,
+	"make":       MakeKeyword,
+	"package":    PackageKeyword,
+	"use":        UseKeyword,
+	"alias":      AliasKeyword,
+	"external":   ExternalKeyword,
+	"c_variadic": CVariadicKeyword,
+	"c_adapted":  CAdaptedKeyword,
+	"ref":        RefKeyword,
+	"deref":      DerefKeyword,
+	"struct":     StructKeyword,
+	"lambda":     LambdaKeyword,
+	"this":       ThisKeyword,
+	"main":       MainKeyword,
+	"enum":       EnumKeyword,
+	"try":        TryKeyword,
+	"catch":      CatchKeyword,
+	"finally":    FinallyKeyword,
+	"throw":      ThrowKeyword,
+	"interface":  InterfaceKeyword,
+	"switch":     SwitchKeyword,
+	"case":       CaseKeyword,
+	"default":    DefaultKeyword,
+	"match":      MatchKeyword,
+}
+
 // CheckIfKeyword used by Lexer.getId to convert an identifier Token to a keyword Token
 func CheckIfKeyword(buffer string) TokenKind {
-	switch buffer {
-	case "var":
-		return VarKeyword
-	case "set":
-		return SetKeyword
-	case "to":
-		return ToKeyword
-	case "if":
-		return IfKeyword
-	case "else":
-		return ElseKeyword
-	case "true":
-		return TrueKeyword
-	case "false":
-		return FalseKeyword
-	case "function":
-		return FunctionKeyword
-	case "class":
-		return ClassKeyword
-	case "from":
-		return FromKeyword
-	case "for":
-		return ForKeyword
-	case "return":
-		return ReturnKeyword
-	case "while":
-		return WhileKeyword
-	case "break":
-		return BreakKeyword
-	case "continue":
-		return ContinueKeyword
-	case "make":
-		return MakeKeyword
-	case "package":
-		return PackageKeyword
-	case "use":
-		return UseKeyword
-	case "alias":
-		return AliasKeyword
-	case "external":
-		return ExternalKeyword
-	case "c_variadic":
-		return CVariadicKeyword
-	case "c_adapted":
-		return CAdaptedKeyword
-	case "ref":
-		return RefKeyword
-	case "deref":
-		return DerefKeyword
-	case "struct":
-		return StructKeyword
-	case "lambda":
-		return LambdaKeyword
-	case "this":
-		return ThisKeyword
-	case "main":
-		return MainKeyword
-	case "enum":
-		return EnumKeyword
-	default:
+	kind, ok := Keywords[buffer]
+	if !ok {
 		return IdToken
 	}
+
+	return kind
 }
 
 func (lxr *Lexer) GetCurrentTextSpan(buffer int) print.TextSpan {
@@ -23,6 +23,8 @@ const (
 	ClassKeyword     TokenKind = "class (Keyword)"
 	FromKeyword      TokenKind = "from (Keyword)"
 	ForKeyword       TokenKind = "for (Keyword)"
+	ForEachKeyword   TokenKind = "foreach (Keyword)"
+	InKeyword        TokenKind = "in (Keyword)"
 	ReturnKeyword    TokenKind = "return (Keyword)"
 	WhileKeyword     TokenKind = "while (Keyword)"
 	ContinueKeyword  TokenKind = "continue (keyword)"
@@ -41,6 +43,15 @@ const (
 	ThisKeyword      TokenKind = "this (keyword)"
 	MainKeyword      TokenKind = "main (keyword)"
 	EnumKeyword      TokenKind = "enum (keyword)"
+	TryKeyword       TokenKind = "try (keyword)"
+	CatchKeyword     TokenKind = "catch (keyword)"
+	FinallyKeyword   TokenKind = "finally (keyword)"
+	ThrowKeyword     TokenKind = "throw (keyword)"
+	InterfaceKeyword TokenKind = "interface (keyword)"
+	SwitchKeyword    TokenKind = "switch (keyword)"
+	CaseKeyword      TokenKind = "case (keyword)"
+	DefaultKeyword   TokenKind = "default (keyword)"
+	MatchKeyword     TokenKind = "match (keyword)"
 
 	// Tokens
 	EOF               TokenKind = "EndOfFile"
@@ -49,6 +60,10 @@ const (
 	NativeStringToken TokenKind = "NativeString"
 	NumberToken       TokenKind = "Number"
 
+	// Interpolated Strings ($"a {b} c")
+	InterpolatedStringStartToken TokenKind = "InterpolatedStringStart '$\"'"
+	InterpolatedStringEndToken   TokenKind = "InterpolatedStringEnd '\"'"
+
 	// Symbol Tokens
 	PlusToken          TokenKind = "Plus '+'"
 	ModulusToken       TokenKind = "Modulus '%'"
@@ -87,6 +102,8 @@ const (
 
 	HashtagToken TokenKind = "Hashtag '#'"
 
+	CommentToken TokenKind = "Comment" // never ends up in the token list, see Lexer.Comments
+
 	BadToken TokenKind = "Token Error (BadToken)" // Naughty ;)
 
 	Semicolon TokenKind = "Semicolon ';'" // Used to separate statements (for now... )
@@ -99,6 +116,8 @@ type Token struct {
 	Kind       TokenKind
 	Span       print2.TextSpan
 	SpaceAfter bool
+	Trivia     *Trivia // the whitespace and comments around this token, see trivia.go
+	Missing    bool    // the parser made this token up because it should have been there but wasn't
 }
 
 // CreateToken returns a Token created from the arguments provided
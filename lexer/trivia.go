@@ -0,0 +1,218 @@
+package lexer
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"sort"
+	"strings"
+)
+
+// trivia is everything in the source code the compiler doesn't care about (whitespace, new lines and comments)
+// every token gets the trivia around it attached, that way the exact source code can be rebuilt from the tokens alone
+
+// TriviaKind what kind of trivia a piece is
+type TriviaKind string
+
+const (
+	WhitespaceTrivia TriviaKind = "Whitespace"
+	NewLineTrivia    TriviaKind = "NewLine"
+	CommentTrivia    TriviaKind = "Comment"
+	SkippedTrivia    TriviaKind = "Skipped" // anything the lexer stepped over without making a token out of it
+)
+
+// Trivia is a token's exact text and everything around it
+type Trivia struct {
+	Text     string        // the token exactly like it was written
+	Leading  []TriviaPiece // everything since the end of the previous token's line
+	Trailing []TriviaPiece // everything behind the token up to (and including) the end of its line
+}
+
+// TriviaPiece is a single run of whitespace, a new line or a comment
+type TriviaPiece struct {
+	Kind TriviaKind
+	Text string
+	Span print.TextSpan
+}
+
+// FullText gives back the token exactly like it was written, trivia and all
+func (t Token) FullText() string {
+	if t.Trivia == nil {
+		return t.Value
+	}
+
+	return TriviaText(t.Trivia.Leading) + t.Trivia.Text + TriviaText(t.Trivia.Trailing)
+}
+
+// Text gives back the token exactly like it was written (without any trivia)
+func (t Token) Text() string {
+	if t.Trivia == nil {
+		return t.Value
+	}
+
+	return t.Trivia.Text
+}
+
+// LeadingComments gives back the comments directly above a token, with no blank lines in between
+// (that's what doc comments look like)
+func (t Token) LeadingComments() []TriviaPiece {
+	if t.Trivia == nil {
+		return nil
+	}
+
+	comments := make([]TriviaPiece, 0)
+	newLines := 0
+
+	// walk backwards from the token until we hit a blank line or something that isn't a comment
+	for i := len(t.Trivia.Leading) - 1; i >= 0; i-- {
+		piece := t.Trivia.Leading[i]
+
+		switch piece.Kind {
+		case NewLineTrivia:
+			newLines++
+			if newLines > 1 {
+				return comments
+			}
+		case CommentTrivia:
+			newLines = 0
+			comments = append([]TriviaPiece{piece}, comments...)
+		case WhitespaceTrivia:
+			continue
+		default:
+			return comments
+		}
+	}
+
+	return comments
+}
+
+// TriviaText puts a list of trivia pieces back together
+func TriviaText(pieces []TriviaPiece) string {
+	var text strings.Builder
+	for _, piece := range pieces {
+		text.WriteString(piece.Text)
+	}
+
+	return text.String()
+}
+
+// attachTrivia gives every token the trivia around it
+// trailing trivia goes up to the end of the token's line, the rest is leading trivia of the next token
+func (lxr *Lexer) attachTrivia() {
+	lineStarts := []int{0}
+	for i, c := range lxr.Code {
+		if c == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	previousEnd := 0
+	var previous *Trivia
+
+	for i := range lxr.Tokens {
+		token := &lxr.Tokens[i]
+
+		// some error tokens can have weird spans, dont trust them too much
+		start := token.Span.StartIndex
+		if start < previousEnd {
+			start = previousEnd
+		}
+		if start > len(lxr.Code) {
+			start = len(lxr.Code)
+		}
+
+		end := token.Span.EndIndex
+		if end < start {
+			end = start
+		}
+		if end > len(lxr.Code) {
+			end = len(lxr.Code)
+		}
+
+		pieces := lxr.splitTrivia(previousEnd, start, lineStarts)
+
+		// everything up to the first new line still belongs to the previous token
+		split := 0
+		if previous != nil {
+			for split < len(pieces) {
+				split++
+				if pieces[split-1].Kind == NewLineTrivia {
+					break
+				}
+			}
+
+			previous.Trailing = pieces[:split]
+		}
+
+		token.Trivia = &Trivia{
+			Text:     string(lxr.Code[start:end]),
+			Leading:  pieces[split:],
+			Trailing: make([]TriviaPiece, 0),
+		}
+
+		previous = token.Trivia
+		previousEnd = end
+	}
+}
+
+// splitTrivia cuts the code between two tokens into trivia pieces
+func (lxr *Lexer) splitTrivia(from int, to int, lineStarts []int) []TriviaPiece {
+	pieces := make([]TriviaPiece, 0)
+
+	for from < to {
+		c := lxr.Code[from]
+		end := from + 1
+		kind := SkippedTrivia
+
+		if c == '\n' {
+			kind = NewLineTrivia
+		} else if c == '/' && end < to && lxr.Code[end] == '/' || (lxr.TreatHashtagAsComment && c == '#') {
+			kind = CommentTrivia
+			for end < to && lxr.Code[end] != '\n' {
+				end++
+			}
+		} else if isTriviaSpace(c) {
+			kind = WhitespaceTrivia
+			for end < to && isTriviaSpace(lxr.Code[end]) {
+				end++
+			}
+		} else {
+			for end < to && lxr.Code[end] != '\n' && !isTriviaSpace(lxr.Code[end]) {
+				end++
+			}
+		}
+
+		pieces = append(pieces, TriviaPiece{
+			Kind: kind,
+			Text: string(lxr.Code[from:end]),
+			Span: lxr.spanBetween(from, end, lineStarts),
+		})
+
+		from = end
+	}
+
+	return pieces
+}
+
+// spanBetween creates a text span for two indexes into the code
+func (lxr *Lexer) spanBetween(start int, end int, lineStarts []int) print.TextSpan {
+	position := func(index int) (int, int) {
+		line := sort.Search(len(lineStarts), func(i int) bool { return lineStarts[i] > index }) - 1
+		return line + 1, index - lineStarts[line] + 1
+	}
+
+	startLine, startColumn := position(start)
+	endLine, endColumn := position(end)
+
+	return print.TextSpan{
+		File:        lxr.File,
+		StartIndex:  start,
+		EndIndex:    end,
+		StartLine:   startLine,
+		StartColumn: startColumn,
+		EndLine:     endLine,
+		EndColumn:   endColumn,
+	}
+}
+
+func isTriviaSpace(c rune) bool {
+	return c == ' ' || c == '\t' || c == '\v' || c == '\r'
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
-	"os"
 )
 
 var labelCounter int = 0
@@ -24,6 +23,18 @@ func Lower(functionSymbol symbols.FunctionSymbol, stmt boundnodes.BoundStatement
 }
 
 func Flatten(functionSymbol symbols.FunctionSymbol, stmt boundnodes.BoundStatementNode) boundnodes.BoundBlockStatementNode {
+	statements := FlattenStatements(stmt)
+
+	if functionSymbol.Type.Fingerprint() == builtins.Void.Fingerprint() {
+		if len(statements) == 0 || CanFallThrough(statements[len(statements)-1]) {
+			statements = append(statements, boundnodes.CreateBoundReturnStatementNode(nil, nodes.ReturnStatementNode{}))
+		}
+	}
+
+	return boundnodes.CreateBoundBlockStatementNode(statements, stmt.Source())
+}
+
+func FlattenStatements(stmt boundnodes.BoundStatementNode) []boundnodes.BoundStatementNode {
 	statements := make([]boundnodes.BoundStatementNode, 0)
 	stack := make([]boundnodes.BoundStatementNode, 0)
 
@@ -66,18 +77,13 @@ func Flatten(functionSymbol symbols.FunctionSymbol, stmt boundnodes.BoundStateme
 		}
 	}
 
-	if functionSymbol.Type.Fingerprint() == builtins.Void.Fingerprint() {
-		if len(statements) == 0 || CanFallThrough(statements[len(statements)-1]) {
-			statements = append(statements, boundnodes.CreateBoundReturnStatementNode(nil, nodes.ReturnStatementNode{}))
-		}
-	}
-
-	return boundnodes.CreateBoundBlockStatementNode(statements, stmt.Source())
+	return statements
 }
 
 func CanFallThrough(stmt boundnodes.BoundStatementNode) bool {
 	return stmt.NodeType() != boundnodes.BoundReturnStatement &&
-		stmt.NodeType() != boundnodes.BoundGotoStatement
+		stmt.NodeType() != boundnodes.BoundGotoStatement &&
+		stmt.NodeType() != boundnodes.BoundThrowStatement
 }
 
 func RewriteStatement(stmt boundnodes.BoundStatementNode) boundnodes.BoundStatementNode {
@@ -94,6 +100,10 @@ func RewriteStatement(stmt boundnodes.BoundStatementNode) boundnodes.BoundStatem
 		return RewriteForStatement(stmt.(boundnodes.BoundForStatementNode))
 	case boundnodes.BoundFromToStatement:
 		return RewriteFromToStatement(stmt.(boundnodes.BoundFromToStatementNode))
+	case boundnodes.BoundForEachStatement:
+		return RewriteForEachStatement(stmt.(boundnodes.BoundForEachStatementNode))
+	case boundnodes.BoundSwitchStatement:
+		return RewriteSwitchStatement(stmt.(boundnodes.BoundSwitchStatementNode))
 	case boundnodes.BoundLabelStatement:
 		return RewriteLabelStatement(stmt.(boundnodes.BoundLabelStatementNode))
 	case boundnodes.BoundGotoStatement:
@@ -104,9 +114,17 @@ func RewriteStatement(stmt boundnodes.BoundStatementNode) boundnodes.BoundStatem
 		return RewriteReturnStatement(stmt.(boundnodes.BoundReturnStatementNode))
 	case boundnodes.BoundExpressionStatement:
 		return RewriteExpressionStatement(stmt.(boundnodes.BoundExpressionStatementNode))
+	case boundnodes.BoundTryStatement:
+		return RewriteTryStatement(stmt.(boundnodes.BoundTryStatementNode))
+	case boundnodes.BoundThrowStatement:
+		return RewriteThrowStatement(stmt.(boundnodes.BoundThrowStatementNode))
+	case boundnodes.BoundTryStartStatement:
+		return stmt
+	case boundnodes.BoundTryEndStatement:
+		return stmt
 	default:
 		print.PrintC(print.Red, "Statement unaccounted for in lowerer! (stuff being in here is important lol)")
-		os.Exit(-1) // we crashin
+		print.Crash(-1) // we crashin
 	}
 
 	return nil
@@ -270,6 +288,126 @@ func RewriteFromToStatement(stmt boundnodes.BoundFromToStatementNode) boundnodes
 	return RewriteStatement(result)
 }
 
+func RewriteForEachStatement(stmt boundnodes.BoundForEachStatementNode) boundnodes.BoundStatementNode {
+	collection := RewriteExpression(stmt.Collection)
+
+	// maps get looped over through an array of their keys
+	if collection.Type().Name == builtins.Map.Name {
+		keys := builtins.Keys
+		keys.Type = symbols.CreateTypeSymbol("array", []symbols.TypeSymbol{collection.Type().SubTypes[0]}, true, false, false, symbols.PackageSymbol{}, nil)
+		collection = boundnodes.CreateBoundTypeCallExpressionNode(collection, keys, make([]boundnodes.BoundExpressionNode, 0), stmt.Source())
+	}
+
+	// store the collection so it only gets evaluated once
+	collectionSymbol := symbols.CreateLocalVariableSymbol("collection", true, collection.Type())
+	collectionDeclaration := boundnodes.CreateBoundVariableDeclarationStatementNode(collectionSymbol, collection, stmt.Source())
+	collectionExpression := boundnodes.CreateBoundVariableExpressionNode(collectionSymbol, false, stmt.Source())
+
+	statements := []boundnodes.BoundStatementNode{collectionDeclaration}
+
+	// arrays hand out their elements directly
+	lengthFunction := builtins.GetArrayLength
+	var elementBase boundnodes.BoundExpressionNode = collectionExpression
+	isPointer := false
+
+	// strings get gone through byte by byte, straight out of their buffer
+	if collection.Type().Fingerprint() == builtins.String.Fingerprint() {
+		lengthFunction = builtins.GetLength
+
+		bufferSymbol := symbols.CreateLocalVariableSymbol("buffer", true, builtins.GetBuffer.Type)
+		bufferDeclaration := boundnodes.CreateBoundVariableDeclarationStatementNode(
+			bufferSymbol,
+			boundnodes.CreateBoundTypeCallExpressionNode(collectionExpression, builtins.GetBuffer, make([]boundnodes.BoundExpressionNode, 0), stmt.Source()),
+			stmt.Source(),
+		)
+
+		statements = append(statements, bufferDeclaration)
+		elementBase = boundnodes.CreateBoundVariableExpressionNode(bufferSymbol, false, stmt.Source())
+		isPointer = true
+	}
+
+	indexSymbol := symbols.CreateLocalVariableSymbol("index", false, builtins.Int)
+	indexDeclaration := boundnodes.CreateBoundVariableDeclarationStatementNode(indexSymbol, boundnodes.CreateBoundLiteralExpressionNodeFromValue(0, stmt.Source()), stmt.Source())
+	indexExpression := boundnodes.CreateBoundVariableExpressionNode(indexSymbol, false, stmt.Source())
+
+	lengthSymbol := symbols.CreateLocalVariableSymbol("length", true, builtins.Int)
+	lengthDeclaration := boundnodes.CreateBoundVariableDeclarationStatementNode(
+		lengthSymbol,
+		boundnodes.CreateBoundTypeCallExpressionNode(collectionExpression, lengthFunction, make([]boundnodes.BoundExpressionNode, 0), stmt.Source()),
+		stmt.Source(),
+	)
+
+	condition := boundnodes.CreateBoundBinaryExpressionNode(
+		indexExpression,
+		boundnodes.BindBinaryOperator(lexer.LessThanToken, builtins.Int, builtins.Int),
+		boundnodes.CreateBoundVariableExpressionNode(lengthSymbol, false, stmt.Source()), stmt.Source(),
+	)
+
+	// load the current element into our loop variable
+	elementDeclaration := boundnodes.CreateBoundVariableDeclarationStatementNode(
+		stmt.Variable,
+		boundnodes.CreateBoundArrayAccessExpressionNode(elementBase, indexExpression, isPointer, stmt.Source()),
+		stmt.Source(),
+	)
+
+	continueLabelStatement := boundnodes.CreateBoundLabelStatementNode(stmt.ContinueLabel, stmt.Source())
+	increment := boundnodes.CreateBoundExpressionStatementNode(
+		boundnodes.CreateBoundAssignmentExpressionNode(
+			indexSymbol,
+			boundnodes.CreateBoundBinaryExpressionNode(
+				indexExpression,
+				boundnodes.BindBinaryOperator(lexer.PlusToken, builtins.Int, builtins.Int),
+				boundnodes.CreateBoundLiteralExpressionNodeFromValue(1, stmt.Source()), stmt.Source(),
+			), false, stmt.Source(),
+		), stmt.Source(),
+	)
+
+	gotoContinue := boundnodes.CreateBoundGotoStatementNode(stmt.ContinueLabel, stmt.Source())
+	whileBody := boundnodes.CreateBoundBlockStatementNode([]boundnodes.BoundStatementNode{
+		elementDeclaration,
+		stmt.Body,
+		gotoContinue,
+		continueLabelStatement,
+		increment,
+	}, stmt.Source())
+
+	whileStatement := boundnodes.CreateBoundWhileStatementNode(condition, whileBody, stmt.BreakLabel, GenerateLabel(), stmt.Source())
+
+	statements = append(statements, indexDeclaration, lengthDeclaration, whileStatement)
+
+	result := boundnodes.CreateBoundBlockStatementNode(statements, stmt.Source())
+	return RewriteStatement(result)
+}
+
+func RewriteSwitchStatement(stmt boundnodes.BoundSwitchStatementNode) boundnodes.BoundStatementNode {
+	// switch (<value>) { case a, b: <first> case c: <second> default: <default> }
+	//
+	// <- gets lowered into: ->
+	//
+	// {
+	//   var tmp <- <value>
+	//   if (tmp = a || tmp = b) <first>
+	//   else if (tmp = c) <second>
+	//   else <default>
+	// }
+
+	declaration := boundnodes.CreateBoundVariableDeclarationStatementNode(stmt.Variable, stmt.Expression, stmt.Source())
+
+	// build the if chain from the back
+	chain := stmt.DefaultBody
+	for i := len(stmt.Conditions) - 1; i >= 0; i-- {
+		chain = boundnodes.CreateBoundIfStatementNode(stmt.Conditions[i], stmt.Bodies[i], chain, stmt.Source())
+	}
+
+	statements := []boundnodes.BoundStatementNode{declaration}
+	if chain != nil {
+		statements = append(statements, chain)
+	}
+
+	result := boundnodes.CreateBoundBlockStatementNode(statements, stmt.Source())
+	return RewriteStatement(result)
+}
+
 func RewriteLabelStatement(stmt boundnodes.BoundLabelStatementNode) boundnodes.BoundLabelStatementNode {
 	return stmt
 }
@@ -297,6 +435,300 @@ func RewriteExpressionStatement(stmt boundnodes.BoundExpressionStatementNode) bo
 	return boundnodes.CreateBoundExpressionStatementNode(expression, stmt.Source())
 }
 
+// pending codes used to find out where to go after a finally block
+const (
+	PendingNothing = iota // just keep going
+	PendingRethrow        // the exception wasn't handled, throw it again
+	PendingReturn         // someone returned from inside the try statement
+	PendingLabel          // someone jumped out of the try statement (PendingLabel + n is the nth jump target)
+)
+
+// keeps track of all the ways to leave a try statement early
+type TryExits struct {
+	FinallyLabel boundnodes.BoundLabel // empty if there's no finally block
+	Pending      symbols.VariableSymbol
+	ReturnValue  symbols.VariableSymbol
+	HasReturns   bool
+	Targets      []boundnodes.BoundLabel
+}
+
+func (exits *TryExits) TargetCode(label boundnodes.BoundLabel) int {
+	for i, target := range exits.Targets {
+		if target == label {
+			return PendingLabel + i
+		}
+	}
+
+	exits.Targets = append(exits.Targets, label)
+	return PendingLabel + len(exits.Targets) - 1
+}
+
+func RewriteTryStatement(stmt boundnodes.BoundTryStatementNode) boundnodes.BoundStatementNode {
+	// try { <body> } catch (e) { <catch> } finally { <finally> }
+	//
+	// <- gets lowered into: ->
+	//
+	// var %pending <- 0
+	// tryStart catch, body
+	// body:
+	//   <body>
+	//   tryEnd
+	//   goto finally
+	// catch:
+	//   var e <- <caught exception>
+	//   tryStart rethrow, catchBody
+	// catchBody:
+	//   <catch>
+	//   tryEnd
+	//   goto finally
+	// rethrow:
+	//   var %exception <- <caught exception>
+	//   %pending <- <rethrow>
+	//   goto finally
+	// finally:
+	//   <finally>
+	//   condGoto %pending = 0, end, ...
+	//   ... (one condGoto per way of leaving the try statement)
+	// end:
+	//
+	// any goto or return leaving <body> or <catch> pops the handler,
+	// remembers where it wanted to go in %pending and goes through finally first.
+	// if there's no finally block all of this collapses into a lot less code.
+	src := stmt.Source()
+
+	hasCatch := stmt.CatchBody != nil
+	hasFinally := stmt.FinallyBody != nil
+
+	bodyLabel := GenerateLabel()
+	catchLabel := GenerateLabel()
+	endLabel := GenerateLabel()
+
+	exits := &TryExits{}
+	result := make([]boundnodes.BoundStatementNode, 0)
+	stubs := make([]boundnodes.BoundStatementNode, 0)
+
+	if hasFinally {
+		exits.FinallyLabel = GenerateLabel()
+		exits.Pending = symbols.CreateLocalVariableSymbol(symbols.GetTempName(), false, builtins.Int)
+		result = append(result, boundnodes.CreateBoundVariableDeclarationStatementNode(exits.Pending, boundnodes.CreateBoundLiteralExpressionNodeFromValue(PendingNothing, src), src))
+	}
+
+	// where to go once we're done with the try or catch block
+	afterwards := endLabel
+	if hasFinally {
+		afterwards = exits.FinallyLabel
+	}
+
+	// the try block
+	// ------------
+	body, bodyStubs := RedirectTryExits(FlattenStatements(RewriteStatement(stmt.Body)), exits, src)
+	result = append(result, boundnodes.CreateBoundTryStartStatementNode(catchLabel, bodyLabel, src))
+	result = append(result, boundnodes.CreateBoundLabelStatementNode(bodyLabel, src))
+	result = append(result, body...)
+	result = append(result, boundnodes.CreateBoundTryEndStatementNode(src))
+	result = append(result, boundnodes.CreateBoundGotoStatementNode(afterwards, src))
+	stubs = append(stubs, bodyStubs...)
+
+	// the catch block
+	// ---------------
+	rethrowLabel := catchLabel
+	if hasCatch {
+		rethrowLabel = GenerateLabel()
+		result = append(result, boundnodes.CreateBoundLabelStatementNode(catchLabel, src))
+
+		if stmt.CatchVariable != nil {
+			result = append(result, boundnodes.CreateBoundVariableDeclarationStatementNode(stmt.CatchVariable, boundnodes.CreateBoundCaughtExceptionExpressionNode(src), src))
+		}
+
+		catchBody := FlattenStatements(RewriteStatement(stmt.CatchBody))
+
+		if hasFinally {
+			// if the catch block throws, we still need to run finally before passing the exception on
+			catchBodyLabel := GenerateLabel()
+			guardedBody, catchStubs := RedirectTryExits(catchBody, exits, src)
+
+			result = append(result, boundnodes.CreateBoundTryStartStatementNode(rethrowLabel, catchBodyLabel, src))
+			result = append(result, boundnodes.CreateBoundLabelStatementNode(catchBodyLabel, src))
+			result = append(result, guardedBody...)
+			result = append(result, boundnodes.CreateBoundTryEndStatementNode(src))
+			stubs = append(stubs, catchStubs...)
+		} else {
+			// no finally -> the handler is already gone and nothing needs redirecting
+			result = append(result, catchBody...)
+		}
+
+		result = append(result, boundnodes.CreateBoundGotoStatementNode(afterwards, src))
+	}
+
+	result = append(result, stubs...)
+
+	// the finally block
+	// -----------------
+	if hasFinally {
+		// remember the exception so finally can't mess with it
+		exception := symbols.CreateLocalVariableSymbol(symbols.GetTempName(), false, builtins.Exception)
+
+		result = append(result, boundnodes.CreateBoundLabelStatementNode(rethrowLabel, src))
+		result = append(result, boundnodes.CreateBoundVariableDeclarationStatementNode(exception, boundnodes.CreateBoundCaughtExceptionExpressionNode(src), src))
+		result = append(result, SetPending(exits, PendingRethrow, src))
+		result = append(result, boundnodes.CreateBoundGotoStatementNode(exits.FinallyLabel, src))
+
+		result = append(result, boundnodes.CreateBoundLabelStatementNode(exits.FinallyLabel, src))
+		result = append(result, FlattenStatements(RewriteStatement(stmt.FinallyBody))...)
+
+		// figure out where we were going before finally
+		dispatch := func(code int, target boundnodes.BoundLabel) {
+			nextLabel := GenerateLabel()
+			condition := boundnodes.CreateBoundBinaryExpressionNode(
+				boundnodes.CreateBoundVariableExpressionNode(exits.Pending, false, src),
+				boundnodes.BindBinaryOperator(lexer.EqualsToken, builtins.Int, builtins.Int),
+				boundnodes.CreateBoundLiteralExpressionNodeFromValue(code, src), src,
+			)
+
+			result = append(result, boundnodes.CreateBoundConditionalGotoStatementNode(condition, target, nextLabel, src))
+			result = append(result, boundnodes.CreateBoundLabelStatementNode(nextLabel, src))
+		}
+
+		dispatch(PendingNothing, endLabel)
+
+		rethrowThrowLabel := GenerateLabel()
+		dispatch(PendingRethrow, rethrowThrowLabel)
+
+		returnLabel := GenerateLabel()
+		if exits.HasReturns {
+			dispatch(PendingReturn, returnLabel)
+		}
+
+		for i, target := range exits.Targets {
+			dispatch(PendingLabel+i, target)
+		}
+
+		result = append(result, boundnodes.CreateBoundGotoStatementNode(endLabel, src))
+
+		// pass the exception on
+		result = append(result, boundnodes.CreateBoundLabelStatementNode(rethrowThrowLabel, src))
+		result = append(result, boundnodes.CreateBoundThrowStatementNode(boundnodes.CreateBoundVariableExpressionNode(exception, false, src), src))
+
+		// finish the return
+		if exits.HasReturns {
+			result = append(result, boundnodes.CreateBoundLabelStatementNode(returnLabel, src))
+			result = append(result, ReturnValue(exits, src))
+		}
+	}
+
+	result = append(result, boundnodes.CreateBoundLabelStatementNode(endLabel, src))
+
+	return boundnodes.CreateBoundBlockStatementNode(result, src)
+}
+
+// RedirectTryExits makes every goto and return leaving the given statements pop the active handler first.
+// The returned stubs need to be placed somewhere nobody falls into.
+func RedirectTryExits(statements []boundnodes.BoundStatementNode, exits *TryExits, src nodes.SyntaxNode) ([]boundnodes.BoundStatementNode, []boundnodes.BoundStatementNode) {
+	// labels declared in here are fine to jump to
+	localLabels := make(map[boundnodes.BoundLabel]bool)
+	for _, stmt := range statements {
+		if stmt.NodeType() == boundnodes.BoundLabelStatement {
+			localLabels[stmt.(boundnodes.BoundLabelStatementNode).Label] = true
+		}
+	}
+
+	// everything needed to jump to a label outside
+	exitTo := func(label boundnodes.BoundLabel) []boundnodes.BoundStatementNode {
+		if exits.FinallyLabel == "" {
+			return []boundnodes.BoundStatementNode{
+				boundnodes.CreateBoundTryEndStatementNode(src),
+				boundnodes.CreateBoundGotoStatementNode(label, src),
+			}
+		}
+
+		return []boundnodes.BoundStatementNode{
+			boundnodes.CreateBoundTryEndStatementNode(src),
+			SetPending(exits, exits.TargetCode(label), src),
+			boundnodes.CreateBoundGotoStatementNode(exits.FinallyLabel, src),
+		}
+	}
+
+	result := make([]boundnodes.BoundStatementNode, 0)
+	stubs := make([]boundnodes.BoundStatementNode, 0)
+
+	// conditional jumps get a little stub they can jump to instead
+	stubFor := func(label boundnodes.BoundLabel) boundnodes.BoundLabel {
+		if localLabels[label] {
+			return label
+		}
+
+		stubLabel := GenerateLabel()
+		stubs = append(stubs, boundnodes.CreateBoundLabelStatementNode(stubLabel, src))
+		stubs = append(stubs, exitTo(label)...)
+		return stubLabel
+	}
+
+	for _, stmt := range statements {
+		switch stmt.NodeType() {
+		case boundnodes.BoundGotoStatement:
+			gotoStatement := stmt.(boundnodes.BoundGotoStatementNode)
+			if !localLabels[gotoStatement.Label] {
+				result = append(result, exitTo(gotoStatement.Label)...)
+				continue
+			}
+
+		case boundnodes.BoundConditionalGotoStatement:
+			gotoStatement := stmt.(boundnodes.BoundConditionalGotoStatementNode)
+			ifLabel := stubFor(gotoStatement.IfLabel)
+			elseLabel := stubFor(gotoStatement.ElseLabel)
+			result = append(result, boundnodes.CreateBoundConditionalGotoStatementNode(gotoStatement.Condition, ifLabel, elseLabel, gotoStatement.Source()))
+			continue
+
+		case boundnodes.BoundReturnStatement:
+			returnStatement := stmt.(boundnodes.BoundReturnStatementNode)
+
+			// calculate the return value while we're still protected
+			if returnStatement.Expression != nil {
+				if exits.ReturnValue == nil {
+					exits.ReturnValue = symbols.CreateLocalVariableSymbol(symbols.GetTempName(), false, returnStatement.Expression.Type())
+					result = append(result, boundnodes.CreateBoundVariableDeclarationStatementNode(exits.ReturnValue, returnStatement.Expression, src))
+				} else {
+					result = append(result, boundnodes.CreateBoundExpressionStatementNode(boundnodes.CreateBoundAssignmentExpressionNode(exits.ReturnValue, returnStatement.Expression, false, src), src))
+				}
+			}
+
+			result = append(result, boundnodes.CreateBoundTryEndStatementNode(src))
+
+			if exits.FinallyLabel == "" {
+				result = append(result, ReturnValue(exits, src))
+			} else {
+				exits.HasReturns = true
+				result = append(result, SetPending(exits, PendingReturn, src))
+				result = append(result, boundnodes.CreateBoundGotoStatementNode(exits.FinallyLabel, src))
+			}
+			continue
+		}
+
+		result = append(result, stmt)
+	}
+
+	return result, stubs
+}
+
+func SetPending(exits *TryExits, code int, src nodes.SyntaxNode) boundnodes.BoundStatementNode {
+	return boundnodes.CreateBoundExpressionStatementNode(
+		boundnodes.CreateBoundAssignmentExpressionNode(exits.Pending, boundnodes.CreateBoundLiteralExpressionNodeFromValue(code, src), false, src), src,
+	)
+}
+
+func ReturnValue(exits *TryExits, src nodes.SyntaxNode) boundnodes.BoundStatementNode {
+	if exits.ReturnValue == nil {
+		return boundnodes.CreateBoundReturnStatementNode(nil, src)
+	}
+
+	return boundnodes.CreateBoundReturnStatementNode(boundnodes.CreateBoundVariableExpressionNode(exits.ReturnValue, false, src), src)
+}
+
+func RewriteThrowStatement(stmt boundnodes.BoundThrowStatementNode) boundnodes.BoundThrowStatementNode {
+	expression := RewriteExpression(stmt.Expression)
+	return boundnodes.CreateBoundThrowStatementNode(expression, stmt.Source())
+}
+
 func RewriteExpression(expr boundnodes.BoundExpressionNode) boundnodes.BoundExpressionNode {
 	switch expr.NodeType() {
 	case boundnodes.BoundErrorExpression:
@@ -333,12 +765,18 @@ func RewriteExpression(expr boundnodes.BoundExpressionNode) boundnodes.BoundExpr
 		return RewriteMakeExpression(expr.(boundnodes.BoundMakeExpressionNode))
 	case boundnodes.BoundMakeArrayExpression:
 		return RewriteMakeArrayExpression(expr.(boundnodes.BoundMakeArrayExpressionNode))
+	case boundnodes.BoundMakeMapExpression:
+		return expr
 	case boundnodes.BoundMakeStructExpression:
 		return RewriteMakeStructExpression(expr.(boundnodes.BoundMakeStructExpressionNode))
 	case boundnodes.BoundFunctionExpression:
 		return RewriteFunctionExpression(expr.(boundnodes.BoundFunctionExpressionNode))
 	case boundnodes.BoundTernaryExpression:
 		return RewriteTernaryExpression(expr.(boundnodes.BoundTernaryExpressionNode))
+	case boundnodes.BoundMatchExpression:
+		return RewriteMatchExpression(expr.(boundnodes.BoundMatchExpressionNode))
+	case boundnodes.BoundInterpolatedStringExpression:
+		return RewriteInterpolatedStringExpression(expr.(boundnodes.BoundInterpolatedStringExpressionNode))
 	case boundnodes.BoundReferenceExpression:
 		return RewriteReferenceExpression(expr.(boundnodes.BoundReferenceExpressionNode))
 	case boundnodes.BoundDereferenceExpression:
@@ -349,9 +787,11 @@ func RewriteExpression(expr boundnodes.BoundExpressionNode) boundnodes.BoundExpr
 		return RewriteThisExpression(expr.(boundnodes.BoundThisExpressionNode))
 	case boundnodes.BoundEnumExpression:
 		return RewriteEnumExpression(expr.(boundnodes.BoundEnumExpressionNode))
+	case boundnodes.BoundCaughtExceptionExpression:
+		return expr
 	default:
 		print.PrintC(print.Red, "Expression unaccounted for in lowerer! (stuff being in here is important lol)")
-		os.Exit(-1) // we crashin
+		print.Crash(-1) // we crashin
 		return nil
 	}
 }
@@ -560,6 +1000,34 @@ func RewriteTernaryExpression(expr boundnodes.BoundTernaryExpressionNode) boundn
 	return newExpr
 }
 
+func RewriteMatchExpression(expr boundnodes.BoundMatchExpressionNode) boundnodes.BoundMatchExpressionNode {
+	// just like ternaries, matches get turned into jumps by the emitter
+	expression := RewriteExpression(expr.Expression)
+
+	conditions := make([]boundnodes.BoundExpressionNode, 0)
+	for _, condition := range expr.Conditions {
+		conditions = append(conditions, RewriteExpression(condition))
+	}
+
+	values := make([]boundnodes.BoundExpressionNode, 0)
+	for _, value := range expr.Values {
+		values = append(values, RewriteExpression(value))
+	}
+
+	defaultValue := RewriteExpression(expr.DefaultValue)
+
+	return boundnodes.CreateBoundMatchExpressionNode(expr.Variable, expression, conditions, values, defaultValue, expr.Tmp, expr.Source())
+}
+
+func RewriteInterpolatedStringExpression(expr boundnodes.BoundInterpolatedStringExpressionNode) boundnodes.BoundInterpolatedStringExpressionNode {
+	parts := make([]boundnodes.BoundExpressionNode, 0)
+	for _, part := range expr.Parts {
+		parts = append(parts, RewriteExpression(part))
+	}
+
+	return boundnodes.CreateBoundInterpolatedStringExpressionNode(parts, expr.Source())
+}
+
 func RewriteReferenceExpression(expr boundnodes.BoundReferenceExpressionNode) boundnodes.BoundReferenceExpressionNode {
 	val := RewriteExpression(expr.Expression)
 	return boundnodes.CreateBoundReferenceExpressionNode(val, expr.Source())
@@ -573,7 +1041,7 @@ func RewriteDereferenceExpression(expr boundnodes.BoundDereferenceExpressionNode
 func RewriteLambdaExpression(expr boundnodes.BoundLambdaExpressionNode) boundnodes.BoundLambdaExpressionNode {
 	body := RewriteStatement(expr.Body)
 	flattened := Flatten(expr.Function, body)
-	return boundnodes.CreateBoundLambdaExpressionNode(expr.Function, flattened, expr.Source())
+	return boundnodes.CreateBoundLambdaExpressionNode(expr.Function, flattened, expr.Captures, expr.CapturesThis, expr.Source())
 }
 
 func RewriteThisExpression(expr boundnodes.BoundThisExpressionNode) boundnodes.BoundThisExpressionNode {
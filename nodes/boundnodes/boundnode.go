@@ -42,11 +42,17 @@ const (
 	BoundWhileStatement           BoundType = "BoundWhileStatement"
 	BoundForStatement             BoundType = "BoundForStatement"
 	BoundFromToStatement          BoundType = "BoundFromToStatement"
+	BoundForEachStatement         BoundType = "BoundForEachStatement"
 	BoundLabelStatement           BoundType = "BoundLabelStatement"
 	BoundGotoStatement            BoundType = "BoundGotoStatement"
 	BoundConditionalGotoStatement BoundType = "BoundConditionalGotoStatement"
 	BoundReturnStatement          BoundType = "BoundReturnStatement"
 	BoundExpressionStatement      BoundType = "BoundExpressionStatement"
+	BoundTryStatement             BoundType = "BoundTryStatement"
+	BoundThrowStatement           BoundType = "BoundThrowStatement"
+	BoundSwitchStatement          BoundType = "BoundSwitchStatement"
+	BoundTryStartStatement        BoundType = "BoundTryStartStatement"
+	BoundTryEndStatement          BoundType = "BoundTryEndStatement"
 
 	// Expressions
 	BoundErrorExpression                BoundType = "BoundErrorExpression"
@@ -66,8 +72,11 @@ const (
 	BoundArrayAssignmentExpression      BoundType = "BoundArrayAssignmentExpression"
 	BoundMakeExpression                 BoundType = "BoundMakeExpression"
 	BoundMakeArrayExpression            BoundType = "BoundMakeArrayExpression"
+	BoundMakeMapExpression              BoundType = "BoundMakeMapExpression"
 	BoundFunctionExpression             BoundType = "BoundFunctionExpression"
 	BoundTernaryExpression              BoundType = "BoundTernaryExpression"
+	BoundMatchExpression                BoundType = "BoundMatchExpression"
+	BoundInterpolatedStringExpression   BoundType = "BoundInterpolatedStringExpression"
 	BoundReferenceExpression            BoundType = "BoundReferenceExpression"
 	BoundDereferenceExpression          BoundType = "BoundDereferenceExpression"
 	BoundMakeStructExpression           BoundType = "BoundMakeStructExpression"
@@ -75,4 +84,5 @@ const (
 	BoundThisExpression                 BoundType = "BoundThisExpression"
 	BoundInternalValueExpression        BoundType = "BoundInternalValueExpression"
 	BoundEnumExpression                 BoundType = "BoundEnumExpression"
+	BoundCaughtExceptionExpression      BoundType = "BoundCaughtExceptionExpression"
 )
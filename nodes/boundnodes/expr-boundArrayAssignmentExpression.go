@@ -38,6 +38,11 @@ func (BoundArrayAssignmentExpressionNode) IsPersistent() bool { return true }
 
 // implement the expression node interface
 func (node BoundArrayAssignmentExpressionNode) Type() symbols.TypeSymbol {
+	// maps hand out their value type
+	if node.Base.Type().Name == "map" {
+		return node.Base.Type().SubTypes[1]
+	}
+
 	return node.Base.Type().SubTypes[0]
 }
 
@@ -0,0 +1,37 @@
+package boundnodes
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// only created by the lowerer
+// the exception that was thrown most recently (what a catch block gets to see)
+type BoundCaughtExceptionExpressionNode struct {
+	BoundExpressionNode
+
+	UnboundSource nodes.SyntaxNode
+}
+
+func (BoundCaughtExceptionExpressionNode) NodeType() BoundType { return BoundCaughtExceptionExpression }
+
+func (node BoundCaughtExceptionExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ BoundCaughtExceptionExpressionNode")
+}
+
+func (node BoundCaughtExceptionExpressionNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+func (BoundCaughtExceptionExpressionNode) IsPersistent() bool { return false }
+
+// implement the expression node interface
+func (node BoundCaughtExceptionExpressionNode) Type() symbols.TypeSymbol { return builtins.Exception }
+
+func CreateBoundCaughtExceptionExpressionNode(src nodes.SyntaxNode) BoundCaughtExceptionExpressionNode {
+	return BoundCaughtExceptionExpressionNode{
+		UnboundSource: src,
+	}
+}
@@ -0,0 +1,45 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// all parts have already been converted to strings, they just need to be joined together
+type BoundInterpolatedStringExpressionNode struct {
+	BoundExpressionNode
+
+	Parts         []BoundExpressionNode
+	UnboundSource nodes.SyntaxNode
+}
+
+func (BoundInterpolatedStringExpressionNode) NodeType() BoundType {
+	return BoundInterpolatedStringExpression
+}
+
+func (node BoundInterpolatedStringExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ BoundInterpolatedStringExpressionNode")
+	fmt.Println(indent + "  └ Parts: ")
+	for _, part := range node.Parts {
+		part.Print(indent + "    ")
+	}
+}
+
+func (node BoundInterpolatedStringExpressionNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+func (BoundInterpolatedStringExpressionNode) IsPersistent() bool { return false }
+
+// implement the expression node interface
+func (BoundInterpolatedStringExpressionNode) Type() symbols.TypeSymbol { return builtins.String }
+
+func CreateBoundInterpolatedStringExpressionNode(parts []BoundExpressionNode, src nodes.SyntaxNode) BoundInterpolatedStringExpressionNode {
+	return BoundInterpolatedStringExpressionNode{
+		Parts:         parts,
+		UnboundSource: src,
+	}
+}
@@ -12,6 +12,8 @@ type BoundLambdaExpressionNode struct {
 
 	Function      symbols.FunctionSymbol
 	Body          BoundBlockStatementNode
+	Captures      []symbols.VariableSymbol
+	CapturesThis  bool // does it use the object it was created in? (fields, class functions or "this")
 	UnboundSource nodes.SyntaxNode
 }
 
@@ -21,6 +23,17 @@ func (node BoundLambdaExpressionNode) Print(indent string) {
 	print.PrintC(print.Yellow, indent+"└ BoundLambdaExpressionNode")
 	fmt.Println(indent + "  └ Symbol: ")
 	node.Function.Print(indent + "    ")
+
+	if len(node.Captures) > 0 {
+		fmt.Println(indent + "  └ Captures: ")
+		for _, captured := range node.Captures {
+			captured.Print(indent + "    ")
+		}
+	}
+
+	if node.CapturesThis {
+		fmt.Println(indent + "  └ Captures: this")
+	}
 }
 
 func (node BoundLambdaExpressionNode) Source() nodes.SyntaxNode {
@@ -43,10 +56,12 @@ func (node BoundLambdaExpressionNode) Type() symbols.TypeSymbol {
 	return symbols.CreateTypeSymbol("action", subtypes, false, false, false, symbols.PackageSymbol{}, nil)
 }
 
-func CreateBoundLambdaExpressionNode(function symbols.FunctionSymbol, body BoundBlockStatementNode, src nodes.SyntaxNode) BoundLambdaExpressionNode {
+func CreateBoundLambdaExpressionNode(function symbols.FunctionSymbol, body BoundBlockStatementNode, captures []symbols.VariableSymbol, capturesThis bool, src nodes.SyntaxNode) BoundLambdaExpressionNode {
 	return BoundLambdaExpressionNode{
 		Function:      function,
 		Body:          body,
+		Captures:      captures,
+		CapturesThis:  capturesThis,
 		UnboundSource: src,
 	}
 }
@@ -6,7 +6,6 @@ import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
-	"os"
 )
 
 // basic global statement member
@@ -68,7 +67,7 @@ func CreateBoundLiteralExpressionNode(expr nodes.LiteralExpressionNode, src node
 			print.Red,
 			fmt.Sprintf("ERROR: Uknown type symbol \"%s\" debug: (BoundLiteralExpressionNode line 40)", expr.LiteralValue.(string)),
 		)
-		os.Exit(1) // shrug
+		print.Crash(1) // shrug
 	}
 	return BoundLiteralExpressionNode{
 		Value:         expr.LiteralValue,
@@ -97,7 +96,7 @@ func CreateBoundLiteralExpressionNodeFromValue(value interface{}, src nodes.Synt
 			print.Red,
 			fmt.Sprintf("ERROR: Uknown type symbol \"%s\" debug: (BoundLiteralExpressionNode line 40)", value.(string)),
 		)
-		os.Exit(1) // shrug
+		print.Crash(1) // shrug
 	}
 	return BoundLiteralExpressionNode{
 		Value:         value,
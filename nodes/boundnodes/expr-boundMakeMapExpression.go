@@ -0,0 +1,42 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+type BoundMakeMapExpressionNode struct {
+	BoundExpressionNode
+
+	MapType symbols.TypeSymbol
+
+	UnboundSource nodes.SyntaxNode
+}
+
+func (BoundMakeMapExpressionNode) NodeType() BoundType { return BoundMakeMapExpression }
+
+func (node BoundMakeMapExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ BoundMakeMapExpressionNode")
+	fmt.Println(indent + "  └ Type: ")
+	node.MapType.Print(indent + "    ")
+}
+
+func (node BoundMakeMapExpressionNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+func (BoundMakeMapExpressionNode) IsPersistent() bool { return false }
+
+// implement the expression node interface
+func (node BoundMakeMapExpressionNode) Type() symbols.TypeSymbol {
+	return node.MapType
+}
+
+func CreateBoundMakeMapExpressionNode(mapType symbols.TypeSymbol, src nodes.SyntaxNode) BoundMakeMapExpressionNode {
+	return BoundMakeMapExpressionNode{
+		MapType:       mapType,
+		UnboundSource: src,
+	}
+}
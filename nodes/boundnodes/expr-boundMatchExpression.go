@@ -0,0 +1,70 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// works like the switch statement, but each case gives back a value (Conditions[i] belongs to Values[i])
+// matches always have a default, even if it's just the last case of an exhaustive enum match
+type BoundMatchExpressionNode struct {
+	BoundExpressionNode
+
+	Variable     symbols.LocalVariableSymbol
+	Expression   BoundExpressionNode
+	Conditions   []BoundExpressionNode
+	Values       []BoundExpressionNode
+	DefaultValue BoundExpressionNode
+	Tmp          symbols.LocalVariableSymbol
+
+	UnboundSource nodes.SyntaxNode
+}
+
+func (BoundMatchExpressionNode) NodeType() BoundType { return BoundMatchExpression }
+
+func (node BoundMatchExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ BoundMatchExpressionNode")
+	fmt.Println(indent + "  └ Expression: ")
+	node.Expression.Print(indent + "    ")
+
+	for i := range node.Conditions {
+		fmt.Println(indent + "  └ Condition: ")
+		node.Conditions[i].Print(indent + "    ")
+		fmt.Println(indent + "  └ Value: ")
+		node.Values[i].Print(indent + "    ")
+	}
+
+	fmt.Println(indent + "  └ DefaultValue: ")
+	node.DefaultValue.Print(indent + "    ")
+}
+
+func (node BoundMatchExpressionNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+func (node BoundMatchExpressionNode) IsPersistent() bool {
+	for _, value := range node.Values {
+		if value.IsPersistent() {
+			return true
+		}
+	}
+
+	return node.DefaultValue.IsPersistent()
+}
+
+// implement the expression node interface
+func (node BoundMatchExpressionNode) Type() symbols.TypeSymbol { return node.DefaultValue.Type() }
+
+func CreateBoundMatchExpressionNode(variable symbols.LocalVariableSymbol, expr BoundExpressionNode, conditions []BoundExpressionNode, values []BoundExpressionNode, defaultValue BoundExpressionNode, tmp symbols.LocalVariableSymbol, src nodes.SyntaxNode) BoundMatchExpressionNode {
+	return BoundMatchExpressionNode{
+		Variable:      variable,
+		Expression:    expr,
+		Conditions:    conditions,
+		Values:        values,
+		DefaultValue:  defaultValue,
+		Tmp:           tmp,
+		UnboundSource: src,
+	}
+}
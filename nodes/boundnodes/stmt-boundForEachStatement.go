@@ -0,0 +1,54 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+type BoundForEachStatementNode struct {
+	BoundLoopStatementNode
+
+	Variable      symbols.VariableSymbol
+	Collection    BoundExpressionNode
+	Body          BoundStatementNode
+	BreakLabel    BoundLabel
+	ContinueLabel BoundLabel
+
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundForEachStatementNode) NodeType() BoundType { return BoundForEachStatement }
+func (node BoundForEachStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundForEachStatementNode")
+	fmt.Println(indent + "  └ Variable: ")
+	node.Variable.Print(indent + "    ")
+	fmt.Println(indent + "  └ Collection: ")
+	node.Collection.Print(indent + "    ")
+	fmt.Println(indent + "  └ Body: ")
+	node.Body.Print(indent + "    ")
+
+	fmt.Printf("%s  └ BreakLabel: %s\n", indent, node.BreakLabel)
+	fmt.Printf("%s  └ ContinueLabel: %s\n", indent, node.ContinueLabel)
+}
+
+func (node BoundForEachStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+func (node BoundForEachStatementNode) LoopBreakLabel() BoundLabel    { return node.BreakLabel }
+func (node BoundForEachStatementNode) LoopContinueLabel() BoundLabel { return node.ContinueLabel }
+
+// constructor
+func CreateBoundForEachStatementNode(variable symbols.VariableSymbol, collection BoundExpressionNode, body BoundStatementNode, breakLabel BoundLabel, continueLabel BoundLabel, src nodes.SyntaxNode) BoundForEachStatementNode {
+	return BoundForEachStatementNode{
+		Variable:      variable,
+		Collection:    collection,
+		Body:          body,
+		BreakLabel:    breakLabel,
+		ContinueLabel: continueLabel,
+		UnboundSource: src,
+	}
+}
@@ -0,0 +1,60 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// the value gets stored in Variable once, every case condition compares against that
+// (Conditions[i] belongs to Bodies[i])
+type BoundSwitchStatementNode struct {
+	BoundStatementNode
+
+	Variable    symbols.LocalVariableSymbol
+	Expression  BoundExpressionNode
+	Conditions  []BoundExpressionNode
+	Bodies      []BoundStatementNode
+	DefaultBody BoundStatementNode // nil if there's no default
+
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundSwitchStatementNode) NodeType() BoundType { return BoundSwitchStatement }
+func (node BoundSwitchStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundSwitchStatementNode")
+	fmt.Println(indent + "  └ Expression: ")
+	node.Expression.Print(indent + "    ")
+
+	for i := range node.Conditions {
+		fmt.Println(indent + "  └ Condition: ")
+		node.Conditions[i].Print(indent + "    ")
+		fmt.Println(indent + "  └ Body: ")
+		node.Bodies[i].Print(indent + "    ")
+	}
+
+	if node.DefaultBody != nil {
+		fmt.Println(indent + "  └ DefaultBody: ")
+		node.DefaultBody.Print(indent + "    ")
+	} else {
+		fmt.Println(indent + "  └ DefaultBody: none")
+	}
+}
+
+func (node BoundSwitchStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+// constructor
+func CreateBoundSwitchStatementNode(variable symbols.LocalVariableSymbol, expr BoundExpressionNode, conditions []BoundExpressionNode, bodies []BoundStatementNode, defaultBody BoundStatementNode, src nodes.SyntaxNode) BoundSwitchStatementNode {
+	return BoundSwitchStatementNode{
+		Variable:      variable,
+		Expression:    expr,
+		Conditions:    conditions,
+		Bodies:        bodies,
+		DefaultBody:   defaultBody,
+		UnboundSource: src,
+	}
+}
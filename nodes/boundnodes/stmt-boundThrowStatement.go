@@ -0,0 +1,34 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+type BoundThrowStatementNode struct {
+	BoundStatementNode
+
+	Expression    BoundExpressionNode // either a string or an exception
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundThrowStatementNode) NodeType() BoundType { return BoundThrowStatement }
+func (node BoundThrowStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundThrowStatementNode")
+	fmt.Println(indent + "  └ Expression:")
+	node.Expression.Print(indent + "    ")
+}
+
+func (node BoundThrowStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+// constructor
+func CreateBoundThrowStatementNode(expr BoundExpressionNode, src nodes.SyntaxNode) BoundThrowStatementNode {
+	return BoundThrowStatementNode{
+		Expression:    expr,
+		UnboundSource: src,
+	}
+}
@@ -0,0 +1,31 @@
+package boundnodes
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// only created by the lowerer
+// unregisters the exception handler of the innermost try statement
+type BoundTryEndStatementNode struct {
+	BoundStatementNode
+
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundTryEndStatementNode) NodeType() BoundType { return BoundTryEndStatement }
+func (node BoundTryEndStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundTryEndStatementNode")
+}
+
+func (node BoundTryEndStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+// constructor
+func CreateBoundTryEndStatementNode(src nodes.SyntaxNode) BoundTryEndStatementNode {
+	return BoundTryEndStatementNode{
+		UnboundSource: src,
+	}
+}
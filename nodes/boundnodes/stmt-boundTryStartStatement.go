@@ -0,0 +1,39 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// only created by the lowerer
+// registers an exception handler, then continues at BodyLabel
+// if an exception gets thrown while the handler is active we end up at CatchLabel instead
+type BoundTryStartStatementNode struct {
+	BoundStatementNode
+
+	CatchLabel    BoundLabel
+	BodyLabel     BoundLabel
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundTryStartStatementNode) NodeType() BoundType { return BoundTryStartStatement }
+func (node BoundTryStartStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundTryStartStatementNode")
+	fmt.Printf("%s  └ CatchLabel: %s\n", indent, node.CatchLabel)
+	fmt.Printf("%s  └ BodyLabel: %s\n", indent, node.BodyLabel)
+}
+
+func (node BoundTryStartStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+// constructor
+func CreateBoundTryStartStatementNode(catchLabel BoundLabel, bodyLabel BoundLabel, src nodes.SyntaxNode) BoundTryStartStatementNode {
+	return BoundTryStartStatementNode{
+		CatchLabel:    catchLabel,
+		BodyLabel:     bodyLabel,
+		UnboundSource: src,
+	}
+}
@@ -0,0 +1,61 @@
+package boundnodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+type BoundTryStatementNode struct {
+	BoundStatementNode
+
+	Body          BoundStatementNode
+	CatchVariable symbols.VariableSymbol // nil if the catch clause doesn't name the exception
+	CatchBody     BoundStatementNode     // nil if there's no catch clause
+	FinallyBody   BoundStatementNode     // nil if there's no finally clause
+
+	UnboundSource nodes.SyntaxNode
+}
+
+// implement the interface
+func (BoundTryStatementNode) NodeType() BoundType { return BoundTryStatement }
+func (node BoundTryStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ BoundTryStatementNode")
+	fmt.Println(indent + "  └ Body: ")
+	node.Body.Print(indent + "    ")
+
+	if node.CatchBody != nil {
+		if node.CatchVariable != nil {
+			fmt.Println(indent + "  └ CatchVariable: ")
+			node.CatchVariable.Print(indent + "    ")
+		}
+
+		fmt.Println(indent + "  └ CatchBody: ")
+		node.CatchBody.Print(indent + "    ")
+	} else {
+		fmt.Println(indent + "  └ CatchBody: none")
+	}
+
+	if node.FinallyBody != nil {
+		fmt.Println(indent + "  └ FinallyBody: ")
+		node.FinallyBody.Print(indent + "    ")
+	} else {
+		fmt.Println(indent + "  └ FinallyBody: none")
+	}
+}
+
+func (node BoundTryStatementNode) Source() nodes.SyntaxNode {
+	return node.UnboundSource
+}
+
+// constructor
+func CreateBoundTryStatementNode(body BoundStatementNode, catchVariable symbols.VariableSymbol, catchBody BoundStatementNode, finallyBody BoundStatementNode, src nodes.SyntaxNode) BoundTryStatementNode {
+	return BoundTryStatementNode{
+		Body:          body,
+		CatchVariable: catchVariable,
+		CatchBody:     catchBody,
+		FinallyBody:   finallyBody,
+		UnboundSource: src,
+	}
+}
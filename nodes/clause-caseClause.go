@@ -0,0 +1,66 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// CaseClauseNode a single "case a, b: ..." or "default: ..." inside of a switch or match
+// switches give it a statement, matches give it a value instead
+type CaseClauseNode struct {
+	SyntaxNode
+
+	Keyword   lexer.Token      // either "case" or "default"
+	Values    []ExpressionNode // empty for default clauses
+	Statement StatementNode    // only set in switch statements
+	Value     ExpressionNode   // only set in match expressions
+}
+
+// implement node type from interface
+func (CaseClauseNode) NodeType() NodeType { return CaseClause }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node CaseClauseNode) Span() print.TextSpan {
+	if node.Statement != nil {
+		return node.Keyword.Span.SpanBetween(node.Statement.Span())
+	}
+
+	return node.Keyword.Span.SpanBetween(node.Value.Span())
+}
+
+// IsDefault tells us if this is the "default" clause
+func (node CaseClauseNode) IsDefault() bool {
+	return node.Keyword.Kind == lexer.DefaultKeyword
+}
+
+// node print function
+func (node CaseClauseNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ CaseClauseNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.Keyword.Kind)
+
+	fmt.Println(indent + "  └ Values: ")
+	for _, value := range node.Values {
+		value.Print(indent + "    ")
+	}
+
+	if node.Statement != nil {
+		fmt.Println(indent + "  └ Statement: ")
+		node.Statement.Print(indent + "    ")
+	} else {
+		fmt.Println(indent + "  └ Value: ")
+		node.Value.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateCaseClauseNode(kw lexer.Token, values []ExpressionNode, stmt StatementNode, value ExpressionNode) CaseClauseNode {
+	return CaseClauseNode{
+		Keyword:   kw,
+		Values:    values,
+		Statement: stmt,
+		Value:     value,
+	}
+}
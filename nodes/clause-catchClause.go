@@ -0,0 +1,56 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// CatchClauseNode the "catch (e) { ... }" part of a try statement
+type CatchClauseNode struct {
+	SyntaxNode
+
+	ClauseIsSet    bool
+	CatchKeyword   lexer.Token
+	Identifier     lexer.Token // Kind is empty if no variable was given
+	CatchStatement StatementNode
+}
+
+// implement node type from interface
+func (CatchClauseNode) NodeType() NodeType { return CatchClause }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node CatchClauseNode) Span() print.TextSpan {
+	if node.ClauseIsSet {
+		return node.CatchKeyword.Span.SpanBetween(node.CatchStatement.Span())
+	} else {
+		return print.TextSpan{} // empty
+	}
+}
+
+// node print function
+func (node CatchClauseNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ CatchClauseNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.CatchKeyword.Kind)
+
+	if node.Identifier.Kind == "" {
+		fmt.Printf("%s  └ Identifier: none\n", indent)
+	} else {
+		fmt.Printf("%s  └ Identifier: %s\n", indent, node.Identifier.Value)
+	}
+
+	fmt.Println(indent + "  └ Statement: ")
+	node.CatchStatement.Print(indent + "    ")
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateCatchClauseNode(kw lexer.Token, id lexer.Token, stmt StatementNode) CatchClauseNode {
+	return CatchClauseNode{
+		CatchKeyword:   kw,
+		Identifier:     id,
+		CatchStatement: stmt,
+		ClauseIsSet:    true,
+	}
+}
@@ -0,0 +1,47 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// FinallyClauseNode the "finally { ... }" part of a try statement
+type FinallyClauseNode struct {
+	SyntaxNode
+
+	ClauseIsSet      bool
+	FinallyKeyword   lexer.Token
+	FinallyStatement StatementNode
+}
+
+// implement node type from interface
+func (FinallyClauseNode) NodeType() NodeType { return FinallyClause }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node FinallyClauseNode) Span() print.TextSpan {
+	if node.ClauseIsSet {
+		return node.FinallyKeyword.Span.SpanBetween(node.FinallyStatement.Span())
+	} else {
+		return print.TextSpan{} // empty
+	}
+}
+
+// node print function
+func (node FinallyClauseNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ FinallyClauseNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.FinallyKeyword.Kind)
+	fmt.Println(indent + "  └ Statement: ")
+	node.FinallyStatement.Print(indent + "    ")
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateFinallyClauseNode(kw lexer.Token, stmt StatementNode) FinallyClauseNode {
+	return FinallyClauseNode{
+		FinallyKeyword:   kw,
+		FinallyStatement: stmt,
+		ClauseIsSet:      true,
+	}
+}
@@ -0,0 +1,105 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"strings"
+)
+
+// the concrete syntax tree is the lossless version of the syntax tree
+// it contains every single token (semicolons, commas, parentheses, you name it) and every token knows its trivia,
+// so putting it back together gives you the exact source code it was parsed from
+
+// ConcreteNode is a node of the concrete syntax tree
+type ConcreteNode struct {
+	Kind     NodeType
+	Node     SyntaxNode // the syntax node this was parsed as (nil for the compilation unit)
+	Children []ConcreteChild
+}
+
+// ConcreteChild is either a node or a token, never both
+type ConcreteChild struct {
+	Node  *ConcreteNode
+	Token *lexer.Token
+}
+
+// Text gives back the exact source code this node was parsed from, including all whitespace and comments
+func (node *ConcreteNode) Text() string {
+	var text strings.Builder
+	for _, token := range node.Tokens() {
+		text.WriteString(token.FullText())
+	}
+
+	return text.String()
+}
+
+// Tokens gives back all tokens in this node, in order
+func (node *ConcreteNode) Tokens() []lexer.Token {
+	tokens := make([]lexer.Token, 0)
+
+	for _, child := range node.Children {
+		if child.Token != nil {
+			tokens = append(tokens, *child.Token)
+		} else {
+			tokens = append(tokens, child.Node.Tokens()...)
+		}
+	}
+
+	return tokens
+}
+
+// FirstToken gives back the first token in this node (nil if there isn't one)
+func (node *ConcreteNode) FirstToken() *lexer.Token {
+	for _, child := range node.Children {
+		if child.Token != nil {
+			return child.Token
+		}
+
+		if first := child.Node.FirstToken(); first != nil {
+			return first
+		}
+	}
+
+	return nil
+}
+
+// Span gives back the text span from the first to the last token (without any trivia)
+func (node *ConcreteNode) Span() print.TextSpan {
+	tokens := node.Tokens()
+	if len(tokens) == 0 {
+		return print.TextSpan{}
+	}
+
+	return tokens[0].Span.SpanBetween(tokens[len(tokens)-1].Span)
+}
+
+// DocComment gives back the comment lines written directly above this node (without the // or #)
+func (node *ConcreteNode) DocComment() string {
+	first := node.FirstToken()
+	if first == nil {
+		return ""
+	}
+
+	lines := make([]string, 0)
+	for _, comment := range first.LeadingComments() {
+		text := strings.TrimPrefix(strings.TrimPrefix(comment.Text, "//"), "#")
+		lines = append(lines, strings.TrimSpace(text))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Print Prints beautiful stuff in console
+func (node *ConcreteNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ "+string(node.Kind))
+
+	for _, child := range node.Children {
+		if child.Node != nil {
+			child.Node.Print(indent + "  ")
+			continue
+		}
+
+		fmt.Printf("%s  └ %s %q\n", indent, child.Token.Kind, child.Token.FullText())
+	}
+}
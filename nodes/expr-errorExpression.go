@@ -0,0 +1,35 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// ErrorExpressionNode is what the parser puts in place of an expression it couldn't make sense of
+// that way it can keep going and the binder still gets a (mostly) complete tree to work with
+type ErrorExpressionNode struct {
+	ExpressionNode
+
+	Token lexer.Token // the token where the expression should have been
+}
+
+// implement node type from interface
+func (ErrorExpressionNode) NodeType() NodeType { return ErrorExpression }
+
+func (node ErrorExpressionNode) Span() print.TextSpan {
+	return node.Token.Span
+}
+
+// node print function
+func (node ErrorExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ ErrorExpressionNode")
+	fmt.Printf("%s  └ Token: %s\n", indent, node.Token.Kind)
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateErrorExpressionNode(token lexer.Token) ErrorExpressionNode {
+	return ErrorExpressionNode{
+		Token: token,
+	}
+}
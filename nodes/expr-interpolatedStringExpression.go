@@ -0,0 +1,45 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// InterpolatedStringExpressionNode $"value: {x}, name: {obj->Name}"
+// the text parts are literal expressions, the holes are just whatever expression was put there
+type InterpolatedStringExpressionNode struct {
+	ExpressionNode
+
+	StartToken lexer.Token
+	Parts      []ExpressionNode
+	EndToken   lexer.Token
+}
+
+// implement node type from interface
+func (InterpolatedStringExpressionNode) NodeType() NodeType { return InterpolatedStringExpression }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node InterpolatedStringExpressionNode) Span() print.TextSpan {
+	return node.StartToken.Span.SpanBetween(node.EndToken.Span)
+}
+
+// node print function
+func (node InterpolatedStringExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ InterpolatedStringExpressionNode")
+	fmt.Println(indent + "  └ Parts: ")
+	for _, part := range node.Parts {
+		part.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateInterpolatedStringExpressionNode(start lexer.Token, parts []ExpressionNode, end lexer.Token) InterpolatedStringExpressionNode {
+	return InterpolatedStringExpressionNode{
+		StartToken: start,
+		Parts:      parts,
+		EndToken:   end,
+	}
+}
@@ -12,9 +12,10 @@ type MakeExpressionNode struct {
 	MakeKeyword  lexer.Token
 	ClosingToken lexer.Token
 
-	Package   *lexer.Token
-	BaseType  lexer.Token
-	Arguments []ExpressionNode
+	Package       *lexer.Token
+	BaseType      lexer.Token
+	TypeArguments []TypeClauseNode // only set if the class is generic
+	Arguments     []ExpressionNode
 }
 
 // implement node type from interface
@@ -31,6 +32,10 @@ func (node MakeExpressionNode) Span() print.TextSpan {
 func (node MakeExpressionNode) Print(indent string) {
 	print.PrintC(print.Yellow, indent+"└ MakeExpressionNode")
 	fmt.Println(indent + "  └ Type: " + node.BaseType.Value)
+	for _, arg := range node.TypeArguments {
+		arg.Print(indent + "    ")
+	}
+
 	fmt.Println(indent + "  └ Arguments: ")
 	for _, v := range node.Arguments {
 		v.Print(indent + "    ")
@@ -38,12 +43,13 @@ func (node MakeExpressionNode) Print(indent string) {
 }
 
 // "constructor" / ooga booga OOP cave man brain
-func CreateMakeExpressionNode(pack *lexer.Token, typ lexer.Token, args []ExpressionNode, makeKw lexer.Token, closing lexer.Token) MakeExpressionNode {
+func CreateMakeExpressionNode(pack *lexer.Token, typ lexer.Token, typeArgs []TypeClauseNode, args []ExpressionNode, makeKw lexer.Token, closing lexer.Token) MakeExpressionNode {
 	return MakeExpressionNode{
-		Package:      pack,
-		BaseType:     typ,
-		Arguments:    args,
-		MakeKeyword:  makeKw,
-		ClosingToken: closing,
+		Package:       pack,
+		BaseType:      typ,
+		TypeArguments: typeArgs,
+		Arguments:     args,
+		MakeKeyword:   makeKw,
+		ClosingToken:  closing,
 	}
 }
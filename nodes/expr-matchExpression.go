@@ -0,0 +1,49 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// MatchExpressionNode like a switch, but every case hands back a value
+// match (value) { case a: "a"; default: "something else"; }
+type MatchExpressionNode struct {
+	ExpressionNode
+
+	Keyword      lexer.Token
+	Expression   ExpressionNode
+	Cases        []CaseClauseNode
+	ClosingBrace lexer.Token
+}
+
+// implement node type from interface
+func (MatchExpressionNode) NodeType() NodeType { return MatchExpression }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node MatchExpressionNode) Span() print.TextSpan {
+	return node.Keyword.Span.SpanBetween(node.ClosingBrace.Span)
+}
+
+// node print function
+func (node MatchExpressionNode) Print(indent string) {
+	print.PrintC(print.Yellow, indent+"└ MatchExpressionNode")
+	fmt.Println(indent + "  └ Expression: ")
+	node.Expression.Print(indent + "    ")
+	fmt.Println(indent + "  └ Cases: ")
+	for _, clause := range node.Cases {
+		clause.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateMatchExpressionNode(kw lexer.Token, expr ExpressionNode, cases []CaseClauseNode, closing lexer.Token) MatchExpressionNode {
+	return MatchExpressionNode{
+		Keyword:      kw,
+		Expression:   expr,
+		Cases:        cases,
+		ClosingBrace: closing,
+	}
+}
@@ -9,10 +9,13 @@ import (
 type ClassDeclarationMember struct {
 	MemberNode
 
-	ClassKeyword lexer.Token
-	Identifier   lexer.Token
-	Members      []MemberNode
-	ClosingToken lexer.Token
+	ClassKeyword   lexer.Token
+	Identifier     lexer.Token
+	TypeParameters []lexer.Token    // only set if this class is generic
+	BaseTypes      []lexer.Token    // base class and interfaces, in any order
+	GenericBases   []TypeClauseNode // bases with type arguments (class X : Box[int]), which can't be inherited from
+	Members        []MemberNode
+	ClosingToken   lexer.Token
 }
 
 // implement node type from interface
@@ -27,6 +30,18 @@ func (node ClassDeclarationMember) Print(indent string) {
 	print.PrintC(print.Cyan, indent+"- ClassDeclarationMember")
 	fmt.Printf("%s  └ Identifier: %s\n", indent, node.Identifier.Kind)
 
+	for _, param := range node.TypeParameters {
+		fmt.Printf("%s  └ TypeParameter: %s\n", indent, param.Value)
+	}
+
+	for _, base := range node.BaseTypes {
+		fmt.Printf("%s  └ BaseType: %s\n", indent, base.Value)
+	}
+
+	for _, base := range node.GenericBases {
+		fmt.Printf("%s  └ GenericBase: %s\n", indent, base.TypeIdentifier.Value)
+	}
+
 	fmt.Println(indent + "  └ Members: ")
 	for _, mem := range node.Members {
 		mem.Print(indent + "    ")
@@ -34,11 +49,14 @@ func (node ClassDeclarationMember) Print(indent string) {
 }
 
 // "constructor" / ooga booga OOP cave man brain
-func CreateClassDeclarationMember(kw lexer.Token, id lexer.Token, members []MemberNode, closing lexer.Token) ClassDeclarationMember {
+func CreateClassDeclarationMember(kw lexer.Token, id lexer.Token, typeParams []lexer.Token, bases []lexer.Token, genericBases []TypeClauseNode, members []MemberNode, closing lexer.Token) ClassDeclarationMember {
 	return ClassDeclarationMember{
-		ClassKeyword: kw,
-		Identifier:   id,
-		Members:      members,
-		ClosingToken: closing,
+		ClassKeyword:   kw,
+		Identifier:     id,
+		TypeParameters: typeParams,
+		BaseTypes:      bases,
+		GenericBases:   genericBases,
+		Members:        members,
+		ClosingToken:   closing,
 	}
 }
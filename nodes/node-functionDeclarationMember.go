@@ -12,6 +12,7 @@ type FunctionDeclarationMember struct {
 
 	FunctionKeyword lexer.Token
 	Identifier      lexer.Token
+	TypeParameters  []lexer.Token // only set if this function is generic
 	Parameters      []ParameterNode
 	TypeClause      TypeClauseNode
 	Body            BlockStatementNode
@@ -43,6 +44,10 @@ func (node FunctionDeclarationMember) Print(indent string) {
 	fmt.Printf("%s  └ Identifier: %s\n", indent, node.Identifier.Kind)
 	fmt.Printf("%s  └ IsPublic: %t\n", indent, node.IsPublic)
 
+	for _, param := range node.TypeParameters {
+		fmt.Printf("%s  └ TypeParameter: %s\n", indent, param.Value)
+	}
+
 	fmt.Println(indent + "  └ Parameters: ")
 	for _, param := range node.Parameters {
 		param.Print(indent + "    ")
@@ -60,10 +65,11 @@ func (node FunctionDeclarationMember) Print(indent string) {
 }
 
 // "constructor" / ooga booga OOP cave man brain
-func CreateFunctionDeclarationMember(kw lexer.Token, id lexer.Token, params []ParameterNode, typeClause TypeClauseNode, body BlockStatementNode, public bool) FunctionDeclarationMember {
+func CreateFunctionDeclarationMember(kw lexer.Token, id lexer.Token, typeParams []lexer.Token, params []ParameterNode, typeClause TypeClauseNode, body BlockStatementNode, public bool) FunctionDeclarationMember {
 	return FunctionDeclarationMember{
 		FunctionKeyword: kw,
 		Identifier:      id,
+		TypeParameters:  typeParams,
 		Parameters:      params,
 		TypeClause:      typeClause,
 		Body:            body,
@@ -0,0 +1,44 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+type InterfaceDeclarationMember struct {
+	MemberNode
+
+	InterfaceKeyword lexer.Token
+	Identifier       lexer.Token
+	Functions        []FunctionDeclarationMember // these dont have a body
+	ClosingToken     lexer.Token
+}
+
+// implement node type from interface
+func (InterfaceDeclarationMember) NodeType() NodeType { return InterfaceDeclaration }
+
+func (node InterfaceDeclarationMember) Span() print.TextSpan {
+	return node.InterfaceKeyword.Span.SpanBetween(node.ClosingToken.Span)
+}
+
+// node print function
+func (node InterfaceDeclarationMember) Print(indent string) {
+	print.PrintC(print.Cyan, indent+"- InterfaceDeclarationMember")
+	fmt.Printf("%s  └ Identifier: %s\n", indent, node.Identifier.Value)
+
+	fmt.Println(indent + "  └ Functions: ")
+	for _, fnc := range node.Functions {
+		fnc.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateInterfaceDeclarationMember(kw lexer.Token, id lexer.Token, functions []FunctionDeclarationMember, closing lexer.Token) InterfaceDeclarationMember {
+	return InterfaceDeclarationMember{
+		InterfaceKeyword: kw,
+		Identifier:       id,
+		Functions:        functions,
+		ClosingToken:     closing,
+	}
+}
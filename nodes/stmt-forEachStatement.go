@@ -0,0 +1,48 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// ForEachStatementNode goes over every element of an array, string or map
+type ForEachStatementNode struct {
+	StatementNode
+
+	Keyword    lexer.Token
+	Identifier lexer.Token
+	Collection ExpressionNode
+	Statement  StatementNode
+}
+
+// NodeType Copy + Paste again
+func (ForEachStatementNode) NodeType() NodeType { return ForEachStatement }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node ForEachStatementNode) Span() print.TextSpan {
+	return node.Keyword.Span.SpanBetween(node.Statement.Span())
+}
+
+// Print Prints beautiful stuff in console
+func (node ForEachStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ ForEachStatementNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.Keyword.Kind)
+	fmt.Printf("%s  └ Identifier: %s\n", indent, node.Identifier.Value)
+	fmt.Println(indent + "  └ Collection: ")
+	node.Collection.Print(indent + "    ")
+	fmt.Println(indent + "  └ Statement: ")
+	node.Statement.Print(indent + "    ")
+}
+
+// "constructor" / ooga booga OOP cave man brain - Same -_-
+func CreateForEachStatementNode(keyword lexer.Token, id lexer.Token, collection ExpressionNode, statement StatementNode) ForEachStatementNode {
+	return ForEachStatementNode{
+		Keyword:    keyword,
+		Identifier: id,
+		Collection: collection,
+		Statement:  statement,
+	}
+}
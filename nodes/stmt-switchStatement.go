@@ -0,0 +1,49 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// SwitchStatementNode switch (value) { case a: ... default: ... }
+type SwitchStatementNode struct {
+	StatementNode
+
+	Keyword      lexer.Token
+	Expression   ExpressionNode
+	Cases        []CaseClauseNode
+	ClosingBrace lexer.Token
+}
+
+// NodeType Copy + Paste (you know the drill)
+func (SwitchStatementNode) NodeType() NodeType { return SwitchStatement }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node SwitchStatementNode) Span() print.TextSpan {
+	return node.Keyword.Span.SpanBetween(node.ClosingBrace.Span)
+}
+
+// Print Prints beautiful stuff in console
+func (node SwitchStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ SwitchStatementNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.Keyword.Kind)
+	fmt.Println(indent + "  └ Expression: ")
+	node.Expression.Print(indent + "    ")
+	fmt.Println(indent + "  └ Cases: ")
+	for _, clause := range node.Cases {
+		clause.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateSwitchStatementNode(kw lexer.Token, expr ExpressionNode, cases []CaseClauseNode, closing lexer.Token) SwitchStatementNode {
+	return SwitchStatementNode{
+		Keyword:      kw,
+		Expression:   expr,
+		Cases:        cases,
+		ClosingBrace: closing,
+	}
+}
@@ -0,0 +1,41 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// ThrowStatementNode like: throw "something went very wrong";
+type ThrowStatementNode struct {
+	StatementNode
+
+	Keyword    lexer.Token
+	Expression ExpressionNode
+}
+
+// NodeType Copy + Paste
+func (ThrowStatementNode) NodeType() NodeType { return ThrowStatement }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node ThrowStatementNode) Span() print.TextSpan {
+	return node.Keyword.Span.SpanBetween(node.Expression.Span())
+}
+
+// Print Prints beautiful stuff in console
+func (node ThrowStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ ThrowStatementNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.Keyword.Kind)
+	fmt.Println(indent + "  └ Expression: ")
+	node.Expression.Print(indent + "    ")
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateThrowStatementNode(keyword lexer.Token, expression ExpressionNode) ThrowStatementNode {
+	return ThrowStatementNode{
+		Keyword:    keyword,
+		Expression: expression,
+	}
+}
@@ -0,0 +1,59 @@
+package nodes
+
+import (
+	"fmt"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// TryStatementNode try { ... } catch (e) { ... } finally { ... }
+type TryStatementNode struct {
+	StatementNode
+
+	Keyword       lexer.Token
+	TryStatement  StatementNode
+	CatchClause   CatchClauseNode
+	FinallyClause FinallyClauseNode
+}
+
+// NodeType Copy + Paste (yet again)
+func (TryStatementNode) NodeType() NodeType { return TryStatement }
+
+// Position returns the starting line and column, and the total length of the statement
+// The starting line and column aren't always the absolute beginning of the statement just what's most
+// convenient.
+func (node TryStatementNode) Span() print.TextSpan {
+	return node.Keyword.Span.SpanBetween(node.TryStatement.Span()).SpanBetween(node.CatchClause.Span()).SpanBetween(node.FinallyClause.Span())
+}
+
+// Print Prints beautiful stuff in console
+func (node TryStatementNode) Print(indent string) {
+	print.PrintC(print.Green, indent+"└ TryStatementNode")
+	fmt.Printf("%s  └ Keyword: %s\n", indent, node.Keyword.Kind)
+	fmt.Println(indent + "  └ Statement: ")
+	node.TryStatement.Print(indent + "    ")
+
+	if !node.CatchClause.ClauseIsSet {
+		fmt.Printf("%s  └ CatchClause: none\n", indent)
+	} else {
+		fmt.Println(indent + "  └ CatchClause: ")
+		node.CatchClause.Print(indent + "    ")
+	}
+
+	if !node.FinallyClause.ClauseIsSet {
+		fmt.Printf("%s  └ FinallyClause: none\n", indent)
+	} else {
+		fmt.Println(indent + "  └ FinallyClause: ")
+		node.FinallyClause.Print(indent + "    ")
+	}
+}
+
+// "constructor" / ooga booga OOP cave man brain
+func CreateTryStatementNode(kw lexer.Token, stmt StatementNode, catchClause CatchClauseNode, finallyClause FinallyClauseNode) TryStatementNode {
+	return TryStatementNode{
+		Keyword:       kw,
+		TryStatement:  stmt,
+		CatchClause:   catchClause,
+		FinallyClause: finallyClause,
+	}
+}
@@ -43,14 +43,16 @@ const (
 	ClassDeclaration            NodeType = "Class Declaration"
 	StructDeclaration           NodeType = "Struct Declaration"
 	EnumDeclaration             NodeType = "Enum Declaration"
+	InterfaceDeclaration        NodeType = "Interface Declaration"
 	PackageReference            NodeType = "Package Reference"
 	PackageAlias                NodeType = "Package Alias"
 	PackageUse                  NodeType = "Package Use"
 
 	// General
 	// -------
-	Parameter  NodeType = "Parameter"
-	TypeClause NodeType = "Type Clause"
+	Parameter       NodeType = "Parameter"
+	TypeClause      NodeType = "Type Clause"
+	CompilationUnit NodeType = "Compilation Unit" // the root of a concrete syntax tree
 
 	// Statements
 	// ----------
@@ -64,7 +66,14 @@ const (
 	BreakStatement      NodeType = "Break Statement"
 	ContinueStatement   NodeType = "Continue Statement"
 	FromToStatement     NodeType = "FromTo Statement"
+	ForEachStatement    NodeType = "ForEach Statement"
 	ExpressionStatement NodeType = "Expression Statement"
+	TryStatement        NodeType = "Try Statement"
+	CatchClause         NodeType = "Catch Clause"
+	FinallyClause       NodeType = "Finally Clause"
+	ThrowStatement      NodeType = "Throw Statement"
+	SwitchStatement     NodeType = "Switch Statement"
+	CaseClause          NodeType = "Case Clause"
 
 	// Expressions
 	// -----------
@@ -90,4 +99,7 @@ const (
 	MakeStructExpression           NodeType = "MakeStruct Expression"
 	LambdaExpression               NodeType = "Lambda Expression"
 	ThisExpression                 NodeType = "This Expression"
+	MatchExpression                NodeType = "Match Expression"
+	InterpolatedStringExpression   NodeType = "InterpolatedString Expression"
+	ErrorExpression                NodeType = "Error Expression"
 )
@@ -19,7 +19,21 @@ var PackagePaths []string
 // all packages weve already loaded
 var PackagesSoFar = make([]symbols.PackageSymbol, 0)
 
+// packages that only exist in memory (like functions a host program hands to an embedded interpreter)
+// these dont have a module, so they only work in the interpreter
+var VirtualPackages = make(map[string]symbols.PackageSymbol)
+
 func ResolvePackage(name string, errorLocation print.TextSpan) symbols.PackageSymbol {
+	// in-memory packages win over anything on disk
+	if virtual, ok := VirtualPackages[name]; ok {
+		virtual.Exists = true
+		virtual.Name = name
+		virtual.ErrorLocation = errorLocation
+
+		PackagesSoFar = append(PackagesSoFar, virtual)
+		return virtual
+	}
+
 	// the path where the package *should* be
 	packagePath := "/" + name + ".ll"
 	exists := false
@@ -174,6 +188,8 @@ func CreateClassSymbolsFromModule(module *ir.Module, pack symbols.PackageSymbol)
 				nodes.ClassDeclarationMember{},
 				make([]symbols.FunctionSymbol, 0),
 				make([]symbols.VariableSymbol, 0),
+				nil,
+				make([]symbols.InterfaceSymbol, 0),
 				pack,
 			)
 
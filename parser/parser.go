@@ -3,15 +3,18 @@ package parser
 import (
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
-	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
 	"github.com/ReCT-Lang/ReCT-Go-Compiler/rules"
-	"os"
 )
 
 // Parser : internal struct for assembling the syntax tree
 type Parser struct {
 	Tokens []lexer.Token
 	Index  int
+	Ranges []NodeRange // which tokens every node was parsed from, see tree.go
+
+	// error recovery, see recovery.go
+	Errors    int // how many syntax errors we found so far
+	LastError int // the index (+1) of the token we last complained about, so we dont complain about it twice
 }
 
 // <HELPERS> ------------------------------------------------------------------
@@ -35,30 +38,21 @@ func (prs *Parser) peek(offset int) lexer.Token {
 	return prs.Tokens[prs.Index+offset]
 }
 
-// consume a syntax token is It's what we're looking for if not -> just die for now
+// consume a syntax token if it's what we're looking for
+// if not -> complain and make up the token we wanted, so we can keep parsing
 func (prs *Parser) consume(expected lexer.TokenKind) lexer.Token {
 	if prs.current().Kind != expected {
 		// can't tell the user which one because there's no way to get a constants name by its value...
 		// so we might need a separate name array for them
 
 		// Switched the TokenKind constants to strings and now added the error message you wanted <3
+		prs.unexpected("unexpected Token \"%s\"! Expected \"%s\"!", prs.current().Kind, expected)
 
-		// Added this additionalInfo section to help users know why a parser error occurred
-		additionalInfo := ""
-		if prs.current().Kind == lexer.BadToken {
-			additionalInfo = " (may be caused by previous \"UnexpectedCharacterError\" which produces a BadToken)"
-		}
-		print.Error(
-			"PARSER",
-			print.UnexpectedTokenError,
-			prs.current().Span,
-			"unexpected Token \"%s\"! Expected \"%s\"!"+additionalInfo,
-			prs.current().Kind,
-			expected,
-		)
+		// the token we're looking at might still be useful to whoever comes next, so dont step over it
+		return prs.missing(expected)
 	}
 
-	// if everything is (sorta) alright -> step our index and return the token
+	// if everything is alright -> step our index and return the token
 	prs.Index++
 	return prs.peek(-1)
 }
@@ -67,6 +61,18 @@ func (prs *Parser) rewind(to lexer.Token) {
 	for prs.current().String(false) != to.String(false) {
 		prs.Index--
 	}
+
+	// anything we parsed after this point doesn't exist anymore
+	for len(prs.Ranges) > 0 && prs.Ranges[len(prs.Ranges)-1].Start >= prs.Index {
+		prs.Ranges = prs.Ranges[:len(prs.Ranges)-1]
+	}
+}
+
+// track remembers which tokens a node was parsed from (everything from the given index up to the current one)
+func (prs *Parser) track(start int, node nodes.SyntaxNode) {
+	if prs.Index > start {
+		prs.Ranges = append(prs.Ranges, NodeRange{Start: start, End: prs.Index, Node: node})
+	}
 }
 
 // </HELPERS> -----------------------------------------------------------------
@@ -88,11 +94,15 @@ func (prs *Parser) parseMembers() []nodes.MemberNode {
 	for prs.current().Kind != lexer.EOF {
 
 		startToken := prs.current()
+		errors := prs.Errors
 
 		// parse all our members
 		member := prs.parseMember(true, true)
 		members = append(members, member)
 
+		// if this member ended in a mess, skip to somewhere we can continue from
+		prs.recoverMember(errors)
+
 		// if we got stuck somewhere, just keep moving
 		if startToken == prs.current() {
 			prs.Index++
@@ -121,6 +131,10 @@ func (prs *Parser) parseMember(allowClasses bool, allowPackages bool) nodes.Memb
 		return prs.parseClassDeclaration()
 	}
 
+	if prs.current().Kind == lexer.InterfaceKeyword && allowClasses {
+		return prs.parseInterfaceDeclaration()
+	}
+
 	if prs.current().Kind == lexer.StructKeyword && allowClasses {
 		return prs.parseStructDeclaration()
 	}
@@ -147,13 +161,18 @@ func (prs *Parser) parseMember(allowClasses bool, allowPackages bool) nodes.Memb
 // this becomes a member node and eventually is appended onto our member node list
 // then we parse the next member in parseMembers.
 func (prs *Parser) parseGlobalStatement() nodes.GlobalStatementMember {
+	begin := prs.Index
+
 	statement := prs.parseStatement()
-	return nodes.CreateGlobalStatementMember(statement)
+	node := nodes.CreateGlobalStatementMember(statement)
+	prs.track(begin, node)
+	return node
 }
 
 // parseFunctionDeclaration checks for a valid order of Tokens, parses all the statements inside the function
 // and then returns it as a function declaration member.
 func (prs *Parser) parseFunctionDeclaration() nodes.FunctionDeclarationMember {
+	begin := prs.Index
 
 	isPublic := false
 	if prs.current().Kind == lexer.SetKeyword {
@@ -168,6 +187,9 @@ func (prs *Parser) parseFunctionDeclaration() nodes.FunctionDeclarationMember {
 	// consume the "functionName" (which is an identifier token).
 	identifier := prs.consume(lexer.IdToken)
 
+	// generic functions have their type parameters right after the name (function Max[T](a T, b T) T)
+	typeParams := prs.parseOptionalTypeParameters()
+
 	// this is where we parse the parameters (e.g., functionArg1)
 	prs.consume(lexer.OpenParenthesisToken)
 	params := prs.parseParameterList() // We only need the arguments not the parenthesis tokens UwU
@@ -182,12 +204,15 @@ func (prs *Parser) parseFunctionDeclaration() nodes.FunctionDeclarationMember {
 	// the block statement will handle multiple statements inside itself
 	body := prs.parseBlockStatement()
 
-	return nodes.CreateFunctionDeclarationMember(kw, identifier, params, typeClause, body, isPublic)
+	node := nodes.CreateFunctionDeclarationMember(kw, identifier, typeParams, params, typeClause, body, isPublic)
+	prs.track(begin, node)
+	return node
 }
 
 // parseExternalFunctionDeclaration checks for a valid order of Tokens, parses all the statements inside the function
 // and then returns it as an external function declaration member.
 func (prs *Parser) parseExternalFunctionDeclaration() nodes.ExternalFunctionDeclarationMember {
+	begin := prs.Index
 
 	// Example:
 	// external <c_variadic> functionName(functionArg1 string) string;
@@ -221,13 +246,33 @@ func (prs *Parser) parseExternalFunctionDeclaration() nodes.ExternalFunctionDecl
 		prs.consume(lexer.Semicolon)
 	}
 
-	return nodes.CreateExternalFunctionDeclarationMember(kw, identifier, params, typeClause, closing, isVariadic, isAdapted)
+	node := nodes.CreateExternalFunctionDeclarationMember(kw, identifier, params, typeClause, closing, isVariadic, isAdapted)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parseClassDeclaration() nodes.ClassDeclarationMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.ClassKeyword)
 	id := prs.consume(lexer.IdToken)
 
+	// optional type parameters (class Stack[T])
+	typeParams := prs.parseOptionalTypeParameters()
+
+	// optional base class and interfaces (class Dog : Animal, Drawable)
+	bases := make([]lexer.Token, 0)
+	genericBases := make([]nodes.TypeClauseNode, 0)
+	if prs.current().Kind == lexer.ColonToken {
+		prs.consume(lexer.ColonToken)
+		bases, genericBases = prs.parseBaseType(bases, genericBases)
+
+		for prs.current().Kind == lexer.CommaToken {
+			prs.consume(lexer.CommaToken)
+			bases, genericBases = prs.parseBaseType(bases, genericBases)
+		}
+	}
+
 	// beginn class body
 	prs.consume(lexer.OpenBraceToken)
 
@@ -238,11 +283,77 @@ func (prs *Parser) parseClassDeclaration() nodes.ClassDeclarationMember {
 	for prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
 
 		startToken := prs.current()
+		errors := prs.Errors
 
 		// parse all our members
 		member := prs.parseMember(false, false)
 		members = append(members, member)
 
+		// if this member ended in a mess, skip to somewhere we can continue from
+		prs.recoverMember(errors)
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
+	}
+
+	closing := prs.consume(lexer.CloseBraceToken)
+
+	node := nodes.CreateClassDeclarationMember(kw, id, typeParams, bases, genericBases, members, closing)
+	prs.track(begin, node)
+	return node
+}
+
+// parses a single base class or interface
+// ones with type arguments (Box[int]) are kept apart, the binder lets the user know those can't be inherited from
+func (prs *Parser) parseBaseType(bases []lexer.Token, genericBases []nodes.TypeClauseNode) ([]lexer.Token, []nodes.TypeClauseNode) {
+	if prs.peek(1).Kind == lexer.OpenBracketToken {
+		return bases, append(genericBases, prs.parseTypeClause())
+	}
+
+	return append(bases, prs.consume(lexer.IdToken)), genericBases
+}
+
+func (prs *Parser) parseInterfaceDeclaration() nodes.InterfaceDeclarationMember {
+	begin := prs.Index
+
+	kw := prs.consume(lexer.InterfaceKeyword)
+	id := prs.consume(lexer.IdToken)
+
+	// beginn interface body
+	prs.consume(lexer.OpenBraceToken)
+
+	// list of the interface's function signatures
+	functions := make([]nodes.FunctionDeclarationMember, 0)
+
+	// loop while the current tokent isnt } or eof
+	for prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
+
+		startToken := prs.current()
+		begin := prs.Index
+
+		// Example:
+		// function Draw(scale int) string;
+		fkw := prs.consume(lexer.FunctionKeyword)
+		identifier := prs.consume(lexer.IdToken)
+
+		prs.consume(lexer.OpenParenthesisToken)
+		params := prs.parseParameterList()
+		prs.consume(lexer.CloseParenthesisToken)
+
+		typeClause := prs.parseOptionalTypeClause()
+
+		// semicolons are allowed after signatures
+		if prs.current().Kind == lexer.Semicolon {
+			prs.consume(lexer.Semicolon)
+		}
+
+		// interface functions are always public
+		function := nodes.CreateFunctionDeclarationMember(fkw, identifier, make([]lexer.Token, 0), params, typeClause, nodes.BlockStatementNode{}, true)
+		prs.track(begin, function)
+		functions = append(functions, function)
+
 		// if we got stuck somewhere, just keep moving
 		if startToken == prs.current() {
 			prs.Index++
@@ -251,10 +362,14 @@ func (prs *Parser) parseClassDeclaration() nodes.ClassDeclarationMember {
 
 	closing := prs.consume(lexer.CloseBraceToken)
 
-	return nodes.CreateClassDeclarationMember(kw, id, members, closing)
+	node := nodes.CreateInterfaceDeclarationMember(kw, id, functions, closing)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parseStructDeclaration() nodes.StructDeclarationMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.StructKeyword)
 	id := prs.consume(lexer.IdToken)
 
@@ -267,6 +382,8 @@ func (prs *Parser) parseStructDeclaration() nodes.StructDeclarationMember {
 	// loop while the current tokent isnt } or eof
 	for prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
 
+		startToken := prs.current()
+
 		field := prs.parseParameter() // a name + a type
 		fields = append(fields, field)
 
@@ -274,14 +391,23 @@ func (prs *Parser) parseStructDeclaration() nodes.StructDeclarationMember {
 		if prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
 			prs.consume(lexer.CommaToken)
 		}
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
 	}
 
 	closing := prs.consume(lexer.CloseBraceToken)
 
-	return nodes.CreateStructDeclarationMember(kw, id, fields, closing)
+	node := nodes.CreateStructDeclarationMember(kw, id, fields, closing)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parseEnumDeclaration() nodes.EnumDeclarationMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.EnumKeyword)
 	id := prs.consume(lexer.IdToken)
 
@@ -294,6 +420,8 @@ func (prs *Parser) parseEnumDeclaration() nodes.EnumDeclarationMember {
 	// loop while the current tokent isnt } or eof
 	for prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
 
+		startToken := prs.current()
+
 		field := prs.consume(lexer.IdToken)
 		var value *nodes.LiteralExpressionNode = nil
 
@@ -309,14 +437,23 @@ func (prs *Parser) parseEnumDeclaration() nodes.EnumDeclarationMember {
 		if prs.current().Kind != lexer.EOF && prs.current().Kind != lexer.CloseBraceToken {
 			prs.consume(lexer.CommaToken)
 		}
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
 	}
 
 	closing := prs.consume(lexer.CloseBraceToken)
 
-	return nodes.CreateEnumDeclarationMember(kw, id, fields, closing)
+	node := nodes.CreateEnumDeclarationMember(kw, id, fields, closing)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parsePackageReference() nodes.PackageReferenceMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.PackageKeyword)
 	id := prs.consume(lexer.IdToken)
 
@@ -324,10 +461,14 @@ func (prs *Parser) parsePackageReference() nodes.PackageReferenceMember {
 		prs.consume(lexer.Semicolon)
 	}
 
-	return nodes.CreatePackageReferenceMember(kw, id)
+	node := nodes.CreatePackageReferenceMember(kw, id)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parsePackageAlias() nodes.PackageAliasMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.AliasKeyword)
 	src := prs.consume(lexer.IdToken)
 	als := prs.consume(lexer.IdToken)
@@ -336,10 +477,14 @@ func (prs *Parser) parsePackageAlias() nodes.PackageAliasMember {
 		prs.consume(lexer.Semicolon)
 	}
 
-	return nodes.CreatePackageAliasMember(kw, src, als)
+	node := nodes.CreatePackageAliasMember(kw, src, als)
+	prs.track(begin, node)
+	return node
 }
 
 func (prs *Parser) parsePackageUse() nodes.PackageUseMember {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.UseKeyword)
 	id := prs.consume(lexer.IdToken)
 
@@ -347,7 +492,9 @@ func (prs *Parser) parsePackageUse() nodes.PackageUseMember {
 		prs.consume(lexer.Semicolon)
 	}
 
-	return nodes.CreatePackageUseMember(kw, id)
+	node := nodes.CreatePackageUseMember(kw, id)
+	prs.track(begin, node)
+	return node
 }
 
 // parseParameterList we parse a list of arguments (usually for a function or functionCall)
@@ -378,9 +525,39 @@ func (prs *Parser) parseParameterList() []nodes.ParameterNode {
 // example: arg1 string
 // arg1 is the identifier, string is the typeClause
 func (prs *Parser) parseParameter() nodes.ParameterNode {
+	begin := prs.Index
+
 	identifier := prs.consume(lexer.IdToken)
 	typeClause := prs.parseTypeClause()
-	return nodes.CreateParameterNode(identifier, typeClause)
+	node := nodes.CreateParameterNode(identifier, typeClause)
+	prs.track(begin, node)
+	return node
+}
+
+// parseOptionalTypeParameters parses the [T, U] behind the name of a generic class or function
+// if there are none, an empty list is returned
+func (prs *Parser) parseOptionalTypeParameters() []lexer.Token {
+	params := make([]lexer.Token, 0)
+
+	if prs.current().Kind != lexer.OpenBracketToken {
+		return params
+	}
+
+	prs.consume(lexer.OpenBracketToken)
+
+	for true {
+		params = append(params, prs.consume(lexer.IdToken))
+
+		if prs.current().Kind != lexer.CommaToken {
+			break
+		} else {
+			prs.consume(lexer.CommaToken)
+		}
+	}
+
+	prs.consume(lexer.CloseBracketToken)
+
+	return params
 }
 
 // parseOptionalTypeClause we check if a typeClause is there, return an empty one if so, otherwise we return a TypeClauseNode
@@ -395,6 +572,8 @@ func (prs *Parser) parseOptionalTypeClause() nodes.TypeClauseNode {
 
 // parseTypeClause consumes the datatype and returns it in node form
 func (prs *Parser) parseTypeClause() nodes.TypeClauseNode {
+	begin := prs.Index
+
 	// if theres a '->' token, consume it
 	if prs.current().Kind == lexer.AccessToken {
 		prs.consume(lexer.AccessToken)
@@ -430,12 +609,16 @@ func (prs *Parser) parseTypeClause() nodes.TypeClauseNode {
 		closing = prs.consume(lexer.CloseBracketToken)
 	}
 
-	return nodes.CreateTypeClauseNode(pack, identifier, subTypes, closing)
+	node := nodes.CreateTypeClauseNode(pack, identifier, subTypes, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseUncertainTypeClause consumes the datatype and returns it in node form
 // difference to parseTypeClause is that this one can fail safely if we notice that this isn't a type
 func (prs *Parser) parseUncertainTypeClause() (nodes.TypeClauseNode, bool) {
+	begin := prs.Index
+
 	// if theres a package token (::) then the type has a prefix
 	var pack *lexer.Token = nil
 	if prs.peek(1).Kind == lexer.PackageToken {
@@ -483,13 +666,17 @@ func (prs *Parser) parseUncertainTypeClause() (nodes.TypeClauseNode, bool) {
 		closing = prs.consume(lexer.CloseBracketToken)
 	}
 
-	return nodes.CreateTypeClauseNode(pack, identifier, subTypes, closing), true
+	node := nodes.CreateTypeClauseNode(pack, identifier, subTypes, closing)
+	prs.track(begin, node)
+	return node, true
 }
 
 // <STATEMENTS> ---------------------------------------------------------------
 
 // parseStatement Based off the first keyword it'll parse a statement
 func (prs *Parser) parseStatement() nodes.StatementNode {
+	begin := prs.Index
+	errors := prs.Errors
 	var statement nodes.StatementNode = nil
 	// nil StatementNode can cause segmentation violation if no correct key is found. (handled in parsePrimaryExpression)
 
@@ -522,6 +709,18 @@ func (prs *Parser) parseStatement() nodes.StatementNode {
 		// from ( ... ) to ... { ... }
 	} else if cur == lexer.FromKeyword {
 		statement = prs.parseFromToStatement()
+		// foreach (var ... in ...) { ... }
+	} else if cur == lexer.ForEachKeyword {
+		statement = prs.parseForEachStatement()
+		// try { ... } catch (e) { ... } finally { ... }
+	} else if cur == lexer.TryKeyword {
+		statement = prs.parseTryStatement()
+		// throw ...;
+	} else if cur == lexer.ThrowKeyword {
+		statement = prs.parseThrowStatement()
+		// switch ( ... ) { case ...: ... default: ... }
+	} else if cur == lexer.SwitchKeyword {
+		statement = prs.parseSwitchStatement()
 
 	} else {
 		// Lastly we process an expression
@@ -530,9 +729,20 @@ func (prs *Parser) parseStatement() nodes.StatementNode {
 		// moved the error message to parsePrimaryExpression()
 	}
 
+	// if this statement ended in a mess, skip to somewhere we can continue from
+	if prs.recovering(errors) {
+		prs.synchronize()
+	}
+
 	// if there's a semicolon -> a b s o r b    i t
 	if prs.current().Kind == lexer.Semicolon {
 		prs.consume(lexer.Semicolon)
+
+		// the semicolon is part of the statement
+		last := len(prs.Ranges) - 1
+		if last >= 0 && prs.Ranges[last].Start == begin && prs.Ranges[last].End == prs.Index-1 {
+			prs.Ranges[last].End = prs.Index
+		}
 	}
 
 	return statement
@@ -541,6 +751,8 @@ func (prs *Parser) parseStatement() nodes.StatementNode {
 // parseBlockStatement this statement contains a bunch of other statements while also being a statement itself
 // Example if { ... } (the "{ ... }" is the block statement).
 func (prs *Parser) parseBlockStatement() nodes.BlockStatementNode {
+	begin := prs.Index
+
 	// create a list for our statement
 	statements := make([]nodes.StatementNode, 0)
 
@@ -565,12 +777,15 @@ func (prs *Parser) parseBlockStatement() nodes.BlockStatementNode {
 	// }
 	closeBrace := prs.consume(lexer.CloseBraceToken)
 
-	return nodes.CreateBlockStatementNode(openBrace, statements, closeBrace)
+	node := nodes.CreateBlockStatementNode(openBrace, statements, closeBrace)
+	prs.track(begin, node)
+	return node
 }
 
 // parseVariableDeclaration parses a variable declaration like var x <- 10;
 // Example: var name <- "Jerry";
 func (prs *Parser) parseVariableDeclaration() nodes.VariableDeclarationStatementNode {
+	begin := prs.Index
 
 	// Firstly we absorb the var or set keyword
 	// In our case (example) we are absorbing var.
@@ -600,16 +815,22 @@ func (prs *Parser) parseVariableDeclaration() nodes.VariableDeclarationStatement
 		initializer := prs.parseExpression()
 
 		// return our newly parsed variable declaration
-		return nodes.CreateVariableDeclarationStatementNode(keyword, typeClause, identifier, assign, initializer)
+		node := nodes.CreateVariableDeclarationStatementNode(keyword, typeClause, identifier, assign, initializer)
+		prs.track(begin, node)
+		return node
 	} else {
 		// if theres no initializer, we'll just null it
-		return nodes.CreateVariableDeclarationStatementNode(keyword, typeClause, identifier, assign, nil)
+		node := nodes.CreateVariableDeclarationStatementNode(keyword, typeClause, identifier, assign, nil)
+		prs.track(begin, node)
+		return node
 	}
 
 }
 
 // parseIfStatement as you can probably guess, this function is called when parseStatement gets an IfKeyword Token.
 func (prs *Parser) parseIfStatement() nodes.IfStatementNode {
+	begin := prs.Index
+
 	// Remember if statements?
 	// if ( ... ) { ... }
 
@@ -630,12 +851,15 @@ func (prs *Parser) parseIfStatement() nodes.IfStatementNode {
 	// this will be an empty elseClauseNode
 	elseClause := prs.parseElseClause()
 
-	return nodes.CreateIfStatementNode(keyword, condition, statement, elseClause)
+	node := nodes.CreateIfStatementNode(keyword, condition, statement, elseClause)
+	prs.track(begin, node)
+	return node
 }
 
 // parseElseClause this parses else statements
 // Example else { ... } (this usually comes after an if statement like: if ( ... ) { ... } else { ... }
 func (prs *Parser) parseElseClause() nodes.ElseClauseNode {
+	begin := prs.Index
 
 	// if theres no else -> dont parse an else lol - Red
 	if prs.current().Kind != lexer.ElseKeyword {
@@ -648,12 +872,15 @@ func (prs *Parser) parseElseClause() nodes.ElseClauseNode {
 	// Same as if statement this can be a single statement or a block statement
 	statement := prs.parseStatement()
 
-	return nodes.CreateElseClauseNode(keyword, statement)
+	node := nodes.CreateElseClauseNode(keyword, statement)
+	prs.track(begin, node)
+	return node
 }
 
 // parseReturnStatement handles return statements like: return ...
 // happens at the end of a function, if you don't know that you should be reading this tbh
 func (prs *Parser) parseReturnStatement() nodes.ReturnStatementNode {
+	begin := prs.Index
 
 	// a b s o r b return keyword
 	keyword := prs.consume(lexer.ReturnKeyword)
@@ -665,12 +892,16 @@ func (prs *Parser) parseReturnStatement() nodes.ReturnStatementNode {
 		expression = prs.parseExpression()
 	}
 
-	return nodes.CreateReturnStatementNode(keyword, expression)
+	node := nodes.CreateReturnStatementNode(keyword, expression)
+	prs.track(begin, node)
+	return node
 }
 
 // parseForStatement handles for statements (loops)
 // Example: for ( ..., ..., ...) { ... }
 func (prs *Parser) parseForStatement() nodes.ForStatementNode {
+	begin := prs.Index
+
 	// First we consume that for keyword, so we can get to the good parts
 	keyword := prs.consume(lexer.ForKeyword)
 
@@ -695,12 +926,15 @@ func (prs *Parser) parseForStatement() nodes.ForStatementNode {
 	statement := prs.parseStatement()
 
 	// pretty sure I write this, glad it didn't require correcting - tokorv
-	return nodes.CreateForStatementNode(keyword, initialiser, condition, updation, statement)
+	node := nodes.CreateForStatementNode(keyword, initialiser, condition, updation, statement)
+	prs.track(begin, node)
+	return node
 }
 
 // parseWhileStatement a while loop
 // Example: while ( ... ) { ... }
 func (prs *Parser) parseWhileStatement() nodes.WhileStatementNode {
+	begin := prs.Index
 
 	// We already know WhileKeyword is there because we detected it in parseStatement
 	// not we consume it to move to the next token.
@@ -715,7 +949,9 @@ func (prs *Parser) parseWhileStatement() nodes.WhileStatementNode {
 	// Usually this is a blockStatement, but it can be a single statement like Print()
 	statement := prs.parseStatement()
 
-	return nodes.CreateWhileStatementNode(keyword, condition, statement)
+	node := nodes.CreateWhileStatementNode(keyword, condition, statement)
+	prs.track(begin, node)
+	return node
 }
 
 // parseFromToStatement a from to loop (quite unique to rect I think)
@@ -724,6 +960,7 @@ func (prs *Parser) parseWhileStatement() nodes.WhileStatementNode {
 // Code Example: from i <- 0 to 100 { Print(string(i)); }
 // the above code ill print all numbers from 0 to 100.
 func (prs *Parser) parseFromToStatement() nodes.FromToStatementNode {
+	begin := prs.Index
 
 	// We're expecting it from parseStatement,
 	// we consume it, so we can parse the other tokens
@@ -744,29 +981,220 @@ func (prs *Parser) parseFromToStatement() nodes.FromToStatementNode {
 
 	// and now we get the statement, same as all other loops, this can be a blockStatement or single statement
 	statement := prs.parseStatement()
-	return nodes.CreateFromToStatementNode(keyword, identifier, lowerBound, upperBound, statement)
+	node := nodes.CreateFromToStatementNode(keyword, identifier, lowerBound, upperBound, statement)
+	prs.track(begin, node)
+	return node
+}
+
+// parseForEachStatement a loop going over every element of a collection
+// Example: foreach (var ... in ...) { ... }
+// Code Example: foreach (var name in names) { Print(name); }
+// the above code will print every string in the names array.
+func (prs *Parser) parseForEachStatement() nodes.ForEachStatementNode {
+	begin := prs.Index
+
+	keyword := prs.consume(lexer.ForEachKeyword)
+
+	// this is where we handle ( ... ) : ( var name in names )
+	prs.consume(lexer.OpenParenthesisToken)  // (
+	prs.consume(lexer.VarKeyword)            // var
+	identifier := prs.consume(lexer.IdToken) // the name each element will go by
+	prs.consume(lexer.InKeyword)             // in
+	collection := prs.parseExpression()      // the thing we're looping over
+	prs.consume(lexer.CloseParenthesisToken) // )
+
+	// and now we get the statement, same as all other loops, this can be a blockStatement or single statement
+	statement := prs.parseStatement()
+	node := nodes.CreateForEachStatementNode(keyword, identifier, collection, statement)
+	prs.track(begin, node)
+	return node
+}
+
+// parseSwitchStatement handles switch statements and all of their cases
+// Example: switch (x) { case 1, 2: { ... } case 3: ...; default: ...; }
+func (prs *Parser) parseSwitchStatement() nodes.SwitchStatementNode {
+	begin := prs.Index
+
+	keyword := prs.consume(lexer.SwitchKeyword)
+
+	// the value we're switching on
+	prs.consume(lexer.OpenParenthesisToken)
+	expression := prs.parseExpression()
+	prs.consume(lexer.CloseParenthesisToken)
+
+	// keep going until we find the closing brace
+	prs.consume(lexer.OpenBraceToken)
+	cases := make([]nodes.CaseClauseNode, 0)
+	for prs.current().Kind != lexer.CloseBraceToken &&
+		prs.current().Kind != lexer.EOF {
+		startToken := prs.current()
+		cases = append(cases, prs.parseCaseClause(false))
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
+	}
+	closing := prs.consume(lexer.CloseBraceToken)
+
+	node := nodes.CreateSwitchStatementNode(keyword, expression, cases, closing)
+	prs.track(begin, node)
+	return node
+}
+
+// parseCaseClause parses a single case of a switch statement or match expression
+// Example: case 1, 2: ... or default: ...
+// in switches the case ends in a statement, in matches it ends in a value
+func (prs *Parser) parseCaseClause(isMatch bool) nodes.CaseClauseNode {
+	begin := prs.Index
+
+	values := make([]nodes.ExpressionNode, 0)
+	var keyword lexer.Token
+
+	if prs.current().Kind == lexer.DefaultKeyword {
+		keyword = prs.consume(lexer.DefaultKeyword)
+	} else {
+		keyword = prs.consume(lexer.CaseKeyword)
+
+		// one or more values, separated by commas
+		values = append(values, prs.parseExpression())
+		for prs.current().Kind == lexer.CommaToken {
+			prs.consume(lexer.CommaToken)
+			values = append(values, prs.parseExpression())
+		}
+	}
+
+	prs.consume(lexer.ColonToken)
+
+	if isMatch {
+		value := prs.parseExpression()
+
+		// values can be ended with a semicolon or comma if you like that more
+		if prs.current().Kind == lexer.Semicolon || prs.current().Kind == lexer.CommaToken {
+			prs.consume(prs.current().Kind)
+		}
+
+		node := nodes.CreateCaseClauseNode(keyword, values, nil, value)
+		prs.track(begin, node)
+		return node
+	}
+
+	// no fallthrough, so every case just gets one statement (which can be a block statement)
+	statement := prs.parseStatement()
+	node := nodes.CreateCaseClauseNode(keyword, values, statement, nil)
+	prs.track(begin, node)
+	return node
 }
 
 // parseBreakStatement processes "break" keyword (honesty nothing special, similar process to continue and return)
 func (prs *Parser) parseBreakStatement() nodes.BreakStatementNode {
+	begin := prs.Index
+
 	keyword := prs.consume(lexer.BreakKeyword)
 
-	return nodes.CreateBreakStatement(keyword)
+	node := nodes.CreateBreakStatement(keyword)
+	prs.track(begin, node)
+	return node
 }
 
 // parseContinueStatement processes the "continue" keyword, not much happening really.
 func (prs *Parser) parseContinueStatement() nodes.ContinueStatementNode {
+	begin := prs.Index
+
 	keyword := prs.consume(lexer.ContinueKeyword)
 
-	return nodes.CreateContinueStatement(keyword)
+	node := nodes.CreateContinueStatement(keyword)
+	prs.track(begin, node)
+	return node
+}
+
+// parseTryStatement handles try statements and their catch and finally clauses
+// Example: try { ... } catch (e) { ... } finally { ... }
+func (prs *Parser) parseTryStatement() nodes.TryStatementNode {
+	begin := prs.Index
+
+	keyword := prs.consume(lexer.TryKeyword)
+
+	// the statement we're keeping an eye on, usually a block statement
+	statement := prs.parseStatement()
+
+	// both clauses are optional here, the binder complains if neither of them is there
+	catchClause := prs.parseCatchClause()
+	finallyClause := prs.parseFinallyClause()
+
+	node := nodes.CreateTryStatementNode(keyword, statement, catchClause, finallyClause)
+	prs.track(begin, node)
+	return node
+}
+
+// parseCatchClause parses the catch part of a try statement
+// Example: catch (e) { ... } or just catch { ... } if you don't care what went wrong
+func (prs *Parser) parseCatchClause() nodes.CatchClauseNode {
+	begin := prs.Index
+
+	// no catch -> no clause
+	if prs.current().Kind != lexer.CatchKeyword {
+		return nodes.CatchClauseNode{}
+	}
+
+	keyword := prs.consume(lexer.CatchKeyword)
+
+	// the exception variable is optional
+	identifier := lexer.Token{}
+	if prs.current().Kind == lexer.OpenParenthesisToken {
+		prs.consume(lexer.OpenParenthesisToken)
+		identifier = prs.consume(lexer.IdToken)
+		prs.consume(lexer.CloseParenthesisToken)
+	}
+
+	statement := prs.parseStatement()
+
+	node := nodes.CreateCatchClauseNode(keyword, identifier, statement)
+	prs.track(begin, node)
+	return node
+}
+
+// parseFinallyClause parses the finally part of a try statement
+// Example: finally { ... }
+func (prs *Parser) parseFinallyClause() nodes.FinallyClauseNode {
+	begin := prs.Index
+
+	// no finally -> no clause
+	if prs.current().Kind != lexer.FinallyKeyword {
+		return nodes.FinallyClauseNode{}
+	}
+
+	keyword := prs.consume(lexer.FinallyKeyword)
+	statement := prs.parseStatement()
+
+	node := nodes.CreateFinallyClauseNode(keyword, statement)
+	prs.track(begin, node)
+	return node
+}
+
+// parseThrowStatement handles throw statements, works just like return
+// Example: throw "oh no";
+func (prs *Parser) parseThrowStatement() nodes.ThrowStatementNode {
+	begin := prs.Index
+
+	keyword := prs.consume(lexer.ThrowKeyword)
+	expression := prs.parseExpression()
+
+	node := nodes.CreateThrowStatementNode(keyword, expression)
+	prs.track(begin, node)
+	return node
 }
 
 // parseExpressionStatement expressions are 2nd class citizens, they come after statements
 // If no statement can be found, we try to process an expression.
 func (prs *Parser) parseExpressionStatement() nodes.ExpressionStatementNode {
+	begin := prs.Index
+
 	expression := prs.parseExpression()
 	// We basically parse an expression
-	return nodes.CreateExpressionStatementNode(expression)
+	node := nodes.CreateExpressionStatementNode(expression)
+	prs.track(begin, node)
+	return node
 }
 
 // </STATEMENTS> --------------------------------------------------------------
@@ -792,10 +1220,14 @@ func (prs *Parser) parseExpression() nodes.ExpressionNode {
 		((prs.peek(1).Kind == lexer.PlusToken && prs.peek(2).Kind == lexer.PlusToken) ||
 			(prs.peek(1).Kind == lexer.MinusToken && prs.peek(2).Kind == lexer.MinusToken)) {
 
+		begin := prs.Index
 		identifier := prs.consume(lexer.IdToken)    // the "i" in "i++"
 		operator := prs.consume(prs.current().Kind) // the "+"
 		prs.consume(prs.current().Kind)             // another "+", we don't have to check because we do that in the if statement above
-		return nodes.CreateVariableEditorExpressionNode(identifier, operator, nil, true)
+
+		node := nodes.CreateVariableEditorExpressionNode(identifier, operator, nil, true)
+		prs.track(begin, node)
+		return node
 	}
 
 	// assignment expression
@@ -820,6 +1252,7 @@ func (prs *Parser) parseExpression() nodes.ExpressionNode {
 
 // parseBinaryExpression
 func (prs *Parser) parseBinaryExpression(parentPrecedence int) nodes.ExpressionNode {
+	begin := prs.Index
 	var left nodes.ExpressionNode
 
 	// check if this is a unary expression
@@ -827,7 +1260,10 @@ func (prs *Parser) parseBinaryExpression(parentPrecedence int) nodes.ExpressionN
 	if unaryPrecedence != 0 && unaryPrecedence > parentPrecedence {
 		operator := prs.consume(prs.current().Kind)
 		operand := prs.parseBinaryExpression(unaryPrecedence)
-		return nodes.CreateUnaryExpressionNode(operator, operand)
+
+		node := nodes.CreateUnaryExpressionNode(operator, operand)
+		prs.track(begin, node)
+		return node
 
 		// if not, start by parsing our left expression
 	} else {
@@ -835,17 +1271,17 @@ func (prs *Parser) parseBinaryExpression(parentPrecedence int) nodes.ExpressionN
 
 		// check if there's an array access going on here
 		if prs.current().Kind == lexer.OpenBracketToken {
-			left = prs.parseArrayAccessExpressionFromValue(left)
+			left = prs.parseArrayAccessExpressionFromValue(left, begin)
 		}
 
 		// check if there's a type call going on here
 		if prs.current().Kind == lexer.AccessToken {
-			left = prs.parseTypeCallExpressionFromValue(left)
+			left = prs.parseTypeCallExpressionFromValue(left, begin)
 		}
 
 		// check if there's a ternary expression going on here
 		if prs.current().Kind == lexer.QuestionMarkToken {
-			left = prs.parseTernaryExpression(left)
+			left = prs.parseTernaryExpression(left, begin)
 		}
 	}
 
@@ -864,6 +1300,7 @@ func (prs *Parser) parseBinaryExpression(parentPrecedence int) nodes.ExpressionN
 
 		// set left to our current expression and continue
 		left = nodes.CreateBinaryExpressionNode(operator, left, right)
+		prs.track(begin, left)
 	}
 
 	return left
@@ -875,6 +1312,9 @@ func (prs *Parser) parsePrimaryExpression() nodes.ExpressionNode {
 	if cur == lexer.StringToken || cur == lexer.NativeStringToken {
 		return prs.parseStringLiteral()
 
+	} else if cur == lexer.InterpolatedStringStartToken {
+		return prs.parseInterpolatedString()
+
 	} else if cur == lexer.NumberToken {
 		return prs.parseNumberLiteral()
 
@@ -902,41 +1342,47 @@ func (prs *Parser) parsePrimaryExpression() nodes.ExpressionNode {
 
 	} else if prs.current().Kind == lexer.MainKeyword {
 		return prs.parseMainExpression()
+
+	} else if cur == lexer.MatchKeyword {
+		return prs.parseMatchExpression()
 	}
 
 	// No proper keyword is found
-	// Since ExpressionNode is nil the program will crash anyway, at least we exit safely
-	// Added this additionalInfo section to help users know why a parser error occurred
-	additionalInfo := ""
-	if prs.current().Kind == lexer.BadToken {
-		additionalInfo = " (may be caused by previous \"UnexpectedCharacterError\" which produces a BadToken)"
+	// complain and put a placeholder in its place, the binder knows to ignore those
+	begin := prs.Index
+	token := prs.current()
+	prs.unexpected("unexpected Token \"%s\"!", token.Kind)
+
+	// if this token can't end or start anything, it's just garbage -> step over it
+	if !isSynchronizing(token.Kind) {
+		prs.Index++
 	}
-	print.Error(
-		"PARSER",
-		print.UnexpectedTokenError,
-		prs.current().Span,
-		"unexpected Token \"%s\"!"+additionalInfo,
-		prs.current().Kind,
-	)
-	os.Exit(1)
 
-	return nil
+	node := nodes.CreateErrorExpressionNode(token)
+	prs.track(begin, node)
+	return node
 }
 
 // parseAssignmentExpression takes an assignmentExpression and returns a node of the same type
 // Example: x <- 100;
 // this is when x has already been defined but is now being assigned a new value.
 func (prs *Parser) parseAssignmentExpression() nodes.AssignmentExpressionNode {
+	begin := prs.Index
+
 	identifier := prs.consume(lexer.IdToken) // the variable you're assigning the new value (like "x")
 	prs.consume(lexer.AssignToken)           // check and skip past the <- (assignToken)
 	value := prs.parseExpression()           // new value of variable (like "x"'s new value is 100).
 
-	return nodes.CreateAssignmentExpressionNode(identifier, value)
+	node := nodes.CreateAssignmentExpressionNode(identifier, value)
+	prs.track(begin, node)
+	return node
 }
 
 // parseVariableEditorExpression this parses an expression like i <-+ 1, the variable is reassigned using the
 // AssignToken, and an operator instead of an expression
 func (prs *Parser) parseVariableEditorExpression() nodes.VariableEditorExpressionNode {
+	begin := prs.Index
+
 	identifier := prs.consume(lexer.IdToken)    // Get the identifier you want to edit
 	prs.consume(lexer.AssignToken)              // a b s o r b assignment token (we don't need it)
 	operator := prs.consume(prs.current().Kind) // get the operator (this is important as we want to know if it's an
@@ -945,7 +1391,9 @@ func (prs *Parser) parseVariableEditorExpression() nodes.VariableEditorExpressio
 	// Get new expression value
 	expression := prs.parseExpression()
 
-	return nodes.CreateVariableEditorExpressionNode(identifier, operator, expression, false)
+	node := nodes.CreateVariableEditorExpressionNode(identifier, operator, expression, false)
+	prs.track(begin, node)
+	return node
 }
 
 // parseNameOrCallExpression we're either parsing a NameExpressionNode or a CallExpressionNode
@@ -972,14 +1420,19 @@ func (prs *Parser) parseNameOrCallExpression() nodes.ExpressionNode {
 
 // parseThisExpression literally just 'this'
 func (prs *Parser) parseThisExpression() nodes.ExpressionNode {
+	begin := prs.Index
+
 	kw := prs.consume(lexer.ThisKeyword)
-	return nodes.CreateThisExpressionNode(kw)
+	node := nodes.CreateThisExpressionNode(kw)
+	prs.track(begin, node)
+	return node
 }
 
 // parseCallExpression this for when we're calling a function
 // For example: Print("hello");
 // we need "Print", and the parameters "hello"
 func (prs *Parser) parseCallExpression() nodes.CallExpressionNode {
+	begin := prs.Index
 
 	// We need the identifier to know which function the program called
 	identifier := prs.consume(lexer.IdToken)
@@ -988,13 +1441,17 @@ func (prs *Parser) parseCallExpression() nodes.CallExpressionNode {
 	args := prs.parseArguments()                        // We get the arguments being put into the function
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
-	return nodes.CreateCallExpressionNode(identifier, args, nodes.TypeClauseNode{}, closing)
+	node := nodes.CreateCallExpressionNode(identifier, args, nodes.TypeClauseNode{}, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseComplexCastExpression this for casting what ive called "complex cast" here
 // For example: complexType[string, int](someAny)
 // we need the type and expression but have to make sure this actually is a cast and not an array access
+// (calls to generic functions look exactly the same, Max[int](a, b), so the binder figures out which one it is)
 func (prs *Parser) parseComplexCastExpression() nodes.ExpressionNode {
+	begin := prs.Index
 
 	// We store the identifier, so we can rewind in case we need to
 	identifier := prs.current()
@@ -1015,16 +1472,19 @@ func (prs *Parser) parseComplexCastExpression() nodes.ExpressionNode {
 	}
 
 	prs.consume(lexer.OpenParenthesisToken)             // (
-	expression := prs.parseExpression()                 // We get the expression we want to cast
+	args := prs.parseArguments()                        // We get the expression we want to cast (or the generic call's arguments)
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
 	// return a call expression as the rest is all managed through it
-	return nodes.CreateCallExpressionNode(identifier, []nodes.ExpressionNode{expression}, typeClause, closing)
+	node := nodes.CreateCallExpressionNode(identifier, args, typeClause, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parsePackageCallExpression this for when we're calling a function from a package
 // For example: sys::Print("hello");
 func (prs *Parser) parsePackageCallExpression() nodes.PackageCallExpressionNode {
+	begin := prs.Index
 
 	// We need the identifier to know which package to select
 	pack := prs.consume(lexer.IdToken)
@@ -1037,7 +1497,9 @@ func (prs *Parser) parsePackageCallExpression() nodes.PackageCallExpressionNode
 	args := prs.parseArguments()                        // We get the arguments being put into the function
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
-	return nodes.CreatePackageCallExpressionNode(pack, identifier, args, closing)
+	node := nodes.CreatePackageCallExpressionNode(pack, identifier, args, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseArrayAccessExpression this for accessing arrays!
@@ -1047,15 +1509,17 @@ func (prs *Parser) parseArrayAccessExpression() nodes.ExpressionNode {
 
 	// the variable we're accessing
 	// we aren't using the symbol directly, we are using a variable expression
+	begin := prs.Index
 	base := prs.parseNameExpression()
 
-	return prs.parseArrayAccessExpressionFromValue(base)
+	return prs.parseArrayAccessExpressionFromValue(base, begin)
 }
 
 // parseArrayAccessExpressionFromValue this for accessing arrays!
 // For example: someArray[1]
 // we need to get the identifier and index we want to access
-func (prs *Parser) parseArrayAccessExpressionFromValue(expr nodes.ExpressionNode) nodes.ExpressionNode {
+// (begin is where the value started)
+func (prs *Parser) parseArrayAccessExpressionFromValue(expr nodes.ExpressionNode, begin int) nodes.ExpressionNode {
 
 	prs.consume(lexer.OpenBracketToken)  // [
 	index := prs.parseExpression()       // We get the index expression
@@ -1066,17 +1530,21 @@ func (prs *Parser) parseArrayAccessExpressionFromValue(expr nodes.ExpressionNode
 		prs.consume(lexer.AssignToken) // <-
 		value := prs.parseExpression() // the value to store in the array
 		// return an array assignment expression
-		return nodes.CreateArrayAssignmentExpressionNode(expr, index, value)
+		node := nodes.CreateArrayAssignmentExpressionNode(expr, index, value)
+		prs.track(begin, node)
+		return node
 	}
 
 	// return an array access expression
-	return nodes.CreateArrayAccessExpressionNode(expr, index)
+	node := nodes.CreateArrayAccessExpressionNode(expr, index)
+	prs.track(begin, node)
+	return node
 }
 
 // parseTernaryExpression this for the one and only ternary
 // For example: a == b ? c : d
 // (the condition is given to us by parseBinaryExpression)
-func (prs *Parser) parseTernaryExpression(condition nodes.ExpressionNode) nodes.ExpressionNode {
+func (prs *Parser) parseTernaryExpression(condition nodes.ExpressionNode, begin int) nodes.ExpressionNode {
 
 	prs.consume(lexer.QuestionMarkToken) // ?
 
@@ -1085,13 +1553,16 @@ func (prs *Parser) parseTernaryExpression(condition nodes.ExpressionNode) nodes.
 	elseExpression := prs.parseExpression() // get the right side of the ternary expression
 
 	// return an array access expression
-	return nodes.CreateTernaryExpressionNode(condition, ifExpression, elseExpression)
+	node := nodes.CreateTernaryExpressionNode(condition, ifExpression, elseExpression)
+	prs.track(begin, node)
+	return node
 }
 
 // parseMakeExpression this for creating objects
 // For example: make SomeClass()
 // we need to get the name of the class we want to create and its parameters
 func (prs *Parser) parseMakeExpression() nodes.ExpressionNode {
+	begin := prs.Index
 
 	makeKeyword := prs.consume(lexer.MakeKeyword) // make
 
@@ -1106,6 +1577,21 @@ func (prs *Parser) parseMakeExpression() nodes.ExpressionNode {
 	// We need the class' Identifier
 	baseType := prs.consume(lexer.IdToken)
 
+	// generic classes get their type arguments right after the name (make Stack[int]())
+	// if this isnt followed by a '(' its actually an array creation (make Stack[int] array(10))
+	typeArgs := make([]nodes.TypeClauseNode, 0)
+	if prs.current().Kind == lexer.OpenBracketToken {
+		prs.rewind(baseType)
+		typeClause, ok := prs.parseUncertainTypeClause()
+
+		if !ok || prs.current().Kind != lexer.OpenParenthesisToken {
+			prs.rewind(makeKeyword)
+			return prs.parseMakeArrayExpression()
+		}
+
+		typeArgs = typeClause.SubClauses
+	}
+
 	// check if the next token is an open parenthesis
 	// if it is we can be certain that this is an object creation
 	// if it's an open brace '{' this is actually a struct literal
@@ -1119,7 +1605,7 @@ func (prs *Parser) parseMakeExpression() nodes.ExpressionNode {
 
 	// if we get a '{', parse a struct literal
 	if prs.current().Kind == lexer.OpenBraceToken {
-		return prs.parseMakeStructExpression(makeKeyword, baseType)
+		return prs.parseMakeStructExpression(makeKeyword, baseType, begin)
 	}
 
 	prs.consume(lexer.OpenParenthesisToken)             // (
@@ -1127,12 +1613,15 @@ func (prs *Parser) parseMakeExpression() nodes.ExpressionNode {
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
 	// return an array access expression
-	return nodes.CreateMakeExpressionNode(pack, baseType, args, makeKeyword, closing)
+	node := nodes.CreateMakeExpressionNode(pack, baseType, typeArgs, args, makeKeyword, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseMakeArrayExpression this for creating struct literals
 // For example: make SomeStruct {"this is an int", "->", 1}
-func (prs *Parser) parseMakeStructExpression(kw lexer.Token, id lexer.Token) nodes.ExpressionNode {
+// (begin is where the make keyword was)
+func (prs *Parser) parseMakeStructExpression(kw lexer.Token, id lexer.Token, begin int) nodes.ExpressionNode {
 	// list to store values in
 	literals := make([]nodes.ExpressionNode, 0)
 
@@ -1155,13 +1644,16 @@ func (prs *Parser) parseMakeStructExpression(kw lexer.Token, id lexer.Token) nod
 
 	closing := prs.consume(lexer.CloseBraceToken) // }
 
-	return nodes.CreateMakeStructExpressionNode(id, literals, kw, closing)
+	node := nodes.CreateMakeStructExpressionNode(id, literals, kw, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseMakeArrayExpression this for creating arrays
 // For example: make string array(10)
 // we need to get the type and length of the new array
 func (prs *Parser) parseMakeArrayExpression() nodes.MakeArrayExpressionNode {
+	begin := prs.Index
 
 	kw := prs.consume(lexer.MakeKeyword) // make
 
@@ -1199,7 +1691,9 @@ func (prs *Parser) parseMakeArrayExpression() nodes.MakeArrayExpressionNode {
 		closing := prs.consume(lexer.CloseBraceToken) // }
 
 		// create our node object
-		return nodes.CreateMakeArrayExpressionNodeLiteral(baseType, literals, kw, closing)
+		node := nodes.CreateMakeArrayExpressionNodeLiteral(baseType, literals, kw, closing)
+		prs.track(begin, node)
+		return node
 	}
 
 	prs.consume(lexer.OpenParenthesisToken)             // (
@@ -1207,12 +1701,15 @@ func (prs *Parser) parseMakeArrayExpression() nodes.MakeArrayExpressionNode {
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
 	// return an array access expression
-	return nodes.CreateMakeArrayExpressionNode(baseType, length, kw, closing)
+	node := nodes.CreateMakeArrayExpressionNode(baseType, length, kw, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseLambdaExpression checks for a valid order of Tokens, parses all the statements inside the function
 // and then returns it as a function declaration member.
 func (prs *Parser) parseLambdaExpression() nodes.LambdaExpressionNode {
+	begin := prs.Index
 
 	// Example:
 	// lambda (functionArg1 string) string { ... }
@@ -1232,29 +1729,71 @@ func (prs *Parser) parseLambdaExpression() nodes.LambdaExpressionNode {
 	// the block statement will handle multiple statements inside itself
 	body := prs.parseBlockStatement()
 
-	return nodes.CreateLambdaExpressionNode(kw, params, typeClause, body)
+	node := nodes.CreateLambdaExpressionNode(kw, params, typeClause, body)
+	prs.track(begin, node)
+	return node
+}
+
+// parseMatchExpression parses a match, which works like a switch statement but results in a value
+// Example: match (x) { case 1: "one"; case 2, 3: "more"; default: "many"; }
+func (prs *Parser) parseMatchExpression() nodes.MatchExpressionNode {
+	begin := prs.Index
+
+	keyword := prs.consume(lexer.MatchKeyword)
+
+	// the value we're matching against
+	prs.consume(lexer.OpenParenthesisToken)
+	expression := prs.parseExpression()
+	prs.consume(lexer.CloseParenthesisToken)
+
+	prs.consume(lexer.OpenBraceToken)
+	cases := make([]nodes.CaseClauseNode, 0)
+	for prs.current().Kind != lexer.CloseBraceToken &&
+		prs.current().Kind != lexer.EOF {
+		startToken := prs.current()
+		cases = append(cases, prs.parseCaseClause(true))
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
+	}
+	closing := prs.consume(lexer.CloseBraceToken)
+
+	node := nodes.CreateMatchExpressionNode(keyword, expression, cases, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseReferenceExpression this for creating pointers
 // For example: ref x
 func (prs *Parser) parseReferenceExpression() nodes.ReferenceExpressionNode {
+	begin := prs.Index
+
 	keyword := prs.consume(lexer.RefKeyword)
 	expression := prs.parseNameExpression()
 
-	return nodes.CreateReferenceExpressionNode(keyword, expression)
+	node := nodes.CreateReferenceExpressionNode(keyword, expression)
+	prs.track(begin, node)
+	return node
 }
 
 // parseDereferenceExpression this for creating pointers
 // For example: ref x
 func (prs *Parser) parseDereferenceExpression() nodes.DereferenceExpressionNode {
+	begin := prs.Index
+
 	keyword := prs.consume(lexer.DerefKeyword)
 	expression := prs.parsePrimaryExpression()
 
-	return nodes.CreateDereferenceExpressionNode(keyword, expression)
+	node := nodes.CreateDereferenceExpressionNode(keyword, expression)
+	prs.track(begin, node)
+	return node
 }
 
 // parseMainExpression when we want to call a function from the global (main) pseudo class
 func (prs *Parser) parseMainExpression() nodes.ExpressionNode {
+	begin := prs.Index
 
 	prs.consume(lexer.MainKeyword)               // main
 	prs.consume(lexer.AccessToken)               // ->
@@ -1262,36 +1801,42 @@ func (prs *Parser) parseMainExpression() nodes.ExpressionNode {
 
 	// if there are no parenthesis -> redirect to parseClassFieldAccessExpression
 	if prs.current().Kind != lexer.OpenParenthesisToken {
-		return prs.parseMainAccessExpression(callIdentifier)
+		return prs.parseMainAccessExpression(callIdentifier, begin)
 	}
 
 	prs.consume(lexer.OpenParenthesisToken)             // (
 	args := prs.parseArguments()                        // any arguments in the function call itself
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
-	return nodes.CreateMainCallExpressionNode(callIdentifier, args, nodes.TypeClauseNode{}, closing)
+	node := nodes.CreateMainCallExpressionNode(callIdentifier, args, nodes.TypeClauseNode{}, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseClassFieldAccessExpression when we want to get a field from inside a class object
 // Example: someClass->someField
-func (prs *Parser) parseMainAccessExpression(id lexer.Token) nodes.ExpressionNode {
+func (prs *Parser) parseMainAccessExpression(id lexer.Token, begin int) nodes.ExpressionNode {
 
 	// if we find a '<-', then this isnt an access, its an assignment
 	if prs.current().Kind == lexer.AssignToken {
-		return prs.parseMainAssignmentExpression(id)
+		return prs.parseMainAssignmentExpression(id, begin)
 	}
 
-	return nodes.CreateMainNameExpressionNode(id)
+	node := nodes.CreateMainNameExpressionNode(id)
+	prs.track(begin, node)
+	return node
 }
 
 // parseClassFieldAssignmentExpression when we want to get a field from inside a class object
 // Example: someClass->someField <- "some value";
-func (prs *Parser) parseMainAssignmentExpression(id lexer.Token) nodes.ExpressionNode {
+func (prs *Parser) parseMainAssignmentExpression(id lexer.Token, begin int) nodes.ExpressionNode {
 
 	prs.consume(lexer.AssignToken) // <-
 	value := prs.parseExpression() // the value
 
-	return nodes.CreateMainAssignmentExpressionNode(id, value)
+	node := nodes.CreateMainAssignmentExpressionNode(id, value)
+	prs.track(begin, node)
+	return node
 }
 
 // parseTypeCallExpression when we want to call a function attached to a data type (or class in the future)
@@ -1303,9 +1848,10 @@ func (prs *Parser) parseTypeCallExpression() nodes.ExpressionNode {
 
 	// the variable we're calling the function on
 	// we aren't using the symbol directly, we are using a variable expression
+	begin := prs.Index
 	base := prs.parseNameExpression()
 
-	return prs.parseTypeCallExpressionFromValue(base)
+	return prs.parseTypeCallExpressionFromValue(base, begin)
 }
 
 // parseTypeCallExpression when we want to call a function attached to a data type (or class in the future)
@@ -1313,42 +1859,49 @@ func (prs *Parser) parseTypeCallExpression() nodes.ExpressionNode {
 // the data type is string (though in a program it's going to be a variable with the type string)
 // GetLength is the exact function call we need
 // Anything in the ( ... ) we need to get as arguments
-func (prs *Parser) parseTypeCallExpressionFromValue(expr nodes.ExpressionNode) nodes.ExpressionNode {
+// (begin is where the value started)
+func (prs *Parser) parseTypeCallExpressionFromValue(expr nodes.ExpressionNode, begin int) nodes.ExpressionNode {
 	prs.consume(lexer.AccessToken)               // ->
 	callIdentifier := prs.consume(lexer.IdToken) // now we need the name of the call (like "GetLength()")
 
 	// if there are no parenthesis -> redirect to parseClassFieldAccessExpression
 	if prs.current().Kind != lexer.OpenParenthesisToken {
-		return prs.parseClassFieldAccessExpression(callIdentifier, expr)
+		return prs.parseClassFieldAccessExpression(callIdentifier, expr, begin)
 	}
 
 	prs.consume(lexer.OpenParenthesisToken)             // (
 	args := prs.parseArguments()                        // any arguments in the function call itself
 	closing := prs.consume(lexer.CloseParenthesisToken) // )
 
-	return nodes.CreateTypeCallExpressionNode(expr, callIdentifier, args, closing)
+	node := nodes.CreateTypeCallExpressionNode(expr, callIdentifier, args, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseClassFieldAccessExpression when we want to get a field from inside a class object
 // Example: someClass->someField
-func (prs *Parser) parseClassFieldAccessExpression(id lexer.Token, expr nodes.ExpressionNode) nodes.ExpressionNode {
+func (prs *Parser) parseClassFieldAccessExpression(id lexer.Token, expr nodes.ExpressionNode, begin int) nodes.ExpressionNode {
 
 	// if we find a '<-', then this isnt an access, its an assignment
 	if prs.current().Kind == lexer.AssignToken {
-		return prs.parseClassFieldAssignmentExpression(id, expr)
+		return prs.parseClassFieldAssignmentExpression(id, expr, begin)
 	}
 
-	return nodes.CreateClassFieldAccessExpressionNode(expr, id)
+	node := nodes.CreateClassFieldAccessExpressionNode(expr, id)
+	prs.track(begin, node)
+	return node
 }
 
 // parseClassFieldAssignmentExpression when we want to get a field from inside a class object
 // Example: someClass->someField <- "some value";
-func (prs *Parser) parseClassFieldAssignmentExpression(id lexer.Token, expr nodes.ExpressionNode) nodes.ClassFieldAssignmentExpressionNode {
+func (prs *Parser) parseClassFieldAssignmentExpression(id lexer.Token, expr nodes.ExpressionNode, begin int) nodes.ClassFieldAssignmentExpressionNode {
 
 	prs.consume(lexer.AssignToken) // <-
 	value := prs.parseExpression() // the value
 
-	return nodes.CreateClassFieldAssignmentExpressionNode(expr, id, value)
+	node := nodes.CreateClassFieldAssignmentExpressionNode(expr, id, value)
+	prs.track(begin, node)
+	return node
 }
 
 // parseArguments this is when we want to get a series of arguments in a function call function definition.
@@ -1376,48 +1929,106 @@ func (prs *Parser) parseArguments() []nodes.ExpressionNode {
 
 // parseNameExpression just consumes an identifier
 func (prs *Parser) parseNameExpression() nodes.NameExpressionNode {
+	begin := prs.Index
+
 	// Doesn't get any more simple than this
 	identifier := prs.consume(lexer.IdToken)
-	return nodes.CreateNameExpressionNode(identifier)
+	node := nodes.CreateNameExpressionNode(identifier)
+	prs.track(begin, node)
+	return node
 }
 
 // parseParenthesisedExpression this is an expression wrapped in parentheses
 // Example: ( 1 + 1 + 1 + 1 + 1 ) or ( "Hello" )
 // The above are relatively simple, remember this can be any Expression even complex ones.
 func (prs *Parser) parseParenthesisedExpression() nodes.ParenthesisedExpressionNode {
+	begin := prs.Index
 
 	// It's quite literally just consuming the parentheses and passing the expression as a new Node
 	opening := prs.consume(lexer.OpenParenthesisToken)
 	expression := prs.parseExpression()
 	closing := prs.consume(lexer.CloseParenthesisToken)
 
-	return nodes.CreateParenthesisedExpressionNode(expression, opening, closing)
+	node := nodes.CreateParenthesisedExpressionNode(expression, opening, closing)
+	prs.track(begin, node)
+	return node
 }
 
 // parseStringLiteral
 func (prs *Parser) parseStringLiteral() nodes.LiteralExpressionNode {
+	begin := prs.Index
+
 	// a string token can be found in the lexer in lexer.getString()
 	if prs.current().Kind == lexer.NativeStringToken {
 		str := prs.consume(lexer.NativeStringToken)
-		return nodes.CreateNativeLiteralExpressionNode(str)
+		node := nodes.CreateNativeLiteralExpressionNode(str)
+		prs.track(begin, node)
+		return node
 	} else {
 		str := prs.consume(lexer.StringToken)
-		return nodes.CreateLiteralExpressionNode(str)
+		node := nodes.CreateLiteralExpressionNode(str)
+		prs.track(begin, node)
+		return node
+	}
+}
+
+// parseInterpolatedString gets all the text parts and holes of an interpolated string
+// Example: $"hello {name}!" -> "hello ", name, "!"
+func (prs *Parser) parseInterpolatedString() nodes.InterpolatedStringExpressionNode {
+	begin := prs.Index
+	start := prs.consume(lexer.InterpolatedStringStartToken)
+	parts := make([]nodes.ExpressionNode, 0)
+
+	for prs.current().Kind != lexer.InterpolatedStringEndToken &&
+		prs.current().Kind != lexer.EOF {
+		startToken := prs.current()
+
+		// text
+		if prs.current().Kind == lexer.StringToken {
+			text := nodes.CreateLiteralExpressionNode(prs.consume(lexer.StringToken))
+			prs.track(prs.Index-1, text)
+			parts = append(parts, text)
+			continue
+		}
+
+		// { expression }
+		prs.consume(lexer.OpenBraceToken)
+		parts = append(parts, prs.parseExpression())
+		prs.consume(lexer.CloseBraceToken)
+
+		// if we got stuck somewhere, just keep moving
+		if startToken == prs.current() {
+			prs.Index++
+		}
 	}
+
+	end := prs.consume(lexer.InterpolatedStringEndToken)
+
+	node := nodes.CreateInterpolatedStringExpressionNode(start, parts, end)
+	prs.track(begin, node)
+	return node
 }
 
 // parseNumberLiteral
 func (prs *Parser) parseNumberLiteral() nodes.LiteralExpressionNode {
+	begin := prs.Index
+
 	// a number token can be found in the lexer in lexer.getNumber()
 	num := prs.consume(lexer.NumberToken)
-	return nodes.CreateLiteralExpressionNode(num)
+	node := nodes.CreateLiteralExpressionNode(num)
+	prs.track(begin, node)
+	return node
 }
 
 // parseBoolLiteral it's *literally* just a bool (true or false)
 // Example: true, false
 func (prs *Parser) parseBoolLiteral() nodes.LiteralExpressionNode {
+	begin := prs.Index
+
 	_bool := prs.consume(prs.current().Kind)
-	return nodes.CreateLiteralExpressionNode(_bool)
+	node := nodes.CreateLiteralExpressionNode(_bool)
+	prs.track(begin, node)
+	return node
 }
 
 // </EXPRESSIONS> -------------------------------------------------------------
@@ -0,0 +1,148 @@
+package parser
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// error recovery
+// instead of giving up on the first syntax error, the parser complains, makes up whatever was missing and keeps going.
+// if a statement or member ends up in a mess, we skip ahead to the next point where things make sense again
+// (a semicolon, a closing brace or the next keyword that starts a statement or member)
+// that way one run can report every independent error in a file and the binder still gets a tree to work with
+
+// unexpected reports the current token as unexpected
+// if we already complained about this token we keep quiet, one error per token is plenty
+func (prs *Parser) unexpected(message string, args ...interface{}) {
+	if prs.LastError == prs.Index+1 {
+		return
+	}
+
+	prs.LastError = prs.Index + 1
+	prs.Errors++
+
+	// Added this additionalInfo section to help users know why a parser error occurred
+	additionalInfo := ""
+	if prs.current().Kind == lexer.BadToken {
+		additionalInfo = " (may be caused by previous \"UnexpectedCharacterError\" which produces a BadToken)"
+	}
+
+	print.Error(
+		"PARSER",
+		print.UnexpectedTokenError,
+		prs.current().Span,
+		message+additionalInfo,
+		args...,
+	)
+}
+
+// missing makes up a token that should have been here but wasn't
+// it's empty and sits right behind the previous token
+func (prs *Parser) missing(kind lexer.TokenKind) lexer.Token {
+	span := prs.current().Span
+	span.EndIndex = span.StartIndex
+	span.EndLine = span.StartLine
+	span.EndColumn = span.StartColumn
+
+	if prs.Index > 0 {
+		previous := prs.peek(-1).Span
+		span = print.TextSpan{
+			File:        previous.File,
+			StartIndex:  previous.EndIndex,
+			EndIndex:    previous.EndIndex,
+			StartLine:   previous.EndLine,
+			StartColumn: previous.EndColumn,
+			EndLine:     previous.EndLine,
+			EndColumn:   previous.EndColumn,
+		}
+	}
+
+	return lexer.Token{
+		Kind:    kind,
+		Span:    span,
+		Missing: true,
+	}
+}
+
+// recovering checks if the thing we just parsed had an error right at its end (which means we're probably lost)
+func (prs *Parser) recovering(errors int) bool {
+	return prs.Errors != errors && prs.LastError >= prs.Index
+}
+
+// synchronize skips ahead to a point where it makes sense to start parsing again
+// that's in front of a semicolon, a closing brace or anything that starts a new statement or member
+// (whole { ... } blocks get skipped, so we dont stop at the wrong closing brace)
+func (prs *Parser) synchronize() {
+	depth := 0
+
+	for {
+		kind := prs.current().Kind
+
+		switch {
+		case kind == lexer.EOF:
+			return
+		case kind == lexer.OpenBraceToken:
+			depth++
+		case kind == lexer.CloseBraceToken:
+			if depth == 0 {
+				return
+			}
+			depth--
+		case depth == 0 && (kind == lexer.Semicolon || isStatementKeyword(kind) || isMemberKeyword(kind)):
+			return
+		}
+
+		prs.Index++
+	}
+}
+
+// recoverMember gets us back on track after a member that ended in a mess
+// (semicolons dont belong to anything at this level, so they're skipped too)
+func (prs *Parser) recoverMember(errors int) {
+	if !prs.recovering(errors) {
+		return
+	}
+
+	prs.synchronize()
+	if prs.current().Kind == lexer.Semicolon {
+		prs.Index++
+	}
+}
+
+// isSynchronizing checks if a token is something we might want to synchronize on
+// (so it's not just garbage we can step over)
+func isSynchronizing(kind lexer.TokenKind) bool {
+	switch kind {
+	case lexer.EOF, lexer.Semicolon, lexer.CommaToken, lexer.ColonToken,
+		lexer.OpenBraceToken, lexer.CloseBraceToken, lexer.CloseParenthesisToken, lexer.CloseBracketToken,
+		lexer.InterpolatedStringEndToken,
+		lexer.ElseKeyword, lexer.CatchKeyword, lexer.FinallyKeyword, lexer.CaseKeyword, lexer.DefaultKeyword,
+		lexer.InKeyword, lexer.ToKeyword:
+		return true
+	}
+
+	return isStatementKeyword(kind) || isMemberKeyword(kind)
+}
+
+// isStatementKeyword checks if a keyword starts a statement (and can't show up in the middle of an expression)
+func isStatementKeyword(kind lexer.TokenKind) bool {
+	switch kind {
+	case lexer.VarKeyword, lexer.SetKeyword, lexer.IfKeyword, lexer.ReturnKeyword, lexer.ForKeyword,
+		lexer.WhileKeyword, lexer.BreakKeyword, lexer.ContinueKeyword, lexer.FromKeyword, lexer.ForEachKeyword,
+		lexer.TryKeyword, lexer.ThrowKeyword, lexer.SwitchKeyword:
+		return true
+	}
+
+	return false
+}
+
+// isMemberKeyword checks if a keyword starts a member (function, class, package reference, ...)
+func isMemberKeyword(kind lexer.TokenKind) bool {
+	switch kind {
+	case lexer.FunctionKeyword, lexer.ExternalKeyword, lexer.ClassKeyword, lexer.InterfaceKeyword,
+		lexer.StructKeyword, lexer.EnumKeyword, lexer.PackageKeyword, lexer.AliasKeyword, lexer.UseKeyword:
+		return true
+	}
+
+	return false
+}
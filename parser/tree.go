@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"sort"
+)
+
+// NodeRange remembers which tokens a node was parsed from (Start up to, but not including, End)
+type NodeRange struct {
+	Start int
+	End   int
+	Node  nodes.SyntaxNode
+}
+
+// ParseConcrete parses a compilation just like Parse, but also gives back the concrete syntax tree for it
+// (which has every single token and all the whitespace and comments around them)
+func ParseConcrete(tokens []lexer.Token) ([]nodes.MemberNode, *nodes.ConcreteNode) {
+	parser := Parser{
+		Tokens: tokens,
+		Index:  0,
+	}
+
+	members := parser.parseMembers()
+	return members, parser.buildConcreteTree()
+}
+
+// buildConcreteTree nests all the node ranges we collected into a tree
+func (prs *Parser) buildConcreteTree() *nodes.ConcreteNode {
+	// outer nodes first, if two nodes have the exact same tokens the one that was finished last is the outer one
+	ranges := prs.Ranges
+	indexes := make([]int, len(ranges))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := ranges[indexes[i]], ranges[indexes[j]]
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		if a.End != b.End {
+			return a.End > b.End
+		}
+
+		return indexes[i] > indexes[j]
+	})
+
+	next := 0
+
+	var build func(kind nodes.NodeType, node nodes.SyntaxNode, start int, end int) *nodes.ConcreteNode
+	build = func(kind nodes.NodeType, node nodes.SyntaxNode, start int, end int) *nodes.ConcreteNode {
+		concrete := &nodes.ConcreteNode{
+			Kind:     kind,
+			Node:     node,
+			Children: make([]nodes.ConcreteChild, 0),
+		}
+
+		for i := start; i < end; {
+			// skip anything that doesn't fit in here properly (shouldn't happen, but better safe than sorry)
+			for next < len(indexes) && (ranges[indexes[next]].Start < i || ranges[indexes[next]].End > end && ranges[indexes[next]].Start == i) {
+				next++
+			}
+
+			// a child node starts here
+			if next < len(indexes) && ranges[indexes[next]].Start == i {
+				child := ranges[indexes[next]]
+				next++
+
+				concrete.Children = append(concrete.Children, nodes.ConcreteChild{
+					Node: build(child.Node.NodeType(), child.Node, child.Start, child.End),
+				})
+
+				i = child.End
+				continue
+			}
+
+			// just a token
+			concrete.Children = append(concrete.Children, nodes.ConcreteChild{Token: &prs.Tokens[i]})
+			i++
+		}
+
+		return concrete
+	}
+
+	return build(nodes.CompilationUnit, nil, 0, len(prs.Tokens))
+}
@@ -26,9 +26,10 @@ type Preprocessor struct {
 }
 
 type PreProcStatement struct {
-	Keyword string
-	Content string
-	Span    print.TextSpan
+	Keyword    string
+	Content    string
+	Span       print.TextSpan
+	TokenIndex int // where the statement's # is in the token list
 }
 
 // <HELPERS> ------------------------------------------------------------------
@@ -55,12 +56,17 @@ func (ppc *Preprocessor) peek(offset int) lexer.Token {
 // </HELPERS> -----------------------------------------------------------------
 
 func Preprocess(filename string, sources *[]string, arguments *[]string) string {
-	// create a preprocessor object
-	preproc := Preprocessor{Sources: sources, Args: arguments}
-
 	// read the files contents
 	code := ReadFile(filename, print.TextSpan{})
-	preproc.Code = string(code)
+	return PreprocessCode(filename, string(code), sources, arguments)
+}
+
+// PreprocessCode does the same as Preprocess, but with code that's already been loaded
+// (the language server keeps open files in memory, the version on disk might be outdated)
+func PreprocessCode(filename string, code string, sources *[]string, arguments *[]string) string {
+	// create a preprocessor object
+	preproc := Preprocessor{Sources: sources, Args: arguments}
+	preproc.Code = code
 	preproc.Filename = filename
 
 	runPreprocessor := true
@@ -86,9 +92,10 @@ func (ppc *Preprocessor) PreprocessString() {
 			// is this a valid preprocessor statement?
 			// following the pattern:
 			// #nameOfStatement("argument string");
+			// (or #nameOfStatement(1234); for statements that just take a number)
 			if ppc.peek(1).Kind == lexer.IdToken &&
 				ppc.peek(2).Kind == lexer.OpenParenthesisToken &&
-				ppc.peek(3).Kind == lexer.StringToken &&
+				(ppc.peek(3).Kind == lexer.StringToken || ppc.peek(3).Kind == lexer.NumberToken) &&
 				ppc.peek(4).Kind == lexer.CloseParenthesisToken {
 
 				span := ppc.current().Span.SpanBetween(ppc.peek(4).Span)
@@ -99,11 +106,17 @@ func (ppc *Preprocessor) PreprocessString() {
 					span = span.SpanBetween(ppc.peek(5).Span)
 				}
 
+				content := ppc.peek(3).Value
+				if ppc.peek(3).Kind == lexer.StringToken {
+					content = ppc.peek(3).RealValue.(string)
+				}
+
 				// create a new preprocessor statement
 				ppc.Statements = append(ppc.Statements, PreProcStatement{
-					Keyword: ppc.peek(1).Value,
-					Content: ppc.peek(3).RealValue.(string),
-					Span:    span,
+					Keyword:    ppc.peek(1).Value,
+					Content:    content,
+					Span:       span,
+					TokenIndex: ppc.Index,
 				})
 			}
 		}
@@ -112,11 +125,29 @@ func (ppc *Preprocessor) PreprocessString() {
 		ppc.Index++
 	}
 
+	// warnings might get turned off, that has to happen before anything could warn us
+	print.ClearWarningSuppressions(ppc.Filename)
+	for _, statement := range ppc.Statements {
+		if statement.Keyword == "nowarn" {
+			ppc.ProcessNoWarnStatement(statement)
+		}
+	}
+
 	// we now have a list of preprocessor statementz!!!!!!!! (mmmmm jes)
 	for _, statement := range ppc.Statements {
 		ppc.ProcessStatement(statement)
 	}
 
+	// the code didn't change, so this was the last round -> time to complain about broken #nowarns
+	// (if we did it earlier, we'd complain once every round)
+	if !ppc.ChangedFile {
+		for _, statement := range ppc.Statements {
+			if statement.Keyword == "nowarn" {
+				ppc.CheckNoWarnStatement(statement)
+			}
+		}
+	}
+
 	// ok we done with the statements, bye bye now
 	ppc.Statements = make([]PreProcStatement, 0)
 	ppc.Index = 0
@@ -173,6 +204,67 @@ func (ppc *Preprocessor) ProcessArgStatement(stmt PreProcStatement) {
 	*ppc.Args = appendedArgs
 }
 
+// ProcessNoWarnStatement turns off warnings in the block the statement is in (or the whole file if it isn't in one)
+// Example: #nowarn("3058, 4053");
+func (ppc *Preprocessor) ProcessNoWarnStatement(stmt PreProcStatement) {
+	codes, _ := print.ParseWarningCodes(stmt.Content)
+	start, end := ppc.EnclosingBlock(stmt.TokenIndex)
+	print.SuppressWarnings(ppc.Filename, start, end, codes)
+}
+
+// CheckNoWarnStatement makes sure a #nowarn only contains warning codes
+func (ppc *Preprocessor) CheckNoWarnStatement(stmt PreProcStatement) {
+	_, invalid := print.ParseWarningCodes(stmt.Content)
+	if invalid != "" {
+		print.Error(
+			"PREPROCESSOR",
+			print.InvalidWarningCodeError,
+			stmt.Span,
+			"\"%s\" is not a warning code! Only warnings can be turned off using #nowarn.",
+			invalid,
+		)
+	}
+}
+
+// EnclosingBlock finds the { ... } around the given token and gives back where it starts and ends
+// if the token isn't inside any block, -1 is returned for both
+func (ppc *Preprocessor) EnclosingBlock(index int) (int, int) {
+	start := -1
+	depth := 0
+
+	// look for the opening brace
+	for i := index - 1; i >= 0; i-- {
+		if ppc.Tokens[i].Kind == lexer.CloseBraceToken {
+			depth++
+		} else if ppc.Tokens[i].Kind == lexer.OpenBraceToken {
+			if depth == 0 {
+				start = ppc.Tokens[i].Span.StartIndex
+				break
+			}
+			depth--
+		}
+	}
+
+	if start < 0 {
+		return -1, -1
+	}
+
+	// and for the closing one (if there is none, the block just goes on until the end of the file)
+	depth = 0
+	for i := index + 1; i < len(ppc.Tokens); i++ {
+		if ppc.Tokens[i].Kind == lexer.OpenBraceToken {
+			depth++
+		} else if ppc.Tokens[i].Kind == lexer.CloseBraceToken {
+			if depth == 0 {
+				return start, ppc.Tokens[i].Span.EndIndex
+			}
+			depth--
+		}
+	}
+
+	return start, len([]rune(ppc.Code))
+}
+
 func (ppc *Preprocessor) ReplaceSpan(text string, span print.TextSpan) {
 	// replace the span with the given text
 	pre := ppc.Code[:span.StartIndex]
@@ -196,7 +288,7 @@ func ReadFile(filename string, errorLocation print.TextSpan) []rune {
 			"file \"%s\" does not exist! Maybe you spelt it wrong?!",
 			filename,
 		)
-		os.Exit(1)
+		print.Crash(1)
 	} else if errors.Is(err, os.ErrPermission) {
 		print.Error(
 			"LEXER",
@@ -205,7 +297,7 @@ func ReadFile(filename string, errorLocation print.TextSpan) []rune {
 			"do not have permissions to open file \"%s\"!",
 			filename,
 		)
-		os.Exit(1)
+		print.Crash(1)
 	} else if err != nil {
 		print.Error(
 			"LEXER",
@@ -214,7 +306,7 @@ func ReadFile(filename string, errorLocation print.TextSpan) []rune {
 			"an unexpected error occurred when reading file \"%s\"!",
 			filename,
 		)
-		os.Exit(1)
+		print.Crash(1)
 	}
 	// destroy all CR in the file
 	contents = []byte(strings.Replace(string(contents), "\r", "", -1))
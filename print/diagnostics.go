@@ -0,0 +1,385 @@
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// machine readable diagnostics
+// instead of printing colorful errors for humans, every error and warning gets collected
+// and written out as a single JSON or SARIF document once compilation is over (for CI, scripts, etc.)
+
+// DiagnosticsFormat is how diagnostics get reported ("" for the usual colored text, "json" or "sarif")
+var DiagnosticsFormat = ""
+
+// DiagnosticsOutput is the file the document gets written to (empty means stdout)
+var DiagnosticsOutput = ""
+
+// DiagnosticsVersion is the compiler version that ends up in the document
+var DiagnosticsVersion = ""
+
+// we only ever want to write one document
+var diagnosticsWritten = false
+
+// SetDiagnosticsFormat switches to one of the diagnostics formats
+func SetDiagnosticsFormat(format string) error {
+	switch format {
+	case "", "text":
+		DiagnosticsFormat = ""
+	case "json", "sarif":
+		DiagnosticsFormat = format
+
+		// no colored errors in between our nice document pls
+		OutputErrorMessages = false
+	default:
+		return fmt.Errorf("unknown diagnostics format \"%s\", expected text, json or sarif", format)
+	}
+
+	return nil
+}
+
+// Diagnostic is a single error or warning, all ready to be turned into JSON
+type Diagnostic struct {
+	Severity    string `json:"severity"`
+	Area        string `json:"area"`
+	Type        string `json:"type"`
+	Code        int    `json:"code"`
+	Message     string `json:"message"`
+	File        string `json:"file"`
+	StartLine   int    `json:"startLine"`
+	StartColumn int    `json:"startColumn"`
+	EndLine     int    `json:"endLine"`
+	EndColumn   int    `json:"endColumn"`
+	Fixes       []Fix  `json:"fixes,omitempty"`
+}
+
+// Fix is a suggested change that would make a diagnostic go away
+type Fix struct {
+	Message     string `json:"message"`
+	Replacement string `json:"replacement"`
+	StartLine   int    `json:"startLine"`
+	StartColumn int    `json:"startColumn"`
+	EndLine     int    `json:"endLine"`
+	EndColumn   int    `json:"endColumn"`
+}
+
+// DiagnosticsDocument is what -diagnostics=json puts out
+type DiagnosticsDocument struct {
+	Tool        string       `json:"tool"`
+	Version     string       `json:"version"`
+	Errors      int          `json:"errors"`
+	Warnings    int          `json:"warnings"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// FormattedMessage gives back the report's message with all its arguments filled in
+func (report ErrorReport) FormattedMessage() string {
+	return fmt.Sprintf(report.Message, report.MessageArgs...)
+}
+
+// CreateDiagnostic turns an error report into a diagnostic
+func CreateDiagnostic(report ErrorReport, severity string) Diagnostic {
+	fixes := make([]Fix, 0, len(report.Suggestions))
+	for _, suggestion := range report.Suggestions {
+		fixes = append(fixes, Fix{
+			Message:     "Replace with \"" + suggestion.Replacement + "\"",
+			Replacement: suggestion.Replacement,
+			StartLine:   suggestion.Span.StartLine,
+			StartColumn: suggestion.Span.StartColumn,
+			EndLine:     suggestion.Span.EndLine,
+			EndColumn:   suggestion.Span.EndColumn,
+		})
+	}
+
+	return Diagnostic{
+		Severity:    severity,
+		Area:        report.Area,
+		Type:        string(report.ErrType),
+		Code:        int(ErrorTypeToCode(report.ErrType)),
+		Message:     report.FormattedMessage(),
+		File:        report.Span.File,
+		StartLine:   report.Span.StartLine,
+		StartColumn: report.Span.StartColumn,
+		EndLine:     report.Span.EndLine,
+		EndColumn:   report.Span.EndColumn,
+		Fixes:       fixes,
+	}
+}
+
+// Diagnostics collects all errors and warnings so far, sorted by file, line and column
+func Diagnostics() []Diagnostic {
+	diagnostics := make([]Diagnostic, 0, len(ErrorList)+len(WarningList))
+
+	for _, report := range ErrorList {
+		diagnostics = append(diagnostics, CreateDiagnostic(report, "error"))
+	}
+
+	for _, report := range WarningList {
+		diagnostics = append(diagnostics, CreateDiagnostic(report, "warning"))
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.StartLine != b.StartLine {
+			return a.StartLine < b.StartLine
+		}
+		if a.StartColumn != b.StartColumn {
+			return a.StartColumn < b.StartColumn
+		}
+
+		// things starting at the same spot shouldn't depend on the order they were reported in
+		if a.EndLine != b.EndLine {
+			return a.EndLine < b.EndLine
+		}
+		if a.EndColumn != b.EndColumn {
+			return a.EndColumn < b.EndColumn
+		}
+		if a.Severity != b.Severity {
+			return a.Severity == "error"
+		}
+		if a.Code != b.Code {
+			return a.Code < b.Code
+		}
+
+		return a.Message < b.Message
+	})
+
+	return diagnostics
+}
+
+// WriteDiagnostics writes the diagnostics document (if we're supposed to and haven't already)
+func WriteDiagnostics() {
+	if DiagnosticsFormat == "" || diagnosticsWritten {
+		return
+	}
+
+	diagnosticsWritten = true
+
+	var document interface{}
+	if DiagnosticsFormat == "sarif" {
+		document = CreateSarifLog()
+	} else {
+		document = DiagnosticsDocument{
+			Tool:        "rgoc",
+			Version:     DiagnosticsVersion,
+			Errors:      len(ErrorList),
+			Warnings:    len(WarningList),
+			Diagnostics: Diagnostics(),
+		}
+	}
+
+	output, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not write diagnostics: %s\n", err.Error())
+		return
+	}
+	output = append(output, '\n')
+
+	if DiagnosticsOutput == "" {
+		os.Stdout.Write(output)
+		return
+	}
+
+	err = os.WriteFile(DiagnosticsOutput, output, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not write diagnostics to '%s': %s\n", DiagnosticsOutput, err.Error())
+	}
+}
+
+// <SARIF> --------------------------------------------------------------------
+// SARIF is the static analysis format GitHub, Azure DevOps & co. understand
+// (only the parts we actually need, the full spec is huge)
+
+type SarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool       SarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	ShortDescription SarifMessage `json:"shortDescription"`
+	Help             SarifMessage `json:"help"`
+}
+
+type SarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations,omitempty"`
+	Fixes     []SarifFix      `json:"fixes,omitempty"`
+}
+
+type SarifFix struct {
+	Description     SarifMessage          `json:"description"`
+	ArtifactChanges []SarifArtifactChange `json:"artifactChanges"`
+}
+
+type SarifArtifactChange struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []SarifReplacement    `json:"replacements"`
+}
+
+type SarifReplacement struct {
+	DeletedRegion   SarifRegion       `json:"deletedRegion"`
+	InsertedContent SarifArtifactText `json:"insertedContent"`
+}
+
+type SarifArtifactText struct {
+	Text string `json:"text"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// CreateSarifLog puts all diagnostics into a SARIF log, every error type becomes a rule
+func CreateSarifLog() SarifLog {
+	rules := make([]SarifRule, 0)
+	ruleIndexes := make(map[string]int)
+	results := make([]SarifResult, 0)
+
+	for _, diagnostic := range Diagnostics() {
+		id := strconv.Itoa(diagnostic.Code)
+
+		index, ok := ruleIndexes[id]
+		if !ok {
+			index = len(rules)
+			ruleIndexes[id] = index
+			rules = append(rules, SarifRule{
+				ID:               id,
+				Name:             diagnostic.Type,
+				ShortDescription: SarifMessage{Text: diagnostic.Type + " (" + diagnostic.Area + ")"},
+				Help:             SarifMessage{Text: "use: rgoc -lookup " + id + ", for more information"},
+			})
+		}
+
+		result := SarifResult{
+			RuleID:    id,
+			RuleIndex: index,
+			Level:     diagnostic.Severity,
+			Message:   SarifMessage{Text: diagnostic.Message},
+		}
+
+		// some errors aren't about any code in particular
+		if diagnostic.File != "" {
+			location := SarifLocation{
+				PhysicalLocation: SarifPhysicalLocation{
+					ArtifactLocation: SarifArtifactLocation{URI: sarifURI(diagnostic.File)},
+				},
+			}
+
+			if diagnostic.StartLine > 0 {
+				location.PhysicalLocation.Region = &SarifRegion{
+					StartLine:   diagnostic.StartLine,
+					StartColumn: diagnostic.StartColumn,
+					EndLine:     diagnostic.EndLine,
+					EndColumn:   diagnostic.EndColumn,
+				}
+			}
+
+			result.Locations = []SarifLocation{location}
+
+			for _, fix := range diagnostic.Fixes {
+				result.Fixes = append(result.Fixes, SarifFix{
+					Description: SarifMessage{Text: fix.Message},
+					ArtifactChanges: []SarifArtifactChange{{
+						ArtifactLocation: SarifArtifactLocation{URI: sarifURI(diagnostic.File)},
+						Replacements: []SarifReplacement{{
+							DeletedRegion: SarifRegion{
+								StartLine:   fix.StartLine,
+								StartColumn: fix.StartColumn,
+								EndLine:     fix.EndLine,
+								EndColumn:   fix.EndColumn,
+							},
+							InsertedContent: SarifArtifactText{Text: fix.Replacement},
+						}},
+					}},
+				})
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return SarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []SarifRun{{
+			Tool: SarifTool{Driver: SarifDriver{
+				Name:           "rgoc",
+				Version:        DiagnosticsVersion,
+				InformationURI: "https://github.com/ReCT-Lang/ReCT-Go-Compiler",
+				Rules:          rules,
+			}},
+			// our columns count characters, not UTF-16 code units
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	}
+}
+
+// sarifURI turns a file path into something SARIF accepts as an uri
+// relative paths stay relative (to wherever the compiler was run from)
+func sarifURI(path string) string {
+	if filepath.IsAbs(path) {
+		uri := filepath.ToSlash(path)
+
+		// windows paths dont start with a slash (C:/...)
+		if uri[0] != '/' {
+			uri = "/" + uri
+		}
+
+		return "file://" + uri
+	}
+
+	return filepath.ToSlash(path)
+}
+
+// </SARIF> -------------------------------------------------------------------
@@ -112,10 +112,14 @@ type ErrorReport struct {
 	Span        TextSpan
 	Message     string
 	MessageArgs []interface{}
+	Suggestions []Suggestion // names that might have been meant instead (if any)
 }
 
 var ErrorList = make([]ErrorReport, 0)
 
+// warnings get remembered too, they just dont stop compilation
+var WarningList = make([]ErrorReport, 0)
+
 // CodeReference stores code for both error lookups and compiler-time error messages.
 // It stores code for error lookups, when compiling it is overwritten with the code to compile.
 var CodeReference []string = []string{
@@ -131,6 +135,11 @@ var SourceFiles = make(map[string]string)
 // Error prints custom error message and code snippet to terminal/console
 // Uses old colour formatting method, will switch to Format() later
 func Error(area string, _type ErrorType, span TextSpan, message string, fargs ...interface{}) {
+	// generics get bound once for every instance, so they'd keep repeating the same errors
+	if IsAlreadyReported(ErrorList, _type, span, message, fargs) {
+		return
+	}
+
 	if OutputErrorMessages {
 		PrintCodeSnippet(span)
 		WriteCF(Cyan, "[%s] ", strings.ToUpper(area))
@@ -147,15 +156,35 @@ func Error(area string, _type ErrorType, span TextSpan, message string, fargs ..
 	}
 
 	// remember this error
-	ErrorList = append(ErrorList, ErrorReport{area, _type, span, message, fargs})
+	ErrorList = append(ErrorList, ErrorReport{area, _type, span, message, fargs, nil})
 }
 
 func CrashIfErrorsFound() {
 	if len(ErrorList) > 0 {
-		os.Exit(-1)
+		Crash(-1)
 	}
 }
 
+// when this is set, crashing panics with a CrashPanic instead of killing the process
+// (the language server needs to survive whatever broken code it gets thrown at it)
+var PanicOnCrash = false
+
+type CrashPanic struct {
+	Code int
+}
+
+// Crash stops compilation for good
+func Crash(code int) {
+	if PanicOnCrash {
+		panic(CrashPanic{code})
+	}
+
+	// if anyone wanted a diagnostics document, this is their last chance to get it
+	WriteDiagnostics()
+
+	os.Exit(code)
+}
+
 // ErrorS basically Error but returns a string instead of printing
 func ErrorS(area string, _type ErrorType, span TextSpan, message string, a ...interface{}) string {
 	output := PrintCodeSnippetS(span)
@@ -177,18 +206,52 @@ func ErrorS(area string, _type ErrorType, span TextSpan, message string, a ...in
 
 // Warning prints custom warning message and code snippet to terminal/console
 func Warning(area string, _type ErrorType, span TextSpan, message string, fargs ...interface{}) {
-	PrintCodeSnippet(span)
-	WriteCF(Cyan, "[%s] ", strings.ToUpper(area))
-	WriteC(DarkCyan, string(_type))
-	WriteCF(DarkYellow, " Warning(%d, %d, %s): ", span.StartLine, span.StartColumn, span.File)
-	WriteCF(Gray, message, fargs...)
-	code := ErrorTypeToCode(_type)
-	WriteC(DarkYellow, "\n[> Error look up code: ")
-	WriteCF(Cyan, "%d", code)
-	WriteC(DarkYellow, " (use: ")
-	WriteC(Yellow, "rgoc -lookup ")
-	WriteCF(Cyan, "%d", code)
-	PrintC(DarkYellow, ", for more information)]\n")
+	// has this one been turned off?
+	if IsWarningSuppressed(ErrorTypeToCode(_type), span) {
+		return
+	}
+
+	// same as with errors, once is enough
+	if IsAlreadyReported(WarningList, _type, span, message, fargs) {
+		return
+	}
+
+	// -Werror
+	if WarningsAsErrors {
+		Error(area, _type, span, message+" (warnings are treated as errors)", fargs...)
+		return
+	}
+
+	if OutputErrorMessages {
+		PrintCodeSnippet(span)
+		WriteCF(Cyan, "[%s] ", strings.ToUpper(area))
+		WriteC(DarkCyan, string(_type))
+		WriteCF(DarkYellow, " Warning(%d, %d, %s): ", span.StartLine, span.StartColumn, span.File)
+		WriteCF(Gray, message, fargs...)
+		code := ErrorTypeToCode(_type)
+		WriteC(DarkYellow, "\n[> Error look up code: ")
+		WriteCF(Cyan, "%d", code)
+		WriteC(DarkYellow, " (use: ")
+		WriteC(Yellow, "rgoc -lookup ")
+		WriteCF(Cyan, "%d", code)
+		PrintC(DarkYellow, ", for more information)]\n")
+	}
+
+	// remember this warning
+	WarningList = append(WarningList, ErrorReport{area, _type, span, message, fargs, nil})
+}
+
+// IsAlreadyReported checks if the exact same thing has already been reported at the exact same spot
+func IsAlreadyReported(reports []ErrorReport, _type ErrorType, span TextSpan, message string, fargs []interface{}) bool {
+	formatted := fmt.Sprintf(message, fargs...)
+
+	for _, report := range reports {
+		if report.ErrType == _type && report.Span == span && report.FormattedMessage() == formatted {
+			return true
+		}
+	}
+
+	return false
 }
 
 // PrintCodeSnippet does what it says on the label, it prints a snippet of the code in CodeReference.
@@ -229,8 +292,12 @@ func PrintCodeSnippet(span TextSpan) {
 	// add the error marker for the start of the borblem
 	WriteC(Red, "v")
 
-	// loins
-	WriteC(Red, strings.Repeat("-", len(errorLines[span.StartLine])-span.StartColumn+1))
+	// loins (until the end of the first line)
+	length := len(errorLines[span.StartLine-1]) - span.StartColumn + 1
+	if length < 0 {
+		length = 0
+	}
+	WriteC(Red, strings.Repeat("-", length))
 
 	// print the lines
 	for i := span.StartLine; i < span.EndLine; i++ {
@@ -307,7 +374,8 @@ func LookUp(code ErrorCode) {
 		fmt.Println(Format("Code: &c%d\n", Gray, code))
 		fmt.Println(Format(data["explanation"]+"\n", Gray))
 		if data["example"] != "" {
-			fmt.Println(Format(data["example"]+"\n", Gray))
+			fmt.Println(Format("&wExample:&w", Gray))
+			PrintC(DarkYellow, data["example"]+"\n")
 		}
 		if data["additional"] != "" {
 			fmt.Println(Format(data["additional"]+"\n", Gray))
@@ -380,6 +448,15 @@ const (
 	UnexpectedNonPointerValueError        = "UnexpectedNonPointerValueError"
 	TooManyStructParametersError          = "TooManyStructParametersError"
 	OutsideThisError                      = "OutsideThisError"
+	IllegalTryStatementError              = "IllegalTryStatementError"
+	IllegalInheritanceError               = "IllegalInheritanceError"
+	IllegalOverrideError                  = "IllegalOverrideError"
+	InterfaceConformanceError             = "InterfaceConformanceError"
+	IllegalGenericDeclarationError        = "IllegalGenericDeclarationError"
+	GenericTypeInferenceError             = "GenericTypeInferenceError"
+	IllegalCaseClauseError                = "IllegalCaseClauseError"
+	NonExhaustiveMatchError               = "NonExhaustiveMatchError"
+	NonExhaustiveSwitchWarning            = "NonExhaustiveSwitchWarning"
 
 	// Emitter Errors
 	UnknownVTableError       = "UnknownVTableError"
@@ -396,6 +473,9 @@ const (
 	UnparsableFingerprintError        = "UnparsableFingerprintError"
 	ImpossibleFunctionProcessingError = "ImpossibleFunctionProcessingError"
 	ImpossibleFieldProcessingError    = "ImpossibleFieldProcessingError"
+
+	// Preprocessor Errors (again)
+	InvalidWarningCodeError = "InvalidWarningCodeError"
 )
 
 // ErrorCode the numerical representation of an Error, this allows it to be "looked up"
@@ -415,17 +495,17 @@ const (
 	FileAlreadyInSourcesWarningCode = iota
 
 	// Lexer ErrorCodes (start at 1000)
-	UnexpectedCharacterErrorCode = iota + 1000 // 1003
-	FileDoesNotExistErrorCode    = iota + 1000 // 1004
-	FilePermissionErrorCode      = iota + 1000 // 1005
-	FileVoidErrorCode            = iota + 1000 // 1006
-	RealValueConversionErrorCode = iota + 1000 // 1007
+	UnexpectedCharacterErrorCode = iota + 1000 // 1004
+	FileDoesNotExistErrorCode    = iota + 1000 // 1005
+	FilePermissionErrorCode      = iota + 1000 // 1006
+	FileVoidErrorCode            = iota + 1000 // 1007
+	RealValueConversionErrorCode = iota + 1000 // 1008
 
 	// Parser ErrorCodes (start at 2000)
 	UnexpectedTokenErrorCode = iota + 2000
 
 	// Binder ErrorCodes (start at 3000) (Chonk warning)
-	DuplicateParameterErrorCode               = iota + 3000 // 3009
+	DuplicateParameterErrorCode               = iota + 3000 // 3010
 	DuplicateFunctionErrorCode                = iota + 3000
 	DuplicateVariableDeclarationErrorCode     = iota + 3000
 	DuplicatePackageImportErrorCode           = iota + 3000
@@ -483,6 +563,24 @@ const (
 	ImpossibleFieldProcessingErrorCode    = iota + 5000
 )
 
+// codes that got added later have fixed numbers, so new ones never move the codes above around
+// (people put these in -nowarn and #nowarn, they need to stay the same)
+const (
+	// Binder ErrorCodes (continuing after OutsideThisErrorCode)
+	IllegalTryStatementErrorCode       ErrorCode = 3050
+	IllegalInheritanceErrorCode                  = 3051
+	IllegalOverrideErrorCode                     = 3052
+	InterfaceConformanceErrorCode                = 3053
+	IllegalGenericDeclarationErrorCode           = 3054
+	GenericTypeInferenceErrorCode                = 3055
+	IllegalCaseClauseErrorCode                   = 3056
+	NonExhaustiveMatchErrorCode                  = 3057
+	NonExhaustiveSwitchWarningCode               = 3058
+
+	// Preprocessor ErrorCodes (start at 6000)
+	InvalidWarningCodeErrorCode = 6000
+)
+
 var ErrorTypeCodeRelations = map[ErrorType]ErrorCode{
 	UnexpectedCharacterError:              UnexpectedCharacterErrorCode,
 	NotImplementedError:                   NotImplementedErrorCode,
@@ -544,6 +642,16 @@ var ErrorTypeCodeRelations = map[ErrorType]ErrorCode{
 	CAdapterCompilationError:              CAdapterCompilationErrorCode,
 	ExternalCAdapterWarning:               ExternalCAdapterWarningCode,
 	OutsideThisError:                      OutsideThisErrorCode,
+	IllegalTryStatementError:              IllegalTryStatementErrorCode,
+	IllegalInheritanceError:               IllegalInheritanceErrorCode,
+	IllegalOverrideError:                  IllegalOverrideErrorCode,
+	InterfaceConformanceError:             InterfaceConformanceErrorCode,
+	IllegalGenericDeclarationError:        IllegalGenericDeclarationErrorCode,
+	GenericTypeInferenceError:             GenericTypeInferenceErrorCode,
+	IllegalCaseClauseError:                IllegalCaseClauseErrorCode,
+	NonExhaustiveMatchError:               NonExhaustiveMatchErrorCode,
+	NonExhaustiveSwitchWarning:            NonExhaustiveSwitchWarningCode,
+	InvalidWarningCodeError:               InvalidWarningCodeErrorCode,
 }
 
 // ErrorTypeToCode https://discord.com/channels/751171532398788720/937451421702455306/943557950260269179
@@ -563,11 +671,12 @@ func ErrorTypeToCode(e ErrorType) ErrorCode {
 //   - "area" 		-> Stores where in the compiler the error is called
 //   - "code" 		-> An integer user can use to "lookup" the error
 //   - "explanation" 	-> An explanation of what the error is/why it occurs.
-//   - "example" 		-> This is generated using ErrorS() and is an example of the error in practice.
+//   - "example" 		-> A bit of code (or a command) that causes the error, printed as is (no colour codes in here!)
 //     -> Some error messages will not have an example as they may not be caused by the code itself.
-//     -> CodeReference stores all example code in a string array. This is wiped at compile time.
-//   - "additional" 	-> This is for additional information, sometimes relating to side effects or further explanation of
-//     -> the example code.
+//   - "additional" 	-> This is for additional information, usually why the error happens and how to fix it,
+//     -> sometimes relating to side effects or further explanation of the example code.
+//
+// every ErrorCode needs an entry in here, the catalogue (rgoc -lookup all) is built from this too
 var errorData = map[ErrorCode]map[string]string{
 	NotImplementedErrorCode: {
 		"name": "NotImplemented",
@@ -582,14 +691,23 @@ Since the feature it not fully developed, it &rwill not&r have a &wspecific erro
 		"area":        "Developer",
 		"explanation": `This error is &drdepreciated&dr. It may be used as an alternative for a &mNotImplemented&m Error, please use: &dyrgoc -lookup &c9000&c, for more information.`,
 		"example":     "",
-		"additional":  "",
+		"additional":  "Use &mNotImplemented&m (&c9000&c) instead.",
 	},
 	NULLErrorCode: {
 		"name":        "NULL",
 		"area":        "Developer",
 		"explanation": "This error is &mNULL&m!",
 		"example":     "",
-		"additional":  "",
+		"additional":  "If you ever get to see this, an error was reported without a known error type. Please let us know on the Discord server!",
+	},
+	FileAlreadyInSourcesWarningCode: {
+		"name": "FileAlreadyInSources Warning",
+		"area": "Preprocessor",
+		"explanation": `This warning occurs when the same file is added to the &wsources list&w more than once using &dy#source&dy.
+The file is only compiled &wonce&w anyway, so the second &dy#source&dy statement &rdoes nothing&r.`,
+		"example": `#source("helper.rct");
+#source("helper.rct"); // helper.rct is already in the list`,
+		"additional": "Remove the duplicate &dy#source&dy statement. If the file is added in multiple places, keeping one of them is enough.",
 	},
 	UnexpectedCharacterErrorCode: {
 		"name": "UnexpectedCharacter",
@@ -597,24 +715,16 @@ Since the feature it not fully developed, it &rwill not&r have a &wspecific erro
 		"explanation": `An &mUnexpectedCharacter&m Error occurs when the &bLexer/scanner&b of the compiler encounters a &wcharacter&w that the &wcompiler&w &rdoes not&r know how to &wprocess&w. 
 Since the compiler does not know how to process this character, it &drcannot proceed&dr and instead outputs an &mUnexpectedCharacter&m Error so the developer 
 of the program can correct the issues and &weither remove or replace&w the &wunexpected character&w.`,
-		//"example": ErrorS(
-		//	"Lexer",
-		//	UnexpectedCharacterError,
-		//	1,
-		//	10,
-		//	5,
-		//	"an unexpected character was found \"%s\"! Lexer is unable to process this character! (BadToken)",
-		//	string(CodeReference[0][15]),
-		//),
+		"example":    "var int x <- 5 $ 3; // '$' isn't part of ReCT",
 		"additional": "This error can cause a &mBadToken&m error in the &bParser&b later on.",
 	},
 	FileDoesNotExistErrorCode: {
 		"name": "FileDoesNotExist",
 		"area": "Lexer",
 		"explanation": `The &wcompiler will check if your file exists&w, and &wif&w it does &drnot&dr the compiler will output this error.
-Usually the cause of this error is entering the &rwrong path&r to the file or a &rtypo&r in the file's name.'`,
-		"example":    "",
-		"additional": "",
+Usually the cause of this error is entering the &rwrong path&r to the file or a &rtypo&r in the file's name.`,
+		"example":    "rgoc -o hello mian.rct # typo, the file is called main.rct",
+		"additional": "Check the path you gave the compiler, relative paths start at the directory you're running &dyrgoc&dy in.",
 	},
 	FilePermissionErrorCode: {
 		"name": "FilePermission",
@@ -622,9 +732,9 @@ Usually the cause of this error is entering the &rwrong path&r to the file or a
 		"explanation": `The compiler will &wtry to open your file&w, and if it cannot it will make a &wseries of checks&w to see &rwhy it can't open your file&r.
 In this case, the &wcompiler found your file&w but the compiler doesn't have the &cpermissions to open the file&c.
 You may need to &wrun the compiler as administrator&w, &wmove the file&w into a different directory (which can update permissions), 
-or directly &wmodify the file's write/read permissions&w.'`,
-		"example":    "",
-		"additional": "",
+or directly &wmodify the file's write/read permissions&w.`,
+		"example":    "rgoc -o hello /root/secret.rct # a file only root is allowed to read",
+		"additional": "On Linux and macOS &dychmod +r <file>&dy gives everyone permission to read the file.",
 	},
 	FileVoidErrorCode: {
 		"name": "FileVoid",
@@ -633,27 +743,27 @@ or directly &wmodify the file's write/read permissions&w.'`,
 series of &wsteps to identify the problem&w, often this leads to a &mFilePermission&m error or a &mFileDoesNotExist&m error.
 However, if the compiler &wcannot diagnose the problem&w, it will output a &mFileVoid&m error. 
 Put simply, &wsomething is wrong with the file&w, and the &ccompiler doesn't know what&c.`,
-		"example":    "",
-		"additional": "",
+		"example":    "rgoc -o hello main.rct # main.rct is a directory or got deleted halfway through",
+		"additional": "Try opening the file in a text editor, if that doesn't work either, the problem is with the file and not with ReCT.",
 	},
 	RealValueConversionErrorCode: {
 		"name": "RealValueConversion",
 		"area": "Lexer",
 		"explanation": `The compiler will try to convert some values like &dyint&dy and &dyfloat&dy into their true values to help down the line.
-This can &wissues if the conversion fails&w. &wYou should check your float and int values for oddities.&w
+This can &wcause issues if the conversion fails&w. &wYou should check your float and int values for oddities.&w
 The most likely cause of this error is &drmultiple points in float literals&dr.`,
-		"example":    "",
-		"additional": "",
+		"example":    "var float f <- 1.2.3; // one point too many",
+		"additional": "Number literals can have at most one decimal point, hex literals (&dy0x...&dy) only allow the digits 0-9 and a-f.",
 	},
 	UnexpectedTokenErrorCode: {
 		"name": "UnexpectedToken",
 		"area": "Parser",
 		"explanation": `An UnexpectedToken error occurs when the compiler is expecting a different value, identifier, keyword, or operator 
 than what was provided. A common cause of this error is the previous occurrence of a &mUnexpectedCharacter&m error. This is because
-&unexpectedCharacter&m errors produce a &mBadToken&m which is then processed by the parser to produce an &mUnexpectedToken&m error.`,
-		"example": "",
+&mUnexpectedCharacter&m errors produce a &mBadToken&m which is then processed by the parser to produce an &mUnexpectedToken&m error.`,
+		"example": "var int x <- (5 + 3; // the closing parenthesis is missing",
 		"additional": `Another common cause of an &mUnexpectedToken&m error is a value, identifier, keyword, or operator appearing
-where it shouldn't.'`,
+where it shouldn't.`,
 	},
 	DuplicateParameterErrorCode: {
 		"name": "DuplicateParameter",
@@ -661,8 +771,10 @@ where it shouldn't.'`,
 		"explanation": `This error is caused by multiple of the same parameter declared in a single function declaration.
 In order to fix this, you need to remove the duplicate parameter or rename one of the parameters so they
 appear to be different.`,
-		"example":    "",
-		"additional": "",
+		"example": `function Add(a int, a int) int {
+    return a + a;
+}`,
+		"additional": "Every parameter needs its own name, otherwise there would be no way of telling them apart inside the function.",
 	},
 	DuplicateFunctionErrorCode: {
 		"name": "DuplicateFunction",
@@ -670,17 +782,29 @@ appear to be different.`,
 		"explanation": `This error occurs when the compiler detects multiple functions of the same name are being defined.
 The compiler will always detect the second declaration as it can only check the function with previously processed
 function symbols. In order to fix this issue, the user needs to change the name of one of the functions.`,
-		"example":    "",
-		"additional": "",
+		"example": `function Greet() {}
+function Greet() {} // Greet already exists`,
+		"additional": "ReCT doesn't support function overloading, so every function needs a unique name.",
 	},
 	DuplicateVariableDeclarationErrorCode: {
 		"name": "DuplicateVariableDeclaration",
 		"area": "Binder",
-		"explanation": `Similar to &mDuplicantFunction&m and &mDuplicantParameter&m errors, &DuplicantVariableDeclaration&m
+		"explanation": `Similar to &mDuplicateFunction&m and &mDuplicateParameter&m errors, &mDuplicateVariableDeclaration&m
 error occurs when two variables of the same name are defined within the same or parent-to-child scope.
 In order to fix this, the user needs to change the name or remove one of the variable declarations.`,
-		"example":    "",
-		"additional": "",
+		"example": `var int count <- 1;
+var int count <- 2; // count has already been declared`,
+		"additional": "If you wanted to change the existing variable, use an assignment instead: &dycount <- 2;&dy",
+	},
+	DuplicatePackageImportErrorCode: {
+		"name": "DuplicatePackageImportError",
+		"area": "Binder",
+		"explanation": `This error occurs when a package (or an alias of one) is loaded under a name that's &ralready taken&r.
+A package can only be loaded once, and an alias can't have the same name as another package or alias.
+Creating an alias of an alias isn't allowed either.`,
+		"example": `package sys;
+package sys; // sys has already been loaded`,
+		"additional": "Remove the duplicate &dypackage&dy statement, or pick a different name for the alias.",
 	},
 	UndefinedVariableReferenceErrorCode: {
 		"name": "UndefinedVariableReference",
@@ -688,8 +812,9 @@ In order to fix this, the user needs to change the name or remove one of the var
 		"explanation": `The compiler must have previous record of a variable's existence to ensure that the variable
 the user is referencing exists. If the variable does not exist the compile will produce this error.
 The variable you are trying to reference may have a typo in the name or be declared in a scope that compiler is not considering.`,
-		"example":    "",
-		"additional": "",
+		"example": `var int count <- 5;
+var int doubled <- cuont * 2; // typo`,
+		"additional": "If the variable is a global used inside of a function, make sure to declare it &wbefore&w the function uses it or access it through &dymain->&dy.",
 	},
 	TypeFunctionDoesNotExistErrorCode: {
 		"name": "TypeFunctionDoesNotExist",
@@ -697,16 +822,22 @@ The variable you are trying to reference may have a typo in the name or be decla
 		"explanation": `A type function that was referenced in the code doesn't exist!
 A type function is a function accessed through a variable of a specific type such as GetLength() on a string variable.
 This error occurs when the function in question doesn't exist for that datatype. `,
-		"example":    "",
-		"additional": "",
+		"example": `class Counter {
+    set int Value;
+    function Constructor() {}
+}
+
+var Counter c <- make Counter();
+c->Reset(); // Counter doesn't have a Reset() function`,
+		"additional": "Check the spelling of the function and the type you're calling it on, only public (&dyset&dy) functions can be called from outside of a class.",
 	},
 	ConversionErrorCode: {
 		"name": "Conversion",
 		"area": "Binder",
 		"explanation": `This error occurs when a program attempts to convert from one type to another type but the conversion
 doesn't exist. This means the compiler doesn't know how to convert between the types and therefore returns and error.`,
-		"example":    "",
-		"additional": "",
+		"example":    "var int count <- make int array(3); // an array can't become an int",
+		"additional": "Make sure the value you're assigning, passing or returning actually has the type that's expected.",
 	},
 	ExplicitConversionErrorCode: {
 		"name": "ExplicitConversion",
@@ -714,8 +845,9 @@ doesn't exist. This means the compiler doesn't know how to convert between the t
 		"explanation": `Similar to &mConversion&m error, this error occurs when the program tries to convert from one type
 to another but does not know how. However, in this case, you can write an explicit type cast which allows the compiler to 
 understand which type to convert to.`,
-		"example":    "",
-		"additional": "",
+		"example": `var string text <- "42";
+var int number <- text; // needs to be int(text)`,
+		"additional": "Explicit conversions can fail at runtime (not every string is a number), which is why the compiler wants you to write them out: &dyint(text)&dy",
 	},
 	UnexpectedExpressionStatementErrorCode: {
 		"name": "UnexpectedExpressionStatement",
@@ -723,16 +855,17 @@ understand which type to convert to.`,
 		"explanation": `&mUnexpectedExpressionStatement&m error occurs when an expression other than call or assignment
 is used as a statement. Only specific expressions are allowed to be used as statements such as 
 function calls and variable assignments.`,
-		"example":    "",
-		"additional": "",
+		"example": `var int x <- 5;
+x + 1; // the result isn't used for anything`,
+		"additional": "An expression like this doesn't do anything on its own, you probably meant to assign it somewhere: &dyx <- x + 1;&dy",
 	},
 	OutsideReturnErrorCode: {
 		"name": "OutsideReturn",
 		"area": "Binder",
 		"explanation": `This error occurs when a return statement is used outside of a function.
 to fix this, you will need to remove the return statement. Maybe you put it in the wrong scope?`,
-		"example":    "",
-		"additional": "",
+		"example":    "return 5; // not inside of any function",
+		"additional": "Code in the global scope just runs from top to bottom, if you want to stop early, move the code into a function and &dyreturn&dy from there.",
 	},
 	VoidReturnErrorCode: {
 		"name": "VoidReturn",
@@ -740,8 +873,10 @@ to fix this, you will need to remove the return statement. Maybe you put it in t
 		"explanation": `This error occurs when a return statement is used inside of a void function.
 A void function cannot return any value and therefore a return statement is not allowed.
 Similar to &mOutsideReturn&m error, you will need to remove the return statement.`,
-		"example":    "",
-		"additional": "",
+		"example": `function Log(message string) {
+    return message; // Log doesn't have a return type
+}`,
+		"additional": "Either remove the value (&dyreturn;&dy works fine in void functions) or give the function a return type.",
 	},
 	OutsideBreakErrorCode: {
 		"name": "OutsideBreak",
@@ -749,9 +884,12 @@ Similar to &mOutsideReturn&m error, you will need to remove the return statement
 		"explanation": `This error occurs when a break statement is used outside of a loop.
 A break statement cannot be used outside of a loop; the compiler does not know how to 
 manage a break statement if it is not inside a loop.
-You must remove the break statement. Maybe it is in the wrong scope?'`,
-		"example":    "",
-		"additional": "",
+You must remove the break statement. Maybe it is in the wrong scope?`,
+		"example": `var int x <- 5;
+if (x > 3) {
+    break; // an if statement is not a loop
+}`,
+		"additional": "&dybreak&dy can only be used inside of &dywhile&dy, &dyfor&dy, &dyfrom&dy and &dyforeach&dy loops.",
 	},
 	UnexpectedNonIntegerValueErrorCode: {
 		"name": "UnexpectedNonIntegerValue",
@@ -759,8 +897,10 @@ You must remove the break statement. Maybe it is in the wrong scope?'`,
 		"explanation": `This error occurs when the compiler is expecting an integer value (literal, or expression),
 but instead finds a different type. To fix this, you must remove the non-integer value and replace it with
 and integer value.`,
-		"example":    "",
-		"additional": "",
+		"example": `from (i <- 0) to "ten" {
+    // ...
+}`,
+		"additional": "Both bounds of a &dyfrom&dy loop and the values of enum fields have to be integers.",
 	},
 	OutsideContinueErrorCode: {
 		"name": "OutsideContinue",
@@ -768,16 +908,19 @@ and integer value.`,
 		"explanation": `This error occurs when a continue statement is used outside of a loop.
 A continue statement cannot be used outside of a loop as it's functionality is to do with the 
 loop it is contained within. To fix this, you must remove the continue statement or place it inside a loop.`,
-		"example":    "",
-		"additional": "",
+		"example": `var int x <- 5;
+if (x > 3) {
+    continue; // an if statement is not a loop
+}`,
+		"additional": "&dycontinue&dy can only be used inside of &dywhile&dy, &dyfor&dy, &dyfrom&dy and &dyforeach&dy loops.",
 	},
 	BinaryOperatorTypeErrorCode: {
 		"name": "BinaryOperatorType",
 		"area": "Binder",
 		"explanation": `This error occurs when the user attempts to use a binary operator between two types the
 compiler does not know how to use the binary operator with.`,
-		"example":    "",
-		"additional": "",
+		"example":    "var bool b <- true - 1; // you can't subtract from a bool",
+		"additional": "Convert one of the values first so both sides have types the operator works with.",
 	},
 	IncorrectTypeFunctionCallErrorCode: {
 		"name": "IncorrectTypeFunctionCall",
@@ -787,26 +930,35 @@ An example of a type function would be GetLength on a string datatype.
 This error occurs when a type function is used on a datatype that doesn't have access to that 
 type function. The compiler does not know how to use the type function on that datatype and 
 therefore, the compiler error.`,
-		"example":    "",
-		"additional": "",
+		"example": `var int number <- 5;
+var int length <- number->GetLength(); // only strings and arrays have a length`,
+		"additional": "Check which type the value actually has, you might need to convert it first: &dystring(number)->GetLength()&dy",
 	},
 	BadNumberOfParametersErrorCode: {
 		"name": "BadNumberOfParameters",
 		"area": "Binder",
 		"explanation": `A function call expects a certain number of arguments but too many or too little
 arguments are provided. The function can only be ran if it has the correct number of argument it expects.`,
-		"example":    "",
-		"additional": "",
+		"example": `function Add(a int, b int) int {
+    return a + b;
+}
+
+var int sum <- Add(1); // Add needs two arguments`,
+		"additional": "Look at the function's declaration to see which arguments it expects.",
 	},
 	UndefinedFunctionCallErrorCode: {
 		"name": "UndefinedFunctionCall",
 		"area": "Binder",
 		"explanation": `This error occurs when the user tries to use a function call that does not exist.
-This error is similar to &mTypeFunctionDoesNotExit&m and &mUndefinedVariableReference&m as in both cases the 
+This error is similar to &mTypeFunctionDoesNotExist&m and &mUndefinedVariableReference&m as in both cases the 
 user is trying to access language constructs that don't exist.
-Usually this error is caused by a typo in the function call name.'`,
-		"example":    "",
-		"additional": "",
+Usually this error is caused by a typo in the function call name.`,
+		"example": `function Add(a int, b int) int {
+    return a + b;
+}
+
+var int sum <- Ad(1, 2); // typo`,
+		"additional": "Functions from packages need the package prefix (&dysys::Print()&dy) unless the package has been brought in with &dyuse&dy.",
 	},
 	UnaryOperatorTypeErrorCode: {
 		"name": "UnaryOperatorType",
@@ -814,8 +966,17 @@ Usually this error is caused by a typo in the function call name.'`,
 		"explanation": `Similar to &mBinaryOperatorType&m error, &mUnaryOperatorType&m error occurs when 
 the user tries to use the unary operator with two types that the compiler does not now how to process with
 a particular unary operator.`,
-		"example":    "",
-		"additional": "",
+		"example":    "var string text <- -\"hello\"; // strings can't be negative",
+		"additional": "&dy-&dy and &dy+&dy only work on numbers, &dy!&dy only works on bools.",
+	},
+	UnknownDataTypeErrorCode: {
+		"name": "UnknownDataType",
+		"area": "Binder",
+		"explanation": `This error occurs when the compiler comes across a &wdatatype&w it &rdoesn't know&r.
+A datatype can be one of the built-in types (&dyint&dy, &dystring&dy, &dyarray&dy, ...), a class, a struct, an interface, an enum
+or a class from a package. If the name isn't any of those, the compiler can't know what kind of value you want.`,
+		"example":    "var strng name <- \"ReCT\"; // typo, should be string",
+		"additional": "Check the spelling, and make sure classes from packages are written with their package prefix (&dypkg::Class&dy) or the package has been brought in with &dyuse&dy.",
 	},
 	UnknownStatementErrorCode: {
 		"name": "UnknownStatement",
@@ -823,6 +984,452 @@ a particular unary operator.`,
 		"explanation": `This error occurs when a statement is found that should not exist. The compiler checks through all
 the possible statement types (like ifStatement, whileStatement, etc) and the one it found does not match any that exist.`,
 		"example":    "",
-		"additional": "",
+		"additional": "This should never happen with code the parser accepted. Please file a bug report with the code that caused it!",
+	},
+	IllegalVariableDeclarationErrorCode: {
+		"name": "IllegalVariableDeclarationError",
+		"area": "Binder",
+		"explanation": `This error occurs when a variable is declared &rwithout a type&r and &rwithout an initial value&r.
+The compiler figures out the type of a variable either from the type you give it or from the value it starts out with,
+with neither of them there is &wno way of knowing&w what the variable is supposed to be.`,
+		"example":    "var x; // what is x?",
+		"additional": "Give the variable a type (&dyvar int x;&dy), a value (&dyvar x <- 5;&dy) or both.",
+	},
+	IllegalFunctionSignatureErrorCode: {
+		"name": "IllegalFunctionSignatureError",
+		"area": "Binder",
+		"explanation": `This error occurs when a function is declared in a way that's &rreserved&r for the compiler.
+The name &dymain&dy is used for the global scope of the program, so no function can be called that,
+and a class' &dyConstructor&dy can't be made public (&dyset&dy) since it's only ever called through &dymake&dy.`,
+		"example": `class Counter {
+    set int Value;
+    set function Constructor() {} // constructors can't be public
+}`,
+		"additional": "Rename the function or remove the &dyset&dy keyword from the constructor.",
+	},
+	IllegalNestedClassesErrorCode: {
+		"name": "IllegalNestedClassesError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class is declared &rinside of another class&r. 
+ReCT only allows classes in the &wglobal scope&w of a file.`,
+		"example": `class Outer {
+    class Inner { // not allowed
+    }
+}`,
+		"additional": "Move the inner class out into the global scope. (The parser usually complains about this first with an &mUnexpectedToken&m error.)",
+	},
+	InvalidStatementPlacementErrorCode: {
+		"name": "InvalidStatementPlacementError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class' global scope (everything outside of its functions) contains something &rother than field declarations&r.
+Only fields declared with &dyset&dy are allowed there, any code that should run when an object is created belongs in the &wConstructor&w.`,
+		"example": `class Counter {
+    set int Value;
+    Value <- 5; // code outside of a function
+}`,
+		"additional": "Move the code into the constructor, or give the field an initial value right away: &dyset int Value <- 5;&dy",
+	},
+	OutsideConstructorCallErrorCode: {
+		"name": "OutsideConstructorCallError",
+		"area": "Binder",
+		"explanation": `This error occurs when an object is created with &dymake&dy in a class' &wglobal scope&w (usually in the initial value of a field).
+When fields get bound, the constructors of the other classes &rdon't exist yet&r, so the compiler can't create objects there.`,
+		"example": `class Counter {
+    function Constructor() {}
+}
+
+class Wrapper {
+    set Counter Inner <- make Counter(); // not allowed here
+    function Constructor() {}
+}`,
+		"additional": "Create the object in the constructor instead: &dyInner <- make Counter();&dy",
+	},
+	InvalidClassAccessErrorCode: {
+		"name": "InvalidClassAccessError",
+		"area": "Binder",
+		"explanation": `This error occurs when the arrow operator (&dy->&dy) is used to access a &wfield&w of something that &rdoesn't have fields&r,
+like a number or a bool, or when an enum doesn't have the field that's being accessed.`,
+		"example": `var int number <- 5;
+var int x <- number->Value; // ints don't have fields`,
+		"additional": "Only objects of classes and structs have fields. Check the type of the value on the left of the arrow.",
+	},
+	IllegalConstructorCallErrorCode: {
+		"name": "IllegalConstructorCallError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class calls its &wown constructor&w (or &dybase()&dy is used in the wrong place).
+The constructor is only called once, when the object is created with &dymake&dy, calling it again could &rbreak the object&r.
+&dybase(...)&dy is only allowed inside of the constructor of a class that inherits from another class.`,
+		"example": `class Counter {
+    set int Value;
+    function Constructor() {
+        Value <- 0;
+    }
+    set function Reset() {
+        Constructor(); // not allowed
+    }
+}`,
+		"additional": "Move the setup code into its own function and call that from both the constructor and wherever else you need it.",
+	},
+	TernaryOperatorTypeErrorCode: {
+		"name": "TernaryOperatorTypeError",
+		"area": "Binder",
+		"explanation": `This error occurs when a ternary expression (&dycondition ? a : b&dy) is used incorrectly.
+The condition needs to be a &dybool&dy, and both sides need to have the &wsame type&w so the result has a type.`,
+		"example": `var int x <- 5;
+var string text <- x ? "yes" : "no"; // x is not a bool`,
+		"additional": "Compare the value to something to get a bool (&dyx > 0 ? \"yes\" : \"no\"&dy) and make sure both results have the same type.",
+	},
+	UnknownClassErrorCode: {
+		"name": "UnknownClassError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class that's being used &rcan't be found&r.
+Classes need to be declared somewhere in your code or come from a package that has been loaded.`,
+		"example":    "var c <- make Countr(); // no class called Countr",
+		"additional": "Check the spelling of the class name, and whether the package it comes from has been loaded with &dypackage&dy.",
+	},
+	FunctionAccessViolationErrorCode: {
+		"name": "FunctionAccessViolationError",
+		"area": "Binder",
+		"explanation": `This error occurs when something is used from a place it &rcan't be accessed&r from.
+Functions of a class are private unless they're declared with &dyset&dy, and functions/variables of the main program
+that are accessed with &dymain->&dy need to be global.`,
+		"example": `class Counter {
+    set int Value;
+    function Constructor() {}
+    function Bump() { // private, Bump needs "set" to be called from outside
+        Value <- Value + 1;
+    }
+}
+
+var Counter c <- make Counter();
+c->Bump();`,
+		"additional": "Make the function public by adding &dyset&dy in front of it, or declare the variable globally.",
+	},
+	UnknownFieldErrorCode: {
+		"name":        "UnknownFieldError",
+		"area":        "Binder",
+		"explanation": `This error occurs when a field of a class or struct is accessed that &rdoesn't exist&r.`,
+		"example": `class Counter {
+    set int Value;
+    function Constructor() {}
+}
+
+var Counter c <- make Counter();
+var int v <- c->Valeu; // typo`,
+		"additional": "Check the spelling of the field and the declaration of the class or struct.",
+	},
+	InvalidNumberOfSubtypesErrorCode: {
+		"name": "InvalidNumberOfSubtypesError",
+		"area": "Binder",
+		"explanation": `This error occurs when a built-in datatype is given the &rwrong number of subtypes&r.
+&dyarray&dy and &dypointer&dy take exactly one, &dymap&dy takes two (key and value) and &dyaction&dy needs at least one.`,
+		"example":    "var map[string] ages <- make map[string](); // what are the values?",
+		"additional": "Add the missing subtypes (or remove the extra ones): &dymap[string, int]&dy",
+	},
+	UnknownPackageErrorCode: {
+		"name": "UnknownPackageError",
+		"area": "Binder",
+		"explanation": `This error occurs when a package is used that &rhasn't been loaded&r (or can't be found at all).
+Packages have to be loaded with &dypackage&dy before anything from them can be used.`,
+		"example":    "sys::Print(\"hello\"); // \"package sys;\" is missing",
+		"additional": "Add &dypackage <name>;&dy to the top of your file. If the package isn't one of the built-in ones, check the package include path (&dy-pi&dy).",
+	},
+	UnexpectedNonArrayValueErrorCode: {
+		"name":        "UnexpectedNonArrayValueError",
+		"area":        "Binder",
+		"explanation": `This error occurs when something that &risn't an array&r is indexed (&dyvalue[0]&dy) or looped over with &dyforeach&dy.`,
+		"example": `var int number <- 5;
+var int first <- number[0]; // ints can't be indexed`,
+		"additional": "Only arrays, pointers and (for &dyforeach&dy) strings and maps can be used like this.",
+	},
+	InvalidExternalFunctionPlacementErrorCode: {
+		"name": "InvalidExternalFunctionPlacementError",
+		"area": "Binder",
+		"explanation": `This error occurs when an &dyexternal&dy function is declared &rinside of a class&r.
+External functions come from C code that gets linked in, they can only be declared in the &wglobal scope&w.`,
+		"example": `class Console {
+    external puts(text pointer[byte]) int; // not allowed in here
+    function Constructor() {}
+}`,
+		"additional": "Move the external function declaration out of the class.",
+	},
+	UnexpectedNonPointerValueErrorCode: {
+		"name": "UnexpectedNonPointerValueError",
+		"area": "Binder",
+		"explanation": `This error occurs when &dyderef&dy is used on a value that &risn't a pointer&r.
+Only pointers point at something that could be dereferenced.`,
+		"example": `var int number <- 5;
+var int value <- deref number; // number isn't a pointer`,
+		"additional": "Only use &dyderef&dy on values of type &dypointer[...]&dy.",
+	},
+	UnknownStructErrorCode: {
+		"name": "UnknownStructError",
+		"area": "Binder",
+		"explanation": `This error occurs when a struct that's being used &rcan't be found&r while looking it up internally.
+Usually an unknown struct name gets reported as an &mUnknownDataType&m error first.`,
+		"example":    "",
+		"additional": "If this shows up on its own, something went wrong while loading your code or a package. Please file a bug report!",
+	},
+	TooManyStructParametersErrorCode: {
+		"name":        "TooManyStructParametersError",
+		"area":        "Binder",
+		"explanation": `This error occurs when a struct is created with &rmore values than it has fields&r.`,
+		"example": `struct Point {
+    x int,
+    y int
+}
+
+var Point p <- make Point { 1, 2, 3 }; // Point only has two fields`,
+		"additional": "Remove the extra values. Values are assigned to the fields in the order they are declared in.",
+	},
+	OutsideThisErrorCode: {
+		"name": "OutsideThisError",
+		"area": "Binder",
+		"explanation": `This error occurs when &dythis&dy is used &routside of a class&r.
+&dythis&dy refers to the object a class function is running on, outside of a class there is no such object.`,
+		"example": `function Reset() {
+    this->Value <- 0; // Reset isn't part of a class
+}`,
+		"additional": "Move the function into a class, or pass the object you want to use as a parameter.",
+	},
+	IllegalTryStatementErrorCode: {
+		"name": "IllegalTryStatementError",
+		"area": "Binder",
+		"explanation": `This error occurs when a &dytry&dy statement has &rneither&r a &dycatch&dy nor a &dyfinally&dy clause.
+Without one of them, there is nothing to do when an exception is thrown.`,
+		"example": `try {
+    var int x <- 5;
+} // no catch or finally`,
+		"additional": "Add a &dycatch (e) { ... }&dy to handle the exception, a &dyfinally { ... }&dy for cleanup, or both.",
+	},
+	IllegalInheritanceErrorCode: {
+		"name": "IllegalInheritanceError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class inherits from another class in a way that &risn't allowed&r.
+A class can only have &wone base class&w, can't inherit from itself (or create a cycle), can't inherit from classes in packages,
+and its constructor needs to start with a &dybase(...)&dy call if the base class' constructor takes arguments.`,
+		"example": `class Animal : Animal { // a class can't inherit from itself
+    function Constructor() {}
+}`,
+		"additional": "Check the &dy: Base&dy part of the class declaration and make sure the constructor calls &dybase(...)&dy first.",
+	},
+	IllegalOverrideErrorCode: {
+		"name": "IllegalOverrideError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class overrides a function of its base class with a &rdifferent signature&r.
+An override needs the &wsame parameters and return type&w, otherwise calls through the base class wouldn't work.`,
+		"example": `class Animal {
+    function Constructor() {}
+    set function Speak() string {
+        return "...";
+    }
+}
+
+class Dog : Animal {
+    function Constructor() {
+        base();
+    }
+    set function Speak() int { // Speak returns a string in Animal
+        return 1;
+    }
+}`,
+		"additional": "Change the signature to match the base class, or give the function a different name if it's not meant to be an override.",
+	},
+	InterfaceConformanceErrorCode: {
+		"name": "InterfaceConformanceError",
+		"area": "Binder",
+		"explanation": `This error occurs when a class says it implements an interface but &rdoesn't have all of its functions&r
+(or has them with the wrong signature, or doesn't make them public).`,
+		"example": `interface Shape {
+    function Area() int;
+}
+
+class Square : Shape { // Area() is missing
+    set int Side;
+    function Constructor(side int) {
+        Side <- side;
+    }
+}`,
+		"additional": "Add every function of the interface to the class as a public (&dyset&dy) function with the exact same signature.",
+	},
+	IllegalGenericDeclarationErrorCode: {
+		"name": "IllegalGenericDeclarationError",
+		"area": "Binder",
+		"explanation": `This error occurs when a function inside of a class is given &rits own type parameters&r.
+Only whole classes and functions in the global scope can be generic.`,
+		"example": `class Box {
+    function Constructor() {}
+    set function Get[T](item T) T { // class functions can't be generic
+        return item;
+    }
+}`,
+		"additional": "Make the class generic instead (&dyclass Box[T]&dy), or move the function out of the class.",
+	},
+	GenericTypeInferenceErrorCode: {
+		"name": "GenericTypeInferenceError",
+		"area": "Binder",
+		"explanation": `This error occurs when the compiler &rcan't figure out&r a type argument of a generic function call.
+Type arguments are guessed from the arguments of the call, if a type parameter isn't used by any parameter there's nothing to go off of.`,
+		"example": `function Empty[T]() array[T] {
+    return make T array(0);
+}
+
+var array[int] items <- Empty(); // what is T?`,
+		"additional": "Give the type arguments explicitly: &dyEmpty[int]()&dy",
+	},
+	IllegalCaseClauseErrorCode: {
+		"name": "IllegalCaseClauseError",
+		"area": "Binder",
+		"explanation": `This error occurs when a &dycase&dy of a switch statement or match expression is &rnot allowed&r.
+A switch or match can only have &wone default case&w, every value can only have &wone case&w (a second one would never be reached),
+and all cases of a match need to give back a value of the same type.`,
+		"example": `var int x <- 5;
+switch (x) {
+    case 1: x <- 2;
+    default: x <- 3;
+    default: x <- 4; // second default
+}`,
+		"additional": "Remove the duplicate default case or case value, or make sure every case of the match gives back a value of the same type.",
+	},
+	NonExhaustiveMatchErrorCode: {
+		"name": "NonExhaustiveMatchError",
+		"area": "Binder",
+		"explanation": `This error occurs when a match expression &rdoesn't handle every possible value&r.
+A match always needs to give back &wsomething&w, so it needs a &dydefault&dy case, or (when matching over an enum) a case for every field.`,
+		"example": `enum Color {
+    Red,
+    Green,
+    Blue
+}
+
+var Color c <- Color->Red;
+var string name <- match (c) {
+    case Color->Red: "red";
+    case Color->Green: "green";
+}; // Blue is missing`,
+		"additional": "Add cases for the missing values or a &dydefault&dy case.",
+	},
+	NonExhaustiveSwitchWarningCode: {
+		"name": "NonExhaustiveSwitchWarning",
+		"area": "Binder",
+		"explanation": `This warning occurs when a switch statement over an enum &rdoesn't handle every field&r and has no &dydefault&dy case.
+The code still compiles, values without a case are just skipped, but that is usually a mistake (especially after adding a new field to the enum).`,
+		"example": `enum Color {
+    Red,
+    Green,
+    Blue
+}
+
+var Color c <- Color->Red;
+switch (c) {
+    case Color->Red: c <- Color->Green;
+} // Green and Blue aren't handled`,
+		"additional": "Add the missing cases or an empty &dydefault&dy case. If skipping them is intended, the warning can be turned off with &dy#nowarn(\"3058\");&dy or &dy-nowarn=3058&dy.",
+	},
+	UnknownVTableErrorCode: {
+		"name": "UnknownVTableError",
+		"area": "Emitter",
+		"explanation": `This error occurs when the compiler &rcan't find the vTable&r of a class that comes from a package.
+Every class has a vTable (a list of its functions) that has to be part of the package's module file.`,
+		"example": `package mylib; // mylib's module file is outdated or broken
+var mylib::Thing t <- make mylib::Thing();`,
+		"additional": "Recompile the package with the current version of the compiler, or check that the package is set up correctly.",
+	},
+	UnknownConstructorErrorCode: {
+		"name":        "UnknownConsructorError",
+		"area":        "Emitter",
+		"explanation": `This error occurs when the compiler &rcan't find the constructor&r of a class that comes from a package.`,
+		"example": `package mylib; // mylib's module file is outdated or broken
+var mylib::Thing t <- make mylib::Thing();`,
+		"additional": "Recompile the package with the current version of the compiler, or check that the package is set up correctly.",
+	},
+	CAdapterCompilationErrorCode: {
+		"name":        "CAdapterCompilationError",
+		"area":        "Emitter",
+		"explanation": `This error occurs when the &wC-Adapter&w module (which translates structs between ReCT and C for &dyc_adapted&dy external functions) &rcouldn't be compiled&r.`,
+		"example":     "",
+		"additional":  "This is a bug in the compiler, please file a bug report with the code that caused it!",
+	},
+	ExternalCAdapterWarningCode: {
+		"name": "ExternalCAdapterWarning",
+		"area": "Emitter",
+		"explanation": `This warning occurs when an external function uses structs &rwithout&r being &dyc_adapted&dy, or is &dyc_adapted&dy without using any structs.
+Structs aren't always laid out the same way in ReCT and C, the C-Adapter takes care of translating them.`,
+		"example": `struct Point {
+    x int,
+    y int
+}
+
+external draw(p Point) int; // should be "external c_adapted draw(...)" `,
+		"additional": "Add &dyc_adapted&dy to external functions that use structs, and remove it from the ones that don't.",
+	},
+	UnknownPackageModuleFileErrorCode: {
+		"name":        "UnknownPackageModuleFileError",
+		"area":        "Packager",
+		"explanation": `This error occurs when a package is loaded but its &rmodule file can't be found&r in any of the package directories.`,
+		"example":     "package mylib; // there's no mylib.ll or mylib.bc anywhere",
+		"additional":  "Make sure the package is installed, or point the compiler at the directory it's in using &dy-pi <path>&dy.",
+	},
+	IllegalBoxedTypeErrorCode: {
+		"name": "IllegalBoxedTypeError",
+		"area": "Packager",
+		"explanation": `This error occurs when a package uses &wboxed types&w (object versions of &dyint&dy, &dybyte&dy, &dyfloat&dy or &dybool&dy) in one of its functions or fields.
+ReCT has no way of representing them.`,
+		"example":    "package mylib; // mylib has a function that gives back a boxed int",
+		"additional": "If you wrote the package, give back an &dyany&dy instead of a boxed primitive.",
+	},
+	IllegalUnspecificArrayTypeErrorCode: {
+		"name": "IllegalUnspecificArrayTypeError",
+		"area": "Packager",
+		"explanation": `This error occurs when a package uses an array &rwithout saying what's in it&r.
+ReCT arrays always have a specific element type.`,
+		"example":    "package mylib; // mylib has a function that takes a plain array",
+		"additional": "If you wrote the package, use a specific array type (like &dyarray[int]&dy).",
+	},
+	MonkeErrorCode: {
+		"name": "MonkeError",
+		"area": "Packager",
+		"explanation": `This error occurs when a package references a type that &rdoesn't look like a ReCT class at all&r.
+The compiler has absolutely no clue what to do with it.`,
+		"example":    "package mylib; // mylib was compiled with something that's not rgoc",
+		"additional": "Make sure the package was compiled with the ReCT compiler. If it was, please file a bug report!",
+	},
+	InvalidNonPointerReferenceErrorCode: {
+		"name": "InvalidNonPointerReferenceError",
+		"area": "Packager",
+		"explanation": `This error occurs when a package references an object type that &rshould be a pointer but isn't&r.
+Objects are always passed around by reference in ReCT.`,
+		"example":    "package mylib; // mylib passes an object by value somewhere",
+		"additional": "If you wrote the package, make sure all objects are passed as pointers.",
+	},
+	UnparsableFingerprintErrorCode: {
+		"name": "UnparsableFingerprintError",
+		"area": "Packager",
+		"explanation": `This error occurs when the &wtype fingerprint&w of something in a package &rcan't be read&r.
+Fingerprints describe the types of a package's functions and fields, if one is broken the compiler can't know what it's dealing with.`,
+		"example":    "package mylib; // mylib's module file is damaged or from a different compiler version",
+		"additional": "Recompile the package with the current version of the compiler.",
+	},
+	ImpossibleFunctionProcessingErrorCode: {
+		"name":        "ImpossibleFunctionProcessingError",
+		"area":        "Packager",
+		"explanation": `This error occurs when a function of a package &rcan't be processed&r while loading the package.`,
+		"example":     "package mylib; // one of mylib's functions has an unsupported signature",
+		"additional":  "Recompile the package with the current version of the compiler. If that doesn't help, please file a bug report!",
+	},
+	ImpossibleFieldProcessingErrorCode: {
+		"name":        "ImpossibleFieldProcessingError",
+		"area":        "Packager",
+		"explanation": `This error occurs when a field of a class in a package &rcan't be processed&r while loading the package.`,
+		"example":     "package mylib; // one of mylib's classes has a field with an unsupported type",
+		"additional":  "Recompile the package with the current version of the compiler. If that doesn't help, please file a bug report!",
+	},
+	InvalidWarningCodeErrorCode: {
+		"name": "InvalidWarningCodeError",
+		"area": "Preprocessor",
+		"explanation": `This error occurs when &dy#nowarn&dy is given something that &risn't the code of a warning&r.
+Only warnings can be turned off, errors always have to be fixed.`,
+		"example":    "#nowarn(\"3016\"); // 3016 is an error, not a warning",
+		"additional": "Use the code shown next to the warning you want to turn off. All codes ending in &dyWarning&dy are listed by &dyrgoc -lookup all&dy.",
 	},
 }
@@ -0,0 +1,167 @@
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// the error catalogue
+// everything -lookup knows about, all in one place
+// (so it can be listed with "rgoc -lookup all" or exported for the docs)
+
+// CatalogueEntry is everything there is to know about one error code
+type CatalogueEntry struct {
+	Code        int    `json:"code"`
+	Name        string `json:"name"`
+	Area        string `json:"area"`
+	Warning     bool   `json:"warning"`
+	Explanation string `json:"explanation"`
+	Example     string `json:"example,omitempty"`
+	Additional  string `json:"additional,omitempty"`
+}
+
+// ErrorCatalogue gives back all known error codes, sorted by code
+func ErrorCatalogue() []CatalogueEntry {
+	entries := make([]CatalogueEntry, 0, len(errorData))
+
+	for code, data := range errorData {
+		entries = append(entries, CatalogueEntry{
+			Code:        int(code),
+			Name:        data["name"],
+			Area:        data["area"],
+			Warning:     IsWarningCode(code),
+			Explanation: StripFormat(data["explanation"]),
+			Example:     data["example"],
+			Additional:  StripFormat(data["additional"]),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Code < entries[j].Code
+	})
+
+	return entries
+}
+
+// LookUpAll prints a list of every error code
+func LookUpAll() {
+	fmt.Println(Format("ReCT-Go-Compiler v&c%s&c - Error Look up", White, "1.1"))
+
+	area := ""
+	for _, entry := range ErrorCatalogue() {
+		// a little header for every part of the compiler
+		if entry.Area != area {
+			area = entry.Area
+			fmt.Println(Format("\n&b%s&b", Gray, area))
+		}
+
+		fmt.Println(Format("  &c%4d&c  &m%s&m", Gray, entry.Code, entry.Name))
+	}
+
+	fmt.Println(Format("\nUse &dyrgoc -lookup <code>&dy for more information about an error.", Gray))
+}
+
+// ExportCatalogue turns the catalogue into a document ("markdown" or "json")
+func ExportCatalogue(format string) (string, error) {
+	switch format {
+	case "markdown", "md":
+		return CatalogueMarkdown(), nil
+	case "json":
+		output, err := json.MarshalIndent(ErrorCatalogue(), "", "  ")
+		if err != nil {
+			return "", err
+		}
+
+		return string(output) + "\n", nil
+	default:
+		return "", fmt.Errorf("unknown catalogue format \"%s\", expected markdown or json", format)
+	}
+}
+
+// CatalogueMarkdown puts the whole catalogue into one markdown page
+// (an overview table first, then a section for every code)
+func CatalogueMarkdown() string {
+	entries := ErrorCatalogue()
+	builder := strings.Builder{}
+
+	builder.WriteString("# ReCT error codes\n\n")
+	builder.WriteString("Every error and warning the compiler can report. Use `rgoc -lookup <code>` to see an entry in the terminal.\n\n")
+	builder.WriteString("| Code | Name | Area |\n")
+	builder.WriteString("| ---: | ---- | ---- |\n")
+
+	for _, entry := range entries {
+		builder.WriteString(fmt.Sprintf("| [%d](#%d) | %s | %s |\n", entry.Code, entry.Code, entry.Name, entry.Area))
+	}
+
+	for _, entry := range entries {
+		// the anchor keeps the links in the table working (headers with spaces make for ugly ids)
+		builder.WriteString(fmt.Sprintf("\n<a id=\"%d\"></a>\n\n## %d %s\n\n", entry.Code, entry.Code, entry.Name))
+
+		kind := "Error"
+		if entry.Warning {
+			kind = "Warning"
+		}
+		builder.WriteString(fmt.Sprintf("**Area:** %s · **Kind:** %s\n\n", entry.Area, kind))
+		builder.WriteString(unwrap(entry.Explanation) + "\n")
+
+		if entry.Example != "" {
+			builder.WriteString("\n### Example\n\n```\n" + entry.Example + "\n```\n")
+		}
+
+		if entry.Additional != "" {
+			builder.WriteString("\n### Why / how to fix\n\n" + unwrap(entry.Additional) + "\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// StripFormat removes all the colour codes (like "&r" or "&dgr") Format would understand
+func StripFormat(message string) string {
+	runes := []rune(message)
+	builder := strings.Builder{}
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '&' || i+1 >= len(runes) {
+			builder.WriteRune(runes[i])
+			continue
+		}
+
+		// see Format() for what all of these are
+		i++
+		switch runes[i] {
+		case '&':
+			builder.WriteRune('&')
+		case 'g':
+			if i+1 < len(runes) && runes[i+1] == 'r' {
+				i++
+			}
+		case 'b':
+			if i+1 < len(runes) && runes[i+1] == 'l' {
+				i++
+			}
+		case 'd':
+			if i+1 < len(runes) {
+				i++
+				if runes[i] == 'g' && i+1 < len(runes) && runes[i+1] == 'r' {
+					i++
+				}
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// unwrap joins the hard wrapped lines of an explanation back together
+// (so a line that happens to start with something like "-" doesn't turn into a list in markdown)
+func unwrap(text string) string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+
+	return strings.Join(lines, " ")
+}
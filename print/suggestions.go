@@ -0,0 +1,144 @@
+package print
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// "did you mean" suggestions
+// when a name can't be found, we look for names that are spelled almost the same
+// and offer them as a fix (in the message and as fix-its in machine readable output)
+
+// Suggestion is a name that could replace the one in Span
+type Suggestion struct {
+	Span        TextSpan
+	Replacement string
+}
+
+// how many suggestions we give at most
+const maxSuggestions = 3
+
+// ErrorWithSuggestions is Error, but with a few names the user might have meant instead of the one at nameSpan
+func ErrorWithSuggestions(area string, _type ErrorType, span TextSpan, nameSpan TextSpan, suggestions []string, message string, fargs ...interface{}) {
+	if len(suggestions) == 0 {
+		Error(area, _type, span, message, fargs...)
+		return
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	fixes := make([]Suggestion, 0, len(suggestions))
+
+	for _, suggestion := range suggestions {
+		quoted = append(quoted, "\""+suggestion+"\"")
+		fixes = append(fixes, Suggestion{nameSpan, suggestion})
+	}
+
+	args := append(fargs, strings.Join(quoted, " or "))
+
+	// only attach the fixes if this actually got reported (and wasn't a repeat)
+	reported := len(ErrorList)
+	Error(area, _type, span, message+" Did you mean %s?", args...)
+
+	if len(ErrorList) > reported {
+		ErrorList[len(ErrorList)-1].Suggestions = fixes
+	}
+}
+
+// SuggestNames finds the candidates that are close enough to name to be a typo of it (best ones first)
+func SuggestNames(name string, candidates []string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	// the longer the name, the more typos we allow
+	length := utf8.RuneCountInString(name)
+	limit := (length + 2) / 3
+
+	matches := make([]match, 0)
+	seen := make(map[string]bool)
+
+	for _, candidate := range candidates {
+		if candidate == name || candidate == "" || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+
+		// getting the case wrong is the most likely typo of them all, so it only counts as half a mistake
+		edits := EditDistance(strings.ToLower(name), strings.ToLower(candidate))
+		distance := 2 * edits
+		if edits == 0 {
+			distance = 1
+		}
+
+		// if every single letter would have to change it's not a typo, it's just a different name
+		// (otherwise short names like "x" would happily suggest any other one-letter symbol)
+		if edits >= length {
+			continue
+		}
+
+		if distance <= 2*limit {
+			matches = append(matches, match{candidate, distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+
+		return matches[i].name < matches[j].name
+	})
+
+	names := make([]string, 0, maxSuggestions)
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		names = append(names, matches[i].name)
+	}
+
+	return names
+}
+
+// EditDistance counts how many characters have to be inserted, removed, replaced or swapped to turn a into b
+// (the optimal string alignment distance, if you're into that kinda thing)
+func EditDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// d[i][j] is the distance between the first i runes of a and the first j runes of b
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+
+			// two characters swapped around
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+func minInt(first int, others ...int) int {
+	for _, other := range others {
+		if other < first {
+			first = other
+		}
+	}
+
+	return first
+}
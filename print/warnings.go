@@ -0,0 +1,115 @@
+package print
+
+import (
+	"strconv"
+	"strings"
+)
+
+// warning control
+// warnings can be turned into errors (-Werror), turned off everywhere (-nowarn)
+// or turned off in just a part of a file (#nowarn("...") in the code itself, see the preprocessor)
+
+// WarningsAsErrors makes every warning that hasn't been turned off count as an error
+var WarningsAsErrors = false
+
+// DisabledWarnings are warnings that are turned off everywhere
+var DisabledWarnings = make(map[ErrorCode]bool)
+
+// WarningSuppression turns off some warnings in a part of a file
+type WarningSuppression struct {
+	File       string
+	StartIndex int // where in the file this applies, a StartIndex < 0 means the whole file
+	EndIndex   int
+	Codes      []ErrorCode
+}
+
+// all the parts of files warnings have been turned off in
+var WarningSuppressions = make([]WarningSuppression, 0)
+
+// IsWarningCode checks if a code belongs to a warning (and not an error)
+func IsWarningCode(code ErrorCode) bool {
+	for errType, errCode := range ErrorTypeCodeRelations {
+		if errCode == code {
+			return strings.HasSuffix(string(errType), "Warning")
+		}
+	}
+
+	return false
+}
+
+// ParseWarningCodes parses a comma separated list of warning codes ("3058, 4053")
+// if something in there isn't a warning code, it's given back as well
+func ParseWarningCodes(list string) ([]ErrorCode, string) {
+	codes := make([]ErrorCode, 0)
+
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(part)
+		if err != nil || !IsWarningCode(ErrorCode(code)) {
+			return codes, part
+		}
+
+		codes = append(codes, ErrorCode(code))
+	}
+
+	return codes, ""
+}
+
+// DisableWarnings turns off the given warnings everywhere
+func DisableWarnings(codes []ErrorCode) {
+	for _, code := range codes {
+		DisabledWarnings[code] = true
+	}
+}
+
+// SuppressWarnings turns off the given warnings in a part of a file (start < 0 for the whole file)
+func SuppressWarnings(file string, start int, end int, codes []ErrorCode) {
+	WarningSuppressions = append(WarningSuppressions, WarningSuppression{
+		File:       file,
+		StartIndex: start,
+		EndIndex:   end,
+		Codes:      codes,
+	})
+}
+
+// ClearWarningSuppressions forgets all suppressions in a file
+func ClearWarningSuppressions(file string) {
+	kept := make([]WarningSuppression, 0, len(WarningSuppressions))
+
+	for _, suppression := range WarningSuppressions {
+		if suppression.File != file {
+			kept = append(kept, suppression)
+		}
+	}
+
+	WarningSuppressions = kept
+}
+
+// IsWarningSuppressed checks if a warning has been turned off at the given location
+func IsWarningSuppressed(code ErrorCode, span TextSpan) bool {
+	if DisabledWarnings[code] {
+		return true
+	}
+
+	for _, suppression := range WarningSuppressions {
+		if suppression.File != span.File {
+			continue
+		}
+
+		if suppression.StartIndex >= 0 && (span.StartIndex < suppression.StartIndex || span.StartIndex >= suppression.EndIndex) {
+			continue
+		}
+
+		for _, suppressed := range suppression.Codes {
+			if suppressed == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
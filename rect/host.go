@@ -0,0 +1,198 @@
+package rect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// go functions a program can call
+// they live in a package of their own, so the program has to say `package host;` and call them with host::Name()
+
+const HostPackage = "host"
+
+type Host struct {
+	Functions map[string]HostFunction
+}
+
+// a go function and the ReCT signature that goes with it
+type HostFunction struct {
+	Function   reflect.Value
+	Parameters []symbols.TypeSymbol
+	Type       symbols.TypeSymbol
+
+	// if the last thing the function returns is an error, that gets thrown as an exception
+	ReturnsError bool
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// go types that have a ReCT type to go with them
+var hostTypes = map[reflect.Kind]symbols.TypeSymbol{
+	reflect.Bool:    builtins.Bool,
+	reflect.Uint8:   builtins.Byte,
+	reflect.Int:     builtins.Int,
+	reflect.Int32:   builtins.Int,
+	reflect.Uint32:  builtins.UInt,
+	reflect.Int64:   builtins.Long,
+	reflect.Uint64:  builtins.ULong,
+	reflect.Float32: builtins.Float,
+	reflect.Float64: builtins.Double,
+	reflect.String:  builtins.String,
+}
+
+// the go types the interpreter keeps ReCT values in
+var valueTypes = map[string]reflect.Type{
+	"bool":   reflect.TypeOf(false),
+	"byte":   reflect.TypeOf(uint8(0)),
+	"int":    reflect.TypeOf(int32(0)),
+	"uint":   reflect.TypeOf(uint32(0)),
+	"long":   reflect.TypeOf(int64(0)),
+	"ulong":  reflect.TypeOf(uint64(0)),
+	"float":  reflect.TypeOf(float32(0)),
+	"double": reflect.TypeOf(float64(0)),
+	"string": reflect.TypeOf(""),
+}
+
+func CreateHost() *Host {
+	return &Host{Functions: make(map[string]HostFunction)}
+}
+
+// makes a go function available to ReCT code
+// it can take and return bool, byte, int, int32, uint32, int64, uint64, float32, float64 and string
+// (and optionally an error as its last result)
+func (hst *Host) Register(name string, function interface{}) error {
+	value := reflect.ValueOf(function)
+	if value.Kind() != reflect.Func {
+		return fmt.Errorf("host function '%s' is a %s, not a function", name, value.Kind())
+	}
+
+	typ := value.Type()
+	if typ.IsVariadic() {
+		return fmt.Errorf("host function '%s' can't be variadic", name)
+	}
+
+	fnc := HostFunction{Function: value, Parameters: make([]symbols.TypeSymbol, 0), Type: builtins.Void}
+
+	for i := 0; i < typ.NumIn(); i++ {
+		param, ok := hostType(typ.In(i))
+		if !ok {
+			return fmt.Errorf("host function '%s' takes a %s, which ReCT has no type for", name, typ.In(i))
+		}
+
+		fnc.Parameters = append(fnc.Parameters, param)
+	}
+
+	results := typ.NumOut()
+	if results > 0 && typ.Out(results-1) == errorType {
+		fnc.ReturnsError = true
+		results--
+	}
+
+	if results > 1 {
+		return fmt.Errorf("host function '%s' returns too many things (at most one value and an error)", name)
+	}
+
+	if results == 1 {
+		result, ok := hostType(typ.Out(0))
+		if !ok {
+			return fmt.Errorf("host function '%s' returns a %s, which ReCT has no type for", name, typ.Out(0))
+		}
+
+		fnc.Type = result
+	}
+
+	hst.Functions[name] = fnc
+	return nil
+}
+
+func hostType(typ reflect.Type) (symbols.TypeSymbol, bool) {
+	result, ok := hostTypes[typ.Kind()]
+	return result, ok
+}
+
+// the package the binder gets to see
+func (hst *Host) Package() symbols.PackageSymbol {
+	names := make([]string, 0)
+	for name := range hst.Functions {
+		names = append(names, name)
+	}
+
+	// keep things in the same order every time
+	sort.Strings(names)
+
+	functions := make([]symbols.FunctionSymbol, 0)
+	for _, name := range names {
+		fnc := hst.Functions[name]
+		functions = append(functions, functionSymbol(name, fnc.Parameters, fnc.Type))
+	}
+
+	return createVirtualPackage(HostPackage, functions)
+}
+
+// hands all functions to the evaluator
+func (hst *Host) bind(evl *evaluator.Evaluator) {
+	for name, fnc := range hst.Functions {
+		evl.Bind(HostPackage, name, evaluator.NativeBinding{
+			Parameters: fnc.Parameters,
+			Type:       fnc.Type,
+			Function:   fnc.call,
+		})
+	}
+}
+
+func (fnc HostFunction) call(evl *evaluator.Evaluator, args []interface{}) interface{} {
+	typ := fnc.Function.Type()
+	in := make([]reflect.Value, 0)
+
+	for i, arg := range args {
+		// null strings are just empty for go
+		if arg == nil {
+			in = append(in, reflect.Zero(typ.In(i)))
+			continue
+		}
+
+		in = append(in, reflect.ValueOf(arg).Convert(typ.In(i)))
+	}
+
+	// the host function isn't part of the program, so it doesnt need the interpreter to itself
+	// (that way it can call back into the instance without waiting on itself forever)
+	var out []reflect.Value
+	var crash interface{}
+	evl.Unlocked(func() {
+		out, crash = fnc.invoke(in)
+	})
+
+	if crash != nil {
+		// a bug in the host shouldn't take the whole host down with it
+		evl.Die("A host function panicked: %v", crash)
+	}
+
+	if fnc.ReturnsError {
+		if err := out[len(out)-1]; !err.IsNil() {
+			evl.Throw(err.Interface().(error).Error())
+		}
+
+		out = out[:len(out)-1]
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	// back to whatever go type the interpreter uses for this
+	return out[0].Convert(valueTypes[fnc.Type.Name]).Interface()
+}
+
+// actually calls the go function, handing back whatever it panicked with instead of panicking
+func (fnc HostFunction) invoke(in []reflect.Value) (out []reflect.Value, crash interface{}) {
+	defer func() {
+		crash = recover()
+	}()
+
+	return fnc.Function.Call(in), nil
+}
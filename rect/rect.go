@@ -0,0 +1,253 @@
+package rect
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/packager"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/parser"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/preprocessor"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// the interpreter, but as a library
+// compile some ReCT, hand it a few go functions, run it and get whatever went wrong back as values
+// (nothing in here ever kills the host process)
+
+// a single source file, the name is only used for diagnostics and #source includes
+type Source struct {
+	Name string
+	Code string
+}
+
+// an error or warning the compiler found
+type Diagnostic = print.Diagnostic
+
+// whatever stopped a program early (an uncaught exception or something the interpreter couldnt do)
+type RuntimeError = evaluator.RuntimeError
+
+type CompileOptions struct {
+	// go functions the program can call (see Host)
+	Host *Host
+
+	// directories to look for package modules in, sys is always available
+	PackagePaths []string
+}
+
+// a program that compiled successfully and is ready to run (as often as you like)
+type Program struct {
+	Bound binder.BoundProgram
+	Host  *Host
+}
+
+type RunOptions struct {
+	// where sys::Input() reads from and sys::Print() writes to (default to the real ones)
+	Stdin  io.Reader
+	Stdout io.Writer
+}
+
+// the compiler keeps its state in globals, so only one compilation at a time
+var compileLock sync.Mutex
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+// <COMPILING> ----------------------------------------------------------------
+
+// compiles the given sources into a program
+// the program is nil if there were any errors, the diagnostics contain warnings either way
+func Compile(sources []Source, options CompileOptions) (program *Program, diagnostics []Diagnostic) {
+	compileLock.Lock()
+	defer compileLock.Unlock()
+
+	resetCompiler()
+
+	// no printing and definitely no dying
+	output, panicOnCrash, paths, virtual := print.OutputErrorMessages, print.PanicOnCrash, packager.PackagePaths, packager.VirtualPackages
+	print.OutputErrorMessages = false
+	print.PanicOnCrash = true
+	packager.PackagePaths = options.PackagePaths
+	packager.VirtualPackages = virtualPackages(options.Host)
+
+	defer func() {
+		print.OutputErrorMessages, print.PanicOnCrash, packager.PackagePaths, packager.VirtualPackages = output, panicOnCrash, paths, virtual
+	}()
+
+	// some errors stop the compiler right away
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(print.CrashPanic); !ok {
+				panic(r)
+			}
+
+			program, diagnostics = nil, print.Diagnostics()
+		}
+	}()
+
+	files := make([]string, 0)
+	code := make(map[string]string)
+
+	for _, source := range sources {
+		files = append(files, source.Name)
+		code[source.Name] = source.Code
+	}
+
+	arguments := make([]string, 0)
+	members := make([]nodes.MemberNode, 0)
+
+	// #source can add more files while we go, those get read from disk
+	for i := 0; i < len(files); i++ {
+		file := files[i]
+
+		content, ok := code[file]
+		if !ok {
+			content = string(preprocessor.ReadFile(file, print.TextSpan{}))
+		}
+
+		content = preprocessor.PreprocessCode(file, content, &files, &arguments)
+		if len(print.ErrorList) > 0 {
+			return nil, print.Diagnostics()
+		}
+
+		tokens := lexer.Lex([]rune(content), file)
+
+		// same order the cli merges them in
+		members = append(parser.Parse(tokens), members...)
+	}
+
+	bound := binder.BindProgram(members)
+
+	if len(print.ErrorList) > 0 {
+		return nil, print.Diagnostics()
+	}
+
+	return &Program{Bound: bound, Host: options.Host}, print.Diagnostics()
+}
+
+// same thing the language server does before every run
+func resetCompiler() {
+	print.ErrorList = make([]print.ErrorReport, 0)
+	print.WarningList = make([]print.ErrorReport, 0)
+	print.SourceFiles = make(map[string]string)
+	print.WarningSuppressions = make([]print.WarningSuppression, 0)
+	packager.PackagesSoFar = make([]symbols.PackageSymbol, 0)
+	langserverinterface.Reset()
+}
+
+// every package the interpreter has bindings for can be used without its module
+// (plus the host package, if there is one)
+func virtualPackages(host *Host) map[string]symbols.PackageSymbol {
+	packages := make(map[string]symbols.PackageSymbol)
+
+	for name, functions := range evaluator.NativePackages {
+		fncs := make([]symbols.FunctionSymbol, 0)
+
+		for fnc, binding := range functions {
+			fncs = append(fncs, functionSymbol(fnc, binding.Parameters, binding.Type))
+		}
+
+		packages[name] = createVirtualPackage(name, fncs)
+	}
+
+	if host != nil {
+		packages[HostPackage] = host.Package()
+	}
+
+	return packages
+}
+
+func createVirtualPackage(name string, functions []symbols.FunctionSymbol) symbols.PackageSymbol {
+	return symbols.CreatePackageSymbol(name, functions, make([]symbols.ClassSymbol, 0), nil, print.TextSpan{})
+}
+
+func functionSymbol(name string, params []symbols.TypeSymbol, returnType symbols.TypeSymbol) symbols.FunctionSymbol {
+	parameters := make([]symbols.ParameterSymbol, 0)
+
+	for i, param := range params {
+		parameters = append(parameters, symbols.CreateParameterSymbol(fmt.Sprintf("arg%d", i), i, param))
+	}
+
+	if returnType.Name == "" {
+		returnType = builtins.Void
+	}
+
+	return symbols.CreateFunctionSymbol(name, parameters, returnType, nodes.FunctionDeclarationMember{}, true)
+}
+
+// <RUNNING> ------------------------------------------------------------------
+
+// a program that has been run, its globals stick around so the host can look at them or call more functions
+type Instance struct {
+	Program   *Program
+	Evaluator *evaluator.Evaluator
+
+	// the first thread that died, if any did
+	threadError *RuntimeError
+}
+
+// runs the programs main code
+// if anything goes wrong the error is a *RuntimeError, the instance is still usable afterwards
+func Run(program *Program, options RunOptions) (*Instance, error) {
+	if program == nil {
+		return nil, errors.New("can't run a program that didn't compile")
+	}
+
+	evl := evaluator.CreateEvaluator(program.Bound)
+
+	if options.Stdin != nil {
+		evl.Stdin = bufio.NewReader(options.Stdin)
+	}
+
+	if options.Stdout != nil {
+		evl.Stdout = options.Stdout
+	}
+
+	instance := &Instance{Program: program, Evaluator: evl}
+
+	// threads dont get to take the host down either
+	evl.OnThreadError = func(err *RuntimeError) {
+		if instance.threadError == nil {
+			instance.threadError = err
+		}
+	}
+
+	if program.Host != nil {
+		program.Host.bind(evl)
+	}
+
+	return instance, instance.protected(func() {
+		evl.CheckNativeBindings()
+		evl.RunMain()
+	})
+}
+
+// runs something inside of the interpreter and turns whatever killed it into an error
+func (ins *Instance) protected(action func()) error {
+	ins.Evaluator.TakeTurn()
+	defer ins.Evaluator.EndTurn()
+
+	if err := ins.Evaluator.Protected(action); err != nil {
+		return err
+	}
+
+	// a thread dying is just as bad, but only report it once
+	if err := ins.threadError; err != nil {
+		ins.threadError = nil
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package rect
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// getting values in and out of a running program
+// primitives and strings turn into their go versions, arrays into slices, maps into maps and structs into map[string]interface{}
+// class instances stay *evaluator.Object (they can point back at themselves, so theres no nice go version of them)
+
+// calls one of the programs functions with the given arguments
+func (ins *Instance) Call(name string, args ...interface{}) (interface{}, error) {
+	fnc, ok := ins.function(name)
+	if !ok {
+		return nil, fmt.Errorf("program has no function called '%s'", name)
+	}
+
+	if len(args) != len(fnc.Symbol.Parameters) {
+		return nil, fmt.Errorf("function '%s' takes %d arguments, got %d", name, len(fnc.Symbol.Parameters), len(args))
+	}
+
+	arguments := make([]interface{}, 0)
+	for i, arg := range args {
+		value, err := ToValue(arg, fnc.Symbol.Parameters[i].Type)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d of '%s': %s", i, name, err)
+		}
+
+		arguments = append(arguments, value)
+	}
+
+	var result interface{}
+	err := ins.protected(func() {
+		result = ins.Evaluator.CallFunction(fnc, nil, arguments)
+	})
+
+	return FromValue(result), err
+}
+
+// reads one of the programs global variables
+func (ins *Instance) Global(name string) (interface{}, bool) {
+	global, ok := ins.global(name)
+	if !ok {
+		return nil, false
+	}
+
+	ins.Evaluator.TakeTurn()
+	defer ins.Evaluator.EndTurn()
+
+	return FromValue(ins.Evaluator.Globals[global.Fingerprint()]), true
+}
+
+// changes one of the programs global variables
+func (ins *Instance) SetGlobal(name string, value interface{}) error {
+	global, ok := ins.global(name)
+	if !ok {
+		return fmt.Errorf("program has no global called '%s'", name)
+	}
+
+	converted, err := ToValue(value, global.VarType())
+	if err != nil {
+		return fmt.Errorf("global '%s': %s", name, err)
+	}
+
+	ins.Evaluator.TakeTurn()
+	defer ins.Evaluator.EndTurn()
+
+	ins.Evaluator.Globals[global.Fingerprint()] = converted
+	return nil
+}
+
+func (ins *Instance) function(name string) (binder.BoundFunction, bool) {
+	for _, fnc := range ins.Program.Bound.Functions {
+		if fnc.Symbol.Name == name && fnc.Symbol.Fingerprint() != ins.Program.Bound.MainFunction.Fingerprint() {
+			return fnc, true
+		}
+	}
+
+	return binder.BoundFunction{}, false
+}
+
+func (ins *Instance) global(name string) (symbols.VariableSymbol, bool) {
+	if ins.Program.Bound.GlobalScope == nil {
+		return nil, false
+	}
+
+	// the global scope also knows about mains own top level variables, those only live in mains frame though
+	for _, global := range ins.Program.Bound.GlobalScope.Variables {
+		if global.IsGlobal() && global.SymbolName() == name {
+			return global, true
+		}
+	}
+
+	return nil, false
+}
+
+// <CONVERSIONS> --------------------------------------------------------------
+
+// turns a go value into whatever the interpreter uses for the given ReCT type
+// (anything that isnt a primitive or a string gets handed over as is)
+func ToValue(value interface{}, typ symbols.TypeSymbol) (interface{}, error) {
+	target, ok := valueTypes[typ.Name]
+	if !ok || value == nil {
+		return value, nil
+	}
+
+	source := reflect.ValueOf(value)
+
+	// go would happily turn numbers into strings (as runes!) and we dont want that
+	if _, ok := hostTypes[source.Kind()]; !ok ||
+		(source.Kind() == reflect.String) != (target.Kind() == reflect.String) ||
+		(source.Kind() == reflect.Bool) != (target.Kind() == reflect.Bool) {
+		return nil, fmt.Errorf("can't use a %s as a %s", source.Type(), typ.Name)
+	}
+
+	return source.Convert(target).Interface(), nil
+}
+
+// turns an interpreter value into something nicer for go
+func FromValue(value interface{}) interface{} {
+	switch val := value.(type) {
+	case *evaluator.Array:
+		elements := make([]interface{}, 0)
+		for _, element := range val.Elements {
+			elements = append(elements, FromValue(element))
+		}
+
+		return elements
+
+	case *evaluator.Map:
+		entries := make(map[interface{}]interface{})
+		for _, key := range val.Keys {
+			entries[key] = FromValue(val.Values[key])
+		}
+
+		return entries
+
+	case *evaluator.Struct:
+		fields := make(map[string]interface{})
+		for _, field := range val.Symbol.Fields {
+			fields[field.SymbolName()] = FromValue(val.Fields[field.Fingerprint()])
+		}
+
+		return fields
+	}
+
+	return value
+}
@@ -0,0 +1,409 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/langserverinterface"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/lexer"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/parser"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/preprocessor"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+// rgoc repl
+// every submission gets bound on top of everything before it, so the symbols (and their fingerprints) of
+// earlier lines stay exactly the same and the evaluator can just keep going with the values it already has
+
+type Repl struct {
+	Reader *bufio.Reader
+
+	// everything declared so far
+	Scope *binder.Scope
+
+	// one evaluator and one main frame for the whole session, so values stick around
+	Evaluator *evaluator.Evaluator
+	Frame     evaluator.Frame
+
+	// how many things have been submitted (every submission is its own "file")
+	Submissions int
+}
+
+func CreateRepl(input io.Reader) *Repl {
+	reader := bufio.NewReader(input)
+
+	evl := evaluator.CreateEvaluator(binder.BoundProgram{})
+	evl.Stdin = reader
+
+	// a thread dying just gets reported, same as everything else in here
+	evl.OnThreadError = evl.Report
+
+	return &Repl{
+		Reader:    reader,
+		Evaluator: evl,
+		Frame:     evaluator.Frame{Name: "main", Locals: make(map[string]interface{}), Environment: make(evaluator.Environment)},
+	}
+}
+
+func (rpl *Repl) Run() {
+	// errors get printed, but they dont get to end the session
+	print.PanicOnCrash = true
+
+	// just typing an expression shows its value
+	binder.AllowExpressionStatements = true
+
+	print.PrintC(print.Cyan, "ReCT repl, type :help for help")
+
+	for {
+		submission, ok := rpl.ReadSubmission()
+		if !ok {
+			fmt.Println()
+			return
+		}
+
+		if !rpl.Submit(submission) {
+			return
+		}
+	}
+}
+
+// <INPUT> --------------------------------------------------------------------
+
+// reads lines until there's something complete to run
+func (rpl *Repl) ReadSubmission() (string, bool) {
+	lines := make([]string, 0)
+
+	for {
+		if len(lines) == 0 {
+			print.WriteC(print.Green, "» ")
+		} else {
+			print.WriteC(print.Gray, "· ")
+		}
+
+		line, err := rpl.Reader.ReadString('\n')
+		if err != nil && line == "" {
+			// out of input, run whatever we've got left
+			return strings.Join(lines, "\n"), len(lines) > 0
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		// an empty line sends off whatever is there, even if its not done yet
+		if strings.TrimSpace(line) == "" {
+			if len(lines) == 0 {
+				continue
+			}
+
+			return strings.Join(lines, "\n"), true
+		}
+
+		lines = append(lines, line)
+		text := strings.Join(lines, "\n")
+
+		if strings.HasPrefix(strings.TrimSpace(text), ":") || IsComplete(text) {
+			return text, true
+		}
+	}
+}
+
+// code is complete once every brace, bracket and parenthesis has been closed again
+func IsComplete(code string) bool {
+	// this is just a peek, nobody needs to hear about any errors yet
+	output, errors := print.OutputErrorMessages, print.ErrorList
+	print.OutputErrorMessages = false
+
+	defer func() {
+		print.OutputErrorMessages, print.ErrorList = output, errors
+	}()
+
+	depth := 0
+	for _, token := range lexer.Lex([]rune(code), "repl") {
+		switch token.Kind {
+		case lexer.OpenBraceToken, lexer.OpenBracketToken, lexer.OpenParenthesisToken:
+			depth++
+		case lexer.CloseBraceToken, lexer.CloseBracketToken, lexer.CloseParenthesisToken:
+			depth--
+		}
+	}
+
+	return depth <= 0
+}
+
+// <SUBMISSIONS> --------------------------------------------------------------
+
+// runs a line (or a few) of code or a command, returns false if the session should end
+func (rpl *Repl) Submit(text string) bool {
+	text = strings.TrimSpace(text)
+
+	// something inside of the compiler blew up, thats no reason to kick the user out
+	defer func() {
+		if r := recover(); r != nil {
+			print.PrintCF(print.Red, "Something went wrong inside of the compiler! (%v)", r)
+			rpl.Evaluator.Frames = rpl.Evaluator.Frames[:0]
+		}
+	}()
+
+	if !strings.HasPrefix(text, ":") {
+		rpl.Evaluate(text)
+		return true
+	}
+
+	command, argument := text, ""
+	if space := strings.IndexAny(text, " \t\n"); space >= 0 {
+		command, argument = text[:space], strings.TrimSpace(text[space:])
+	}
+
+	switch command {
+	case ":quit", ":exit", ":q":
+		return false
+
+	case ":help", ":h":
+		rpl.Help()
+
+	case ":type", ":t":
+		if expr, ok := rpl.BindExpression(argument); ok {
+			print.PrintC(print.Yellow, langserverinterface.TypeName(expr.Type()))
+		}
+
+	case ":ast":
+		if expr, ok := rpl.ParseExpression(argument); ok {
+			expr.Print("")
+		}
+
+	case ":bound":
+		if expr, ok := rpl.BindExpression(argument); ok {
+			expr.Print("")
+		}
+
+	default:
+		print.PrintCF(print.Red, "Unknown command '%s'! (use :help to see all of them)", command)
+	}
+
+	return true
+}
+
+func (rpl *Repl) Help() {
+	print.PrintC(print.Cyan, "Type some code and it runs right away, anything you declare sticks around.")
+	print.PrintC(print.Cyan, "Open braces keep going on the next line, an empty line runs whatever's there.")
+	fmt.Println()
+	print.PrintC(print.Green, ":type <expr>   shows the type of an expression")
+	print.PrintC(print.Green, ":ast <expr>    shows the syntax tree of an expression")
+	print.PrintC(print.Green, ":bound <expr>  shows the bound tree of an expression")
+	print.PrintC(print.Green, ":help          shows this")
+	print.PrintC(print.Green, ":quit          ends the session")
+}
+
+// compiles and runs some code on top of everything before it
+func (rpl *Repl) Evaluate(code string) {
+	// the semicolon at the end is optional in here
+	if !strings.HasSuffix(code, ";") && !strings.HasSuffix(code, "}") {
+		code += ";"
+	}
+
+	var program binder.BoundProgram
+	ok := rpl.Compile(code, func(members []nodes.MemberNode) {
+		program = binder.BindProgram(members)
+	})
+
+	if !ok {
+		return
+	}
+
+	// this line made it, so everything in it is here to stay
+	scope := binder.MainScope
+	rpl.Scope = &scope
+	rpl.SilenceWarnings()
+
+	rpl.Evaluator.LoadProgram(program)
+	rpl.Evaluator.LastValue = nil
+
+	// threads started on earlier lines keep running while we wait for input, so take turns with them
+	rpl.Evaluator.TakeTurn()
+	err := rpl.Evaluator.Protected(func() {
+		rpl.Evaluator.CheckNativeBindings()
+		rpl.Evaluator.RunMainIn(rpl.Frame)
+	})
+	rpl.Evaluator.EndTurn()
+
+	if err != nil {
+		rpl.Evaluator.Report(err)
+		return
+	}
+
+	// if this ended in an expression, show what it came out as
+	statements := program.GlobalScope.Statements
+	if len(statements) == 0 || statements[len(statements)-1].NodeType() != boundnodes.BoundExpressionStatement {
+		return
+	}
+
+	expr := statements[len(statements)-1].(boundnodes.BoundExpressionStatementNode).Expression
+	if expr.Type().Fingerprint() == builtins.Void.Fingerprint() {
+		return
+	}
+
+	print.WriteC(print.White, rpl.Format(rpl.Evaluator.LastValue))
+	print.PrintC(print.Gray, " : "+langserverinterface.TypeName(expr.Type()))
+}
+
+// lexes and parses a submission, then hands it to bind
+// returns false if anything went wrong (which has already been reported by then)
+func (rpl *Repl) Compile(code string, bind func(members []nodes.MemberNode)) (ok bool) {
+	rpl.Submissions++
+	file := fmt.Sprintf("repl:%d", rpl.Submissions)
+
+	print.ErrorList = make([]print.ErrorReport, 0)
+	print.WarningList = make([]print.ErrorReport, 0)
+
+	binder.PreviousScope = rpl.Scope
+	defer func() {
+		binder.PreviousScope = nil
+	}()
+
+	// some errors stop the compiler right away
+	defer func() {
+		if r := recover(); r != nil {
+			if _, crashed := r.(print.CrashPanic); !crashed {
+				panic(r)
+			}
+
+			ok = false
+		}
+	}()
+
+	// #nowarn and friends work in here too
+	sources := []string{file}
+	arguments := make([]string, 0)
+	code = preprocessor.PreprocessCode(file, code, &sources, &arguments)
+
+	members := parser.Parse(lexer.Lex([]rune(code), file))
+	if len(print.ErrorList) > 0 {
+		return false
+	}
+
+	bind(members)
+	return len(print.ErrorList) == 0
+}
+
+// the function bodies of earlier lines get bound again every time, we've already seen their warnings
+func (rpl *Repl) SilenceWarnings() {
+	codes := make([]print.ErrorCode, 0)
+	for _, code := range print.ErrorTypeCodeRelations {
+		if print.IsWarningCode(code) {
+			codes = append(codes, code)
+		}
+	}
+
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	print.WarningSuppressions = append(print.WarningSuppressions, print.WarningSuppression{
+		File:       fmt.Sprintf("repl:%d", rpl.Submissions),
+		StartIndex: -1,
+		Codes:      codes,
+	})
+}
+
+func (rpl *Repl) ParseExpression(code string) (nodes.ExpressionNode, bool) {
+	var expr nodes.ExpressionNode
+
+	ok := rpl.Compile(strings.TrimSuffix(code, ";")+";", func(members []nodes.MemberNode) {
+		expr = expressionOf(members)
+	})
+
+	return expr, ok && expr != nil
+}
+
+func (rpl *Repl) BindExpression(code string) (boundnodes.BoundExpressionNode, bool) {
+	var expr boundnodes.BoundExpressionNode
+
+	ok := rpl.Compile(strings.TrimSuffix(code, ";")+";", func(members []nodes.MemberNode) {
+		if expressionOf(members) == nil {
+			return
+		}
+
+		// just the global scope is enough, nothing here needs to be lowered or run
+		globalScope := binder.BindGlobalScope(members)
+		if len(globalScope.Statements) == 1 {
+			if stmt, isExpr := globalScope.Statements[0].(boundnodes.BoundExpressionStatementNode); isExpr {
+				expr = stmt.Expression
+			}
+		}
+	})
+
+	return expr, ok && expr != nil
+}
+
+// the expression in a submission that should be nothing but an expression
+func expressionOf(members []nodes.MemberNode) nodes.ExpressionNode {
+	if len(members) != 1 || members[0].NodeType() != nodes.GlobalStatement {
+		print.PrintC(print.Red, "That's not an expression!")
+		return nil
+	}
+
+	stmt, ok := members[0].(nodes.GlobalStatementMember).Statement.(nodes.ExpressionStatementNode)
+	if !ok {
+		print.PrintC(print.Red, "That's not an expression!")
+		return nil
+	}
+
+	return stmt.Expression
+}
+
+// <VALUES> -------------------------------------------------------------------
+
+// writes a value out in a way thats nice to look at
+func (rpl *Repl) Format(value interface{}) string {
+	switch val := value.(type) {
+	case nil:
+		return "null"
+
+	case string:
+		return fmt.Sprintf("%q", val)
+
+	case *evaluator.Array:
+		elements := make([]string, 0)
+		for _, element := range val.Elements {
+			elements = append(elements, rpl.Format(element))
+		}
+
+		return "{" + strings.Join(elements, ", ") + "}"
+
+	case *evaluator.Map:
+		entries := make([]string, 0)
+		for _, key := range val.Keys {
+			entries = append(entries, rpl.Format(key)+": "+rpl.Format(val.Values[key]))
+		}
+
+		return "{" + strings.Join(entries, ", ") + "}"
+
+	case *evaluator.Struct:
+		fields := make([]string, 0)
+		for _, field := range val.Symbol.Fields {
+			fields = append(fields, field.SymbolName()+": "+rpl.Format(val.Fields[field.Fingerprint()]))
+		}
+
+		return val.Symbol.Name + "{" + strings.Join(fields, ", ") + "}"
+
+	// objects can point back at themselves, so they just get their name
+	case *evaluator.Object:
+		return "<" + val.Class.Name + " object>"
+
+	case *evaluator.Closure, *evaluator.FunctionReference:
+		return "<action>"
+
+	case *evaluator.Thread:
+		return "<thread>"
+
+	case evaluator.Pointer:
+		return "<pointer>"
+	}
+
+	return rpl.Evaluator.FormatValue(value).(string)
+}
@@ -21,6 +21,12 @@ type ClassSymbol struct {
 	Declaration nodes.ClassDeclarationMember
 	Functions   []FunctionSymbol
 	Fields      []VariableSymbol
+
+	// the class this one inherits from (nil if there is none)
+	Parent *ClassSymbol
+
+	// the interfaces this class implements (not including the ones from its parent)
+	Interfaces []InterfaceSymbol
 }
 
 // implement the symbol interface
@@ -29,6 +35,10 @@ func (s ClassSymbol) SymbolName() string   { return s.Name }
 
 func (sym ClassSymbol) Print(indent string) {
 	print.PrintC(print.Magenta, indent+"└ ClassSymbol ["+sym.Name+"]")
+
+	if sym.Parent != nil {
+		print.PrintC(print.Magenta, indent+"  └ Parent ["+sym.Parent.Name+"]")
+	}
 }
 
 func (s ClassSymbol) Fingerprint() string {
@@ -36,14 +46,42 @@ func (s ClassSymbol) Fingerprint() string {
 	return id
 }
 
+// checks if this class inherits from the given class (directly or somewhere up the chain)
+func (s ClassSymbol) InheritsFrom(other ClassSymbol) bool {
+	for parent := s.Parent; parent != nil; parent = parent.Parent {
+		if parent.Fingerprint() == other.Fingerprint() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checks if this class (or any class up the chain) implements the given interface
+func (s ClassSymbol) Implements(iface InterfaceSymbol) bool {
+	for _, implemented := range s.Interfaces {
+		if implemented.Fingerprint() == iface.Fingerprint() {
+			return true
+		}
+	}
+
+	if s.Parent != nil {
+		return s.Parent.Implements(iface)
+	}
+
+	return false
+}
+
 // constructor
-func CreateClassSymbol(name string, declration nodes.ClassDeclarationMember, functions []FunctionSymbol, fields []VariableSymbol, pck PackageSymbol) ClassSymbol {
+func CreateClassSymbol(name string, declration nodes.ClassDeclarationMember, functions []FunctionSymbol, fields []VariableSymbol, parent *ClassSymbol, interfaces []InterfaceSymbol, pck PackageSymbol) ClassSymbol {
 	sym := ClassSymbol{
 		Exists:      true,
 		Name:        name,
 		Declaration: declration,
 		Functions:   functions,
 		Fields:      fields,
+		Parent:      parent,
+		Interfaces:  interfaces,
 		Package:     pck,
 	}
 
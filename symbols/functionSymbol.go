@@ -23,6 +23,9 @@ type FunctionSymbol struct {
 	Parameters  []ParameterSymbol
 	Type        TypeSymbol
 	Declaration nodes.FunctionDeclarationMember
+
+	// what the type parameters stand for (only set for instances of generic functions and classes)
+	TypeArguments map[string]TypeSymbol
 }
 
 // implement the symbol interface
@@ -0,0 +1,46 @@
+package symbols
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+)
+
+type InterfaceSymbol struct {
+	Symbol
+
+	Exists bool
+
+	Type TypeSymbol
+
+	Name        string
+	Declaration nodes.InterfaceDeclarationMember
+	Functions   []FunctionSymbol // in declaration order
+}
+
+// implement the symbol interface
+func (InterfaceSymbol) SymbolType() SymbolType { return Interface }
+func (s InterfaceSymbol) SymbolName() string   { return s.Name }
+
+func (sym InterfaceSymbol) Print(indent string) {
+	print.PrintC(print.Magenta, indent+"└ InterfaceSymbol ["+sym.Name+"]")
+}
+
+func (s InterfaceSymbol) Fingerprint() string {
+	id := "I_" + s.Name + "_"
+	return id
+}
+
+// constructor
+func CreateInterfaceSymbol(name string, declaration nodes.InterfaceDeclarationMember, functions []FunctionSymbol) InterfaceSymbol {
+	sym := InterfaceSymbol{
+		Exists:      true,
+		Name:        name,
+		Declaration: declaration,
+		Functions:   functions,
+	}
+
+	// interface values are just objects, that way they can be passed around like any other class
+	sym.Type = CreateTypeSymbol(name, make([]TypeSymbol, 0), true, true, false, PackageSymbol{}, sym)
+
+	return sym
+}
@@ -45,6 +45,7 @@ const (
 	Class          SymbolType = "ClassSymbol"
 	Struct         SymbolType = "StructSymbol"
 	Enum           SymbolType = "EnumSymbol"
+	Interface      SymbolType = "InterfaceSymbol"
 	GlobalVariable SymbolType = "GlobalVariableSymbol"
 	LocalVariable  SymbolType = "LocalVariableSymbol"
 	Parameter      SymbolType = "ParameterSymbol"
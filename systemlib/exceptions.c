@@ -30,8 +30,38 @@
 
 #define RESET "\e[0m"
 
+// the innermost try block and the last thing that was thrown
+static _Thread_local exc_Handler *exc_CurrentHandler = NULL;
+static _Thread_local char *exc_CurrentMessage = NULL;
+
+// entering a try block
+void exc_PushHandler(exc_Handler *handler) {
+	handler->previous = exc_CurrentHandler;
+	exc_CurrentHandler = handler;
+}
+
+// leaving a try block
+void exc_PopHandler() {
+	if (exc_CurrentHandler != NULL)
+		exc_CurrentHandler = exc_CurrentHandler->previous;
+}
+
+// the message of whatever was caught last
+char *exc_GetMessage() {
+	return exc_CurrentMessage;
+}
+
 // the actual throw message
 void exc_Throw(char *message) {
+	exc_CurrentMessage = message;
+
+	// if someone is ready to catch this -> jump right back to them
+	if (exc_CurrentHandler != NULL) {
+		exc_Handler *handler = exc_CurrentHandler;
+		exc_CurrentHandler = handler->previous;
+		longjmp(handler->buffer, 1);
+	}
+
 	// exception format:
 	// [RUNTIME] Encountered Exception! '<exception>'
 	// [Stacktrace]
@@ -104,16 +134,6 @@ void exc_ThrowIfInvalidCast(class_Any* fromObj, Standard_vTable *to, const char
 		parent = parent->parentVTable;
 	}
 
-	// check if the goal inherits from the source
-	parent = to->parentVTable;
-	while (parent != NULL) {
-		// inheritance found
-		if (strcmp(parent->className, from.className) == 0) return;
-
-		// if not, continue searching up the chain
-		parent = parent->parentVTable;
-	}
-
     // if the names are equal -> show the fingerprints
     bool namesAreEqual = strcmp(from.className, to->className) == 0;
 
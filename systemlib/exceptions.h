@@ -1,6 +1,8 @@
 #ifndef EXCEPTIONS_H
 #define EXCEPTIONS_H
 
+#include <setjmp.h>
+
 // define the throwing function (very athletic)
 // ============================================
 
@@ -8,9 +10,20 @@
 extern "C" {
 #endif
 
+// an active try block, they're linked up like a stack
+typedef struct exc_Handler {
+	struct exc_Handler *previous;
+	jmp_buf buffer;
+} exc_Handler;
+
 // standard exception throwing
 void exc_Throw(char *message);
 
+// try block bookkeeping
+void exc_PushHandler(exc_Handler *handler);
+void exc_PopHandler();
+char *exc_GetMessage();
+
 // exception shortcuts
 void exc_ThrowIfNull(void *pointer);
 void exc_ThrowIfInvalidCast(class_Any* from, Standard_vTable *to, const char *toFingerprint);
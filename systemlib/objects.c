@@ -176,6 +176,46 @@ class_String *String_public_Substring(class_String* this, int start, int length)
 	return newString;
 }
 
+// definition for a string.Join() method
+// -----------------------------------------------------------------------------
+// [i] this is what interpolated strings get turned into, all parts are copied
+//     into a single buffer which only gets allocated once (unlike Concat)
+// -----------------------------------------------------------------------------
+class_String *String_public_Join(class_String** parts, int count) {
+	// figure out how long our new string is going to be
+	int length = 0;
+	for (int i = 0; i < count; i++) {
+		if (parts[i] != NULL)
+			length += parts[i]->length;
+	}
+
+	// allocate the buffer and copy all parts into it
+	char *buffer = GC_MALLOC(length + 1);
+	int offset = 0;
+
+	for (int i = 0; i < count; i++) {
+		if (parts[i] == NULL) continue;
+
+		memcpy(&buffer[offset], parts[i]->buffer, parts[i]->length);
+		offset += parts[i]->length;
+	}
+
+	buffer[length] = '\0';
+
+	// create a string object
+	class_String *newString = (class_String*)GC_MALLOC(sizeof(class_String));
+	newString->vtable = String_vTable_Const;
+
+	String_public_Constructor(newString);
+
+	// hand it our buffer directly, no need to copy it again
+	newString->buffer = buffer;
+	newString->length = length;
+	newString->maxLen = length;
+
+	return newString;
+}
+
 // -----------------------------------------------------------------------------
 // "int" object type
 // Note: this is an object version of an int, this is to box and crunch it
@@ -458,6 +498,226 @@ void *pArray_public_GetElementPtr(class_pArray* this, int index) {
 	return (void*)(this->elements + index * this->elemSize);
 }
 
+// -----------------------------------------------------------------------------
+// "map" object type
+// Note: maps keys to values, both are stored as objects (primitives get boxed)
+// The keys and values live in two arrays (in insertion order), the buckets
+// are an open addressing hash index pointing into them
+// -----------------------------------------------------------------------------
+
+// definition for the Map vTable
+const Standard_vTable Map_vTable_Const = {&Any_vTable_Const, "Map"};
+
+// definition for the objects constructor
+void Map_public_Constructor(class_Map* this) {
+	this->length = 0;
+	this->maxLen = 8;
+
+	this->keys   = (class_Any**)GC_MALLOC(this->maxLen * sizeof(class_Any*));
+	this->values = (class_Any**)GC_MALLOC(this->maxLen * sizeof(class_Any*));
+
+	// GC_MALLOC hands out cleared memory, so all buckets start out empty
+	this->bucketCount = 16;
+	this->buckets     = (int*)GC_MALLOC(this->bucketCount * sizeof(int));
+}
+
+// boxed primitives are compared by their value
+// if the key is one of them, its value is put into *bits
+bool Map_KeyBits(class_Any* key, long *bits) {
+	const char *name = key->vtable.className;
+
+	if (strcmp(name, "Int") == 0 || strcmp(name, "Uint") == 0) {
+		*bits = ((class_Int*)key)->value;
+		return true;
+	}
+
+	if (strcmp(name, "Long") == 0 || strcmp(name, "Ulong") == 0) {
+		*bits = ((class_Long*)key)->value;
+		return true;
+	}
+
+	if (strcmp(name, "Byte") == 0) {
+		*bits = ((class_Byte*)key)->value;
+		return true;
+	}
+
+	if (strcmp(name, "Bool") == 0) {
+		*bits = ((class_Bool*)key)->value;
+		return true;
+	}
+
+	// floats and doubles are both stored as a float
+	if (strcmp(name, "Float") == 0 || strcmp(name, "Double") == 0) {
+		*bits = 0;
+		memcpy(bits, &((class_Float*)key)->value, sizeof(float));
+		return true;
+	}
+
+	return false;
+}
+
+// hashes a key (strings by content, boxed primitives by value, everything else by address)
+unsigned int Map_Hash(class_Any* key) {
+	long bits;
+
+	if (key == NULL)
+		return 0;
+
+	// FNV-1a over the string's buffer
+	if (strcmp(key->vtable.className, "String") == 0) {
+		class_String *str = (class_String*)key;
+		unsigned int hash = 2166136261u;
+
+		for (int i = 0; i < str->length; i++) {
+			hash ^= (unsigned char)str->buffer[i];
+			hash *= 16777619u;
+		}
+
+		return hash;
+	}
+
+	if (!Map_KeyBits(key, &bits))
+		bits = (long)key;
+
+	return (unsigned int)(bits ^ (bits >> 32)) * 2654435761u;
+}
+
+// checks if two keys are the same
+bool Map_KeysEqual(class_Any* a, class_Any* b) {
+	long bitsA, bitsB;
+
+	if (a == b)
+		return true;
+
+	if (a == NULL || b == NULL)
+		return false;
+
+	// keys of different types are never equal
+	if (strcmp(a->vtable.className, b->vtable.className) != 0)
+		return false;
+
+	if (strcmp(a->vtable.className, "String") == 0)
+		return String_public_Equal((class_String*)a, (class_String*)b);
+
+	if (Map_KeyBits(a, &bitsA) && Map_KeyBits(b, &bitsB))
+		return bitsA == bitsB;
+
+	return false;
+}
+
+// finds the index of a key's entry, -1 if it isnt in here
+int Map_Find(class_Map* this, class_Any* key) {
+	int mask = this->bucketCount - 1;
+	int slot = Map_Hash(key) & mask;
+
+	while (this->buckets[slot] != 0) {
+		int index = this->buckets[slot] - 1;
+
+		if (Map_KeysEqual(this->keys[index], key))
+			return index;
+
+		slot = (slot + 1) & mask;
+	}
+
+	return -1;
+}
+
+// puts an entry into the hash index
+void Map_Index(class_Map* this, int index) {
+	int mask = this->bucketCount - 1;
+	int slot = Map_Hash(this->keys[index]) & mask;
+
+	while (this->buckets[slot] != 0)
+		slot = (slot + 1) & mask;
+
+	this->buckets[slot] = index + 1;
+}
+
+// throws away the hash index and builds a new one
+void Map_Reindex(class_Map* this, int bucketCount) {
+	this->bucketCount = bucketCount;
+	this->buckets     = (int*)GC_MALLOC(bucketCount * sizeof(int));
+
+	for (int i = 0; i < this->length; i++)
+		Map_Index(this, i);
+}
+
+// definition for a element access
+class_Any* Map_public_Get(class_Map* this, class_Any* key) {
+	int index = Map_Find(this, key);
+
+	if (index == -1)
+		exc_Throw("Key not found in map!");
+
+	return this->values[index];
+}
+
+// definition for a element assignment
+void Map_public_Set(class_Map* this, class_Any* key, class_Any* value) {
+	int index = Map_Find(this, key);
+
+	// if this key already exists, just replace its value
+	if (index != -1) {
+		this->values[index] = value;
+		return;
+	}
+
+	// if the entry buffers need to grow
+	if (this->length == this->maxLen) {
+		this->maxLen *= 2;
+
+		this->keys   = (class_Any**)GC_REALLOC(this->keys, this->maxLen * sizeof(class_Any*));
+		this->values = (class_Any**)GC_REALLOC(this->values, this->maxLen * sizeof(class_Any*));
+	}
+
+	this->keys[this->length]   = key;
+	this->values[this->length] = value;
+	this->length++;
+
+	// keep the hash index at most half full
+	if (this->length * 2 > this->bucketCount)
+		Map_Reindex(this, this->bucketCount * 2);
+	else
+		Map_Index(this, this->length - 1);
+}
+
+// definition for a map.Has() method
+bool Map_public_Has(class_Map* this, class_Any* key) {
+	return Map_Find(this, key) != -1;
+}
+
+// definition for a map.Remove() method
+void Map_public_Remove(class_Map* this, class_Any* key) {
+	int index = Map_Find(this, key);
+
+	if (index == -1)
+		return;
+
+	// move everything behind it down a slot, that way the insertion order stays intact
+	for (int i = index; i < this->length - 1; i++) {
+		this->keys[i]   = this->keys[i + 1];
+		this->values[i] = this->values[i + 1];
+	}
+
+	this->length--;
+
+	// all the indices changed, so the hash index has to be rebuilt
+	Map_Reindex(this, this->bucketCount);
+}
+
+// definition for map length
+int Map_public_GetLength(class_Map* this) {
+	return this->length;
+}
+
+// definition for key access by index (used for map.Keys())
+class_Any* Map_public_GetKey(class_Map* this, int index) {
+	if (index < 0 || index >= this->length)
+		exc_Throw("Map index out of range!");
+
+	return this->keys[index];
+}
+
 // -----------------------------------------------------------------------------
 // "thread" object type
 // Note: this uses the pthread library. Make sure executable is compiled with flag -lpthread
@@ -20,6 +20,7 @@ typedef struct class_Double    class_Double;
 typedef struct class_Bool      class_Bool;
 typedef struct class_Array     class_Array;
 typedef struct class_pArray    class_pArray;
+typedef struct class_Map       class_Map;
 typedef struct class_Thread    class_Thread;
 
 // declare all constructors
@@ -33,6 +34,7 @@ void Double_public_Constructor(class_Double*, double);
 void Bool_public_Constructor(class_Bool*, bool);
 void Array_public_Constructor(class_Array*, int);
 void pArray_public_Constructor(class_pArray*, int, int);
+void Map_public_Constructor(class_Map*);
 
 // -----------------------------------------------------------------------------
 // standard vTable, this is the base requirement for all vtables
@@ -81,6 +83,7 @@ bool String_public_Equal(class_String*, class_String*);
 char *String_public_GetBuffer(class_String*);
 int String_public_GetLength(class_String*);
 class_String *String_public_Substring(class_String*, int, int);
+class_String *String_public_Join(class_String**, int);
 
 // -----------------------------------------------------------------------------
 // "int" object type
@@ -240,6 +243,31 @@ DEFINE_PARRAY(Byte);
 DEFINE_PARRAY(Int);
 DEFINE_PARRAY(Float);
 
+// -----------------------------------------------------------------------------
+// "map" object type
+// Note: maps keys to values, both are stored as objects (primitives get boxed)
+// boxed primitives and strings are compared by value, everything else by reference
+// -----------------------------------------------------------------------------
+
+// the objects struct
+struct class_Map {
+	Standard_vTable vtable;  // our vTable
+	class_Any **keys;        // keys in insertion order
+	class_Any **values;      // values belonging to those keys
+	int length;              // number of entries
+	int maxLen;              // buffer length
+	int *buckets;            // hash index into keys/values (entry index + 1, 0 means empty)
+	int bucketCount;         // always a power of two
+};
+
+// the objects methods
+class_Any* Map_public_Get(class_Map*, class_Any*);
+void Map_public_Set(class_Map*, class_Any*, class_Any*);
+bool Map_public_Has(class_Map*, class_Any*);
+void Map_public_Remove(class_Map*, class_Any*);
+int Map_public_GetLength(class_Map*);
+class_Any* Map_public_GetKey(class_Map*, int);
+
 // -----------------------------------------------------------------------------
 // base "thread" object type
 // Note: Multithreading!
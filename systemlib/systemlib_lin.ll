@@ -4,6 +4,9 @@ target datalayout = "e-m:e-p270:32:32-p271:32:32-p272:64:64-i64:64-f80:128-n8:16
 target triple = "x86_64-pc-linux-gnu"
 
 %struct.Standard_vTable = type { i8*, i8*, i8* }
+%struct.exc_Handler = type { %struct.exc_Handler*, [1 x %struct.__jmp_buf_tag] }
+%struct.__jmp_buf_tag = type { [8 x i64], i32, %struct.__sigset_t }
+%struct.__sigset_t = type { [16 x i64] }
 %struct.class_Any = type { %struct.Standard_vTable }
 %struct.class_String = type { %struct.Standard_vTable, i8*, i32, i32, i32 }
 %struct.class_Int = type { %struct.Standard_vTable, i32 }
@@ -12,6 +15,7 @@ target triple = "x86_64-pc-linux-gnu"
 %struct.class_Float = type { %struct.Standard_vTable, float }
 %struct.class_Array = type { %struct.Standard_vTable, %struct.class_Any**, i32, i32, i32 }
 %struct.class_pArray = type { %struct.Standard_vTable, i8*, i32, i32, i32, i32 }
+%struct.class_Map = type { %struct.Standard_vTable, %struct.class_Any**, %struct.class_Any**, i32, i32, i32*, i32 }
 %struct.class_Thread = type { %struct.Standard_vTable, i8* (i8*)*, %struct.class_Array*, i64 }
 %union.pthread_attr_t = type { i64, [48 x i8] }
 
@@ -39,21 +43,29 @@ target triple = "x86_64-pc-linux-gnu"
 @.str.12 = private unnamed_addr constant [26 x i8] c"Array index out of range!\00", align 1
 @.str.13 = private unnamed_addr constant [7 x i8] c"pArray\00", align 1
 @pArray_vTable_Const = dso_local constant %struct.Standard_vTable { i8* bitcast (%struct.Standard_vTable* @Any_vTable_Const to i8*), i8* getelementptr inbounds ([7 x i8], [7 x i8]* @.str.13, i32 0, i32 0), i8* null }, align 8
+@.str.15 = private unnamed_addr constant [4 x i8] c"Map\00", align 1
+@Map_vTable_Const = dso_local constant %struct.Standard_vTable { i8* bitcast (%struct.Standard_vTable* @Any_vTable_Const to i8*), i8* getelementptr inbounds ([4 x i8], [4 x i8]* @.str.15, i32 0, i32 0), i8* null }, align 8
+@.str.16 = private unnamed_addr constant [5 x i8] c"Uint\00", align 1
+@.str.17 = private unnamed_addr constant [6 x i8] c"Ulong\00", align 1
+@.str.18 = private unnamed_addr constant [22 x i8] c"Key not found in map!\00", align 1
+@.str.19 = private unnamed_addr constant [24 x i8] c"Map index out of range!\00", align 1
 @.str.14 = private unnamed_addr constant [7 x i8] c"Thread\00", align 1
 @Thread_vTable_Const = dso_local constant %struct.Standard_vTable { i8* bitcast (%struct.Standard_vTable* @Any_vTable_Const to i8*), i8* getelementptr inbounds ([7 x i8], [7 x i8]* @.str.14, i32 0, i32 0), i8* null }, align 8
-@.str.15 = private unnamed_addr constant [45 x i8] c"%s[RUNTIME] %sEncountered Exception! %s'%s'\0A\00", align 1
-@.str.1.16 = private unnamed_addr constant [8 x i8] c"\1B[1;31m\00", align 1
-@.str.2.17 = private unnamed_addr constant [8 x i8] c"\1B[0;31m\00", align 1
-@.str.3.18 = private unnamed_addr constant [19 x i8] c"%s[STACKTRACE] %s\0A\00", align 1
-@.str.4.19 = private unnamed_addr constant [8 x i8] c"\1B[1;33m\00", align 1
-@.str.5.20 = private unnamed_addr constant [8 x i8] c"\1B[0;33m\00", align 1
-@.str.6.21 = private unnamed_addr constant [4 x i8] c".so\00", align 1
-@.str.7.22 = private unnamed_addr constant [5 x i8] c".dll\00", align 1
-@.str.8.23 = private unnamed_addr constant [4 x i8] c"%s\0A\00", align 1
-@.str.9.24 = private unnamed_addr constant [54 x i8] c"Null-Pointer exception! The given reference was null.\00", align 1
-@.str.10.25 = private unnamed_addr constant [90 x i8] c"Conversion vTable for output type could not be found! This indicates a broken executable.\00", align 1
-@.str.11.26 = private unnamed_addr constant [4 x i8] c"Any\00", align 1
-@.str.12.27 = private unnamed_addr constant [50 x i8] c"Object of type %s could not be casted to type %s!\00", align 1
+@exc_CurrentHandler = internal thread_local global %struct.exc_Handler* null, align 8
+@exc_CurrentMessage = internal thread_local global i8* null, align 8
+@.str.20 = private unnamed_addr constant [45 x i8] c"%s[RUNTIME] %sEncountered Exception! %s'%s'\0A\00", align 1
+@.str.1.21 = private unnamed_addr constant [8 x i8] c"\1B[1;31m\00", align 1
+@.str.2.22 = private unnamed_addr constant [8 x i8] c"\1B[0;31m\00", align 1
+@.str.3.23 = private unnamed_addr constant [19 x i8] c"%s[STACKTRACE] %s\0A\00", align 1
+@.str.4.24 = private unnamed_addr constant [8 x i8] c"\1B[1;33m\00", align 1
+@.str.5.25 = private unnamed_addr constant [8 x i8] c"\1B[0;33m\00", align 1
+@.str.6.26 = private unnamed_addr constant [4 x i8] c".so\00", align 1
+@.str.7.27 = private unnamed_addr constant [5 x i8] c".dll\00", align 1
+@.str.8.28 = private unnamed_addr constant [4 x i8] c"%s\0A\00", align 1
+@.str.9.29 = private unnamed_addr constant [54 x i8] c"Null-Pointer exception! The given reference was null.\00", align 1
+@.str.10.30 = private unnamed_addr constant [90 x i8] c"Conversion vTable for output type could not be found! This indicates a broken executable.\00", align 1
+@.str.11.31 = private unnamed_addr constant [4 x i8] c"Any\00", align 1
+@.str.12.32 = private unnamed_addr constant [50 x i8] c"Object of type %s could not be casted to type %s!\00", align 1
 
 ; Function Attrs: noinline nounwind optnone sspstrong uwtable
 define dso_local void @Any_public_Constructor(%struct.class_Any* noundef %0) #0 {
@@ -432,6 +444,139 @@ define dso_local %struct.class_String* @String_public_Substring(%struct.class_St
   ret %struct.class_String* %62
 }
 
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local %struct.class_String* @String_public_Join(%struct.class_String** noundef %0, i32 noundef %1) #0 {
+  %3 = alloca %struct.class_String**, align 8
+  %4 = alloca i32, align 4
+  %5 = alloca i32, align 4
+  %6 = alloca i32, align 4
+  %7 = alloca i8*, align 8
+  %8 = alloca i32, align 4
+  %9 = alloca i32, align 4
+  %10 = alloca %struct.class_String*, align 8
+  store %struct.class_String** %0, %struct.class_String*** %3, align 8
+  store i32 %1, i32* %4, align 4
+  store i32 0, i32* %5, align 4
+  store i32 0, i32* %6, align 4
+  br label %11
+
+11:                                               ; preds = %32, %2
+  %12 = load i32, i32* %6, align 4
+  %13 = load i32, i32* %4, align 4
+  %14 = icmp slt i32 %12, %13
+  br i1 %14, label %15, label %35
+
+15:                                               ; preds = %11
+  %16 = load %struct.class_String**, %struct.class_String*** %3, align 8
+  %17 = load i32, i32* %6, align 4
+  %18 = sext i32 %17 to i64
+  %19 = getelementptr inbounds %struct.class_String*, %struct.class_String** %16, i64 %18
+  %20 = load %struct.class_String*, %struct.class_String** %19, align 8
+  %21 = icmp ne %struct.class_String* %20, null
+  br i1 %21, label %22, label %32
+
+22:                                               ; preds = %15
+  %23 = load %struct.class_String**, %struct.class_String*** %3, align 8
+  %24 = load i32, i32* %6, align 4
+  %25 = sext i32 %24 to i64
+  %26 = getelementptr inbounds %struct.class_String*, %struct.class_String** %23, i64 %25
+  %27 = load %struct.class_String*, %struct.class_String** %26, align 8
+  %28 = getelementptr inbounds %struct.class_String, %struct.class_String* %27, i32 0, i32 2
+  %29 = load i32, i32* %28, align 8
+  %30 = load i32, i32* %5, align 4
+  %31 = add nsw i32 %30, %29
+  store i32 %31, i32* %5, align 4
+  br label %32
+
+32:                                               ; preds = %22, %15
+  %33 = load i32, i32* %6, align 4
+  %34 = add nsw i32 %33, 1
+  store i32 %34, i32* %6, align 4
+  br label %11
+
+35:                                               ; preds = %11
+  %36 = load i32, i32* %5, align 4
+  %37 = add nsw i32 %36, 1
+  %38 = sext i32 %37 to i64
+  %39 = call noalias i8* @GC_malloc(i64 noundef %38) #9
+  store i8* %39, i8** %7, align 8
+  store i32 0, i32* %8, align 4
+  store i32 0, i32* %9, align 4
+  br label %40
+
+40:                                               ; preds = %68, %35
+  %41 = load i32, i32* %9, align 4
+  %42 = load i32, i32* %4, align 4
+  %43 = icmp slt i32 %41, %42
+  br i1 %43, label %44, label %71
+
+44:                                               ; preds = %40
+  %45 = load %struct.class_String**, %struct.class_String*** %3, align 8
+  %46 = load i32, i32* %9, align 4
+  %47 = sext i32 %46 to i64
+  %48 = getelementptr inbounds %struct.class_String*, %struct.class_String** %45, i64 %47
+  %49 = load %struct.class_String*, %struct.class_String** %48, align 8
+  %50 = icmp eq %struct.class_String* %49, null
+  br i1 %50, label %68, label %51
+
+51:                                               ; preds = %44
+  %52 = load i8*, i8** %7, align 8
+  %53 = load i32, i32* %8, align 4
+  %54 = sext i32 %53 to i64
+  %55 = getelementptr inbounds i8, i8* %52, i64 %54
+  %56 = load %struct.class_String**, %struct.class_String*** %3, align 8
+  %57 = load i32, i32* %9, align 4
+  %58 = sext i32 %57 to i64
+  %59 = getelementptr inbounds %struct.class_String*, %struct.class_String** %56, i64 %58
+  %60 = load %struct.class_String*, %struct.class_String** %59, align 8
+  %61 = getelementptr inbounds %struct.class_String, %struct.class_String* %60, i32 0, i32 1
+  %62 = load i8*, i8** %61, align 8
+  %63 = getelementptr inbounds %struct.class_String, %struct.class_String* %60, i32 0, i32 2
+  %64 = load i32, i32* %63, align 8
+  %65 = sext i32 %64 to i64
+  call void @llvm.memcpy.p0i8.p0i8.i64(i8* align 1 %55, i8* align 1 %62, i64 %65, i1 false)
+  %66 = load i32, i32* %8, align 4
+  %67 = add nsw i32 %66, %64
+  store i32 %67, i32* %8, align 4
+  br label %68
+
+68:                                               ; preds = %51, %44
+  %69 = load i32, i32* %9, align 4
+  %70 = add nsw i32 %69, 1
+  store i32 %70, i32* %9, align 4
+  br label %40
+
+71:                                               ; preds = %40
+  %72 = load i8*, i8** %7, align 8
+  %73 = load i32, i32* %5, align 4
+  %74 = sext i32 %73 to i64
+  %75 = getelementptr inbounds i8, i8* %72, i64 %74
+  store i8 0, i8* %75, align 1
+  %76 = call noalias i8* @GC_malloc(i64 noundef 48) #9
+  %77 = bitcast i8* %76 to %struct.class_String*
+  store %struct.class_String* %77, %struct.class_String** %10, align 8
+  %78 = load %struct.class_String*, %struct.class_String** %10, align 8
+  %79 = getelementptr inbounds %struct.class_String, %struct.class_String* %78, i32 0, i32 0
+  %80 = bitcast %struct.Standard_vTable* %79 to i8*
+  call void @llvm.memcpy.p0i8.p0i8.i64(i8* align 8 %80, i8* align 8 bitcast (%struct.Standard_vTable* @String_vTable_Const to i8*), i64 24, i1 false)
+  %81 = load %struct.class_String*, %struct.class_String** %10, align 8
+  call void @String_public_Constructor(%struct.class_String* noundef %81)
+  %82 = load i8*, i8** %7, align 8
+  %83 = load %struct.class_String*, %struct.class_String** %10, align 8
+  %84 = getelementptr inbounds %struct.class_String, %struct.class_String* %83, i32 0, i32 1
+  store i8* %82, i8** %84, align 8
+  %85 = load i32, i32* %5, align 4
+  %86 = load %struct.class_String*, %struct.class_String** %10, align 8
+  %87 = getelementptr inbounds %struct.class_String, %struct.class_String* %86, i32 0, i32 2
+  store i32 %85, i32* %87, align 8
+  %88 = load i32, i32* %5, align 4
+  %89 = load %struct.class_String*, %struct.class_String** %10, align 8
+  %90 = getelementptr inbounds %struct.class_String, %struct.class_String* %89, i32 0, i32 3
+  store i32 %88, i32* %90, align 4
+  %91 = load %struct.class_String*, %struct.class_String** %10, align 8
+  ret %struct.class_String* %91
+}
+
 ; Function Attrs: noinline nounwind optnone sspstrong uwtable
 define dso_local void @Int_public_Constructor(%struct.class_Int* noundef %0, i32 noundef %1) #0 {
   %3 = alloca %struct.class_Int*, align 8
@@ -1085,6 +1230,503 @@ define dso_local i8* @pArray_public_GetElementPtr(%struct.class_pArray* noundef
   ret i8* %24
 }
 
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @Map_public_Constructor(%struct.class_Map* noundef %0) #0 {
+  %2 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  store i32 0, i32* %2, align 8
+  %3 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 4
+  store i32 8, i32* %3, align 4
+  %4 = call noalias i8* @GC_malloc(i64 noundef 64) #9
+  %5 = bitcast i8* %4 to %struct.class_Any**
+  %6 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  store %struct.class_Any** %5, %struct.class_Any*** %6, align 8
+  %7 = call noalias i8* @GC_malloc(i64 noundef 64) #9
+  %8 = bitcast i8* %7 to %struct.class_Any**
+  %9 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 2
+  store %struct.class_Any** %8, %struct.class_Any*** %9, align 8
+  %10 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  store i32 16, i32* %10, align 8
+  %11 = call noalias i8* @GC_malloc(i64 noundef 64) #9
+  %12 = bitcast i8* %11 to i32*
+  %13 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 5
+  store i32* %12, i32** %13, align 8
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local zeroext i1 @Map_KeyBits(%struct.class_Any* noundef %0, i64* noundef %1) #0 {
+  %3 = getelementptr inbounds %struct.class_Any, %struct.class_Any* %0, i32 0, i32 0, i32 1
+  %4 = load i8*, i8** %3, align 8
+  %5 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.5, i64 0, i64 0)) #8
+  %6 = icmp eq i32 %5, 0
+  br i1 %6, label %10, label %7
+
+7:                                                ; preds = %2
+  %8 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.16, i64 0, i64 0)) #8
+  %9 = icmp eq i32 %8, 0
+  br i1 %9, label %10, label %15
+
+10:                                               ; preds = %7, %2
+  %11 = bitcast %struct.class_Any* %0 to %struct.class_Int*
+  %12 = getelementptr inbounds %struct.class_Int, %struct.class_Int* %11, i32 0, i32 1
+  %13 = load i32, i32* %12, align 8
+  %14 = sext i32 %13 to i64
+  store i64 %14, i64* %1, align 8
+  ret i1 true
+
+15:                                               ; preds = %7
+  %16 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.7, i64 0, i64 0)) #8
+  %17 = icmp eq i32 %16, 0
+  br i1 %17, label %21, label %18
+
+18:                                               ; preds = %15
+  %19 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([6 x i8], [6 x i8]* @.str.17, i64 0, i64 0)) #8
+  %20 = icmp eq i32 %19, 0
+  br i1 %20, label %21, label %25
+
+21:                                               ; preds = %18, %15
+  %22 = bitcast %struct.class_Any* %0 to %struct.class_Long*
+  %23 = getelementptr inbounds %struct.class_Long, %struct.class_Long* %22, i32 0, i32 1
+  %24 = load i64, i64* %23, align 8
+  store i64 %24, i64* %1, align 8
+  ret i1 true
+
+25:                                               ; preds = %18
+  %26 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.6, i64 0, i64 0)) #8
+  %27 = icmp eq i32 %26, 0
+  br i1 %27, label %28, label %33
+
+28:                                               ; preds = %25
+  %29 = bitcast %struct.class_Any* %0 to %struct.class_Byte*
+  %30 = getelementptr inbounds %struct.class_Byte, %struct.class_Byte* %29, i32 0, i32 1
+  %31 = load i8, i8* %30, align 8
+  %32 = sext i8 %31 to i64
+  store i64 %32, i64* %1, align 8
+  ret i1 true
+
+33:                                               ; preds = %25
+  %34 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.10, i64 0, i64 0)) #8
+  %35 = icmp eq i32 %34, 0
+  br i1 %35, label %36, label %42
+
+36:                                               ; preds = %33
+  %37 = bitcast %struct.class_Any* %0 to %struct.class_Byte*
+  %38 = getelementptr inbounds %struct.class_Byte, %struct.class_Byte* %37, i32 0, i32 1
+  %39 = load i8, i8* %38, align 8
+  %40 = and i8 %39, 1
+  %41 = zext i8 %40 to i64
+  store i64 %41, i64* %1, align 8
+  ret i1 true
+
+42:                                               ; preds = %33
+  %43 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([6 x i8], [6 x i8]* @.str.8, i64 0, i64 0)) #8
+  %44 = icmp eq i32 %43, 0
+  br i1 %44, label %48, label %45
+
+45:                                               ; preds = %42
+  %46 = call i32 @strcmp(i8* noundef %4, i8* noundef getelementptr inbounds ([7 x i8], [7 x i8]* @.str.9, i64 0, i64 0)) #8
+  %47 = icmp eq i32 %46, 0
+  br i1 %47, label %48, label %53
+
+48:                                               ; preds = %45, %42
+  store i64 0, i64* %1, align 8
+  %49 = bitcast %struct.class_Any* %0 to %struct.class_Float*
+  %50 = getelementptr inbounds %struct.class_Float, %struct.class_Float* %49, i32 0, i32 1
+  %51 = bitcast float* %50 to i8*
+  %52 = bitcast i64* %1 to i8*
+  call void @llvm.memcpy.p0i8.p0i8.i64(i8* align 8 %52, i8* align 8 %51, i64 4, i1 false)
+  ret i1 true
+
+53:                                               ; preds = %45
+  ret i1 false
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local i32 @Map_Hash(%struct.class_Any* noundef %0) #0 {
+  %2 = alloca i64, align 8
+  %3 = icmp eq %struct.class_Any* %0, null
+  br i1 %3, label %4, label %5
+
+4:                                                ; preds = %1
+  ret i32 0
+
+5:                                                ; preds = %1
+  %6 = getelementptr inbounds %struct.class_Any, %struct.class_Any* %0, i32 0, i32 0, i32 1
+  %7 = load i8*, i8** %6, align 8
+  %8 = call i32 @strcmp(i8* noundef %7, i8* noundef getelementptr inbounds ([7 x i8], [7 x i8]* @.str.1, i64 0, i64 0)) #8
+  %9 = icmp eq i32 %8, 0
+  br i1 %9, label %10, label %29
+
+10:                                               ; preds = %5
+  %11 = bitcast %struct.class_Any* %0 to %struct.class_String*
+  %12 = getelementptr inbounds %struct.class_String, %struct.class_String* %11, i32 0, i32 1
+  %13 = load i8*, i8** %12, align 8
+  %14 = getelementptr inbounds %struct.class_String, %struct.class_String* %11, i32 0, i32 2
+  %15 = load i32, i32* %14, align 8
+  br label %16
+
+16:                                               ; preds = %20, %10
+  %17 = phi i32 [ 0, %10 ], [ %27, %20 ]
+  %18 = phi i32 [ -2128831035, %10 ], [ %26, %20 ]
+  %19 = icmp slt i32 %17, %15
+  br i1 %19, label %20, label %28
+
+20:                                               ; preds = %16
+  %21 = sext i32 %17 to i64
+  %22 = getelementptr inbounds i8, i8* %13, i64 %21
+  %23 = load i8, i8* %22, align 1
+  %24 = zext i8 %23 to i32
+  %25 = xor i32 %18, %24
+  %26 = mul i32 %25, 16777619
+  %27 = add nsw i32 %17, 1
+  br label %16
+
+28:                                               ; preds = %16
+  ret i32 %18
+
+29:                                               ; preds = %5
+  %30 = call zeroext i1 @Map_KeyBits(%struct.class_Any* noundef %0, i64* noundef %2)
+  br i1 %30, label %33, label %31
+
+31:                                               ; preds = %29
+  %32 = ptrtoint %struct.class_Any* %0 to i64
+  store i64 %32, i64* %2, align 8
+  br label %33
+
+33:                                               ; preds = %31, %29
+  %34 = load i64, i64* %2, align 8
+  %35 = ashr i64 %34, 32
+  %36 = xor i64 %34, %35
+  %37 = trunc i64 %36 to i32
+  %38 = mul i32 %37, -1640531535
+  ret i32 %38
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local zeroext i1 @Map_KeysEqual(%struct.class_Any* noundef %0, %struct.class_Any* noundef %1) #0 {
+  %3 = alloca i64, align 8
+  %4 = alloca i64, align 8
+  %5 = icmp eq %struct.class_Any* %0, %1
+  br i1 %5, label %32, label %6
+
+6:                                                ; preds = %2
+  %7 = icmp eq %struct.class_Any* %0, null
+  %8 = icmp eq %struct.class_Any* %1, null
+  %9 = or i1 %7, %8
+  br i1 %9, label %33, label %10
+
+10:                                               ; preds = %6
+  %11 = getelementptr inbounds %struct.class_Any, %struct.class_Any* %0, i32 0, i32 0, i32 1
+  %12 = load i8*, i8** %11, align 8
+  %13 = getelementptr inbounds %struct.class_Any, %struct.class_Any* %1, i32 0, i32 0, i32 1
+  %14 = load i8*, i8** %13, align 8
+  %15 = call i32 @strcmp(i8* noundef %12, i8* noundef %14) #8
+  %16 = icmp eq i32 %15, 0
+  br i1 %16, label %17, label %33
+
+17:                                               ; preds = %10
+  %18 = call i32 @strcmp(i8* noundef %12, i8* noundef getelementptr inbounds ([7 x i8], [7 x i8]* @.str.1, i64 0, i64 0)) #8
+  %19 = icmp eq i32 %18, 0
+  br i1 %19, label %20, label %24
+
+20:                                               ; preds = %17
+  %21 = bitcast %struct.class_Any* %0 to %struct.class_String*
+  %22 = bitcast %struct.class_Any* %1 to %struct.class_String*
+  %23 = call zeroext i1 @String_public_Equal(%struct.class_String* noundef %21, %struct.class_String* noundef %22)
+  ret i1 %23
+
+24:                                               ; preds = %17
+  %25 = call zeroext i1 @Map_KeyBits(%struct.class_Any* noundef %0, i64* noundef %3)
+  br i1 %25, label %26, label %33
+
+26:                                               ; preds = %24
+  %27 = call zeroext i1 @Map_KeyBits(%struct.class_Any* noundef %1, i64* noundef %4)
+  br i1 %27, label %28, label %33
+
+28:                                               ; preds = %26
+  %29 = load i64, i64* %3, align 8
+  %30 = load i64, i64* %4, align 8
+  %31 = icmp eq i64 %29, %30
+  ret i1 %31
+
+32:                                               ; preds = %2
+  ret i1 true
+
+33:                                               ; preds = %26, %24, %10, %6
+  ret i1 false
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local i32 @Map_Find(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1) #0 {
+  %3 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  %4 = load i32, i32* %3, align 8
+  %5 = sub nsw i32 %4, 1
+  %6 = call i32 @Map_Hash(%struct.class_Any* noundef %1)
+  %7 = and i32 %6, %5
+  %8 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 5
+  %9 = load i32*, i32** %8, align 8
+  %10 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  %11 = load %struct.class_Any**, %struct.class_Any*** %10, align 8
+  br label %12
+
+12:                                               ; preds = %24, %2
+  %13 = phi i32 [ %7, %2 ], [ %26, %24 ]
+  %14 = sext i32 %13 to i64
+  %15 = getelementptr inbounds i32, i32* %9, i64 %14
+  %16 = load i32, i32* %15, align 4
+  %17 = icmp eq i32 %16, 0
+  br i1 %17, label %28, label %18
+
+18:                                               ; preds = %12
+  %19 = sub nsw i32 %16, 1
+  %20 = sext i32 %19 to i64
+  %21 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %11, i64 %20
+  %22 = load %struct.class_Any*, %struct.class_Any** %21, align 8
+  %23 = call zeroext i1 @Map_KeysEqual(%struct.class_Any* noundef %22, %struct.class_Any* noundef %1)
+  br i1 %23, label %27, label %24
+
+24:                                               ; preds = %18
+  %25 = add nsw i32 %13, 1
+  %26 = and i32 %25, %5
+  br label %12
+
+27:                                               ; preds = %18
+  ret i32 %19
+
+28:                                               ; preds = %12
+  ret i32 -1
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @Map_Index(%struct.class_Map* noundef %0, i32 noundef %1) #0 {
+  %3 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  %4 = load i32, i32* %3, align 8
+  %5 = sub nsw i32 %4, 1
+  %6 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  %7 = load %struct.class_Any**, %struct.class_Any*** %6, align 8
+  %8 = sext i32 %1 to i64
+  %9 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %7, i64 %8
+  %10 = load %struct.class_Any*, %struct.class_Any** %9, align 8
+  %11 = call i32 @Map_Hash(%struct.class_Any* noundef %10)
+  %12 = and i32 %11, %5
+  %13 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 5
+  %14 = load i32*, i32** %13, align 8
+  br label %15
+
+15:                                               ; preds = %21, %2
+  %16 = phi i32 [ %12, %2 ], [ %23, %21 ]
+  %17 = sext i32 %16 to i64
+  %18 = getelementptr inbounds i32, i32* %14, i64 %17
+  %19 = load i32, i32* %18, align 4
+  %20 = icmp eq i32 %19, 0
+  br i1 %20, label %24, label %21
+
+21:                                               ; preds = %15
+  %22 = add nsw i32 %16, 1
+  %23 = and i32 %22, %5
+  br label %15
+
+24:                                               ; preds = %15
+  %25 = add nsw i32 %1, 1
+  store i32 %25, i32* %18, align 4
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @Map_Reindex(%struct.class_Map* noundef %0, i32 noundef %1) #0 {
+  %3 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  store i32 %1, i32* %3, align 8
+  %4 = sext i32 %1 to i64
+  %5 = mul i64 %4, 4
+  %6 = call noalias i8* @GC_malloc(i64 noundef %5) #9
+  %7 = bitcast i8* %6 to i32*
+  %8 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 5
+  store i32* %7, i32** %8, align 8
+  %9 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  br label %10
+
+10:                                               ; preds = %14, %2
+  %11 = phi i32 [ 0, %2 ], [ %15, %14 ]
+  %12 = load i32, i32* %9, align 8
+  %13 = icmp slt i32 %11, %12
+  br i1 %13, label %14, label %16
+
+14:                                               ; preds = %10
+  call void @Map_Index(%struct.class_Map* noundef %0, i32 noundef %11)
+  %15 = add nsw i32 %11, 1
+  br label %10
+
+16:                                               ; preds = %10
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local %struct.class_Any* @Map_public_Get(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1) #0 {
+  %3 = call i32 @Map_Find(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1)
+  %4 = icmp eq i32 %3, -1
+  br i1 %4, label %5, label %6
+
+5:                                                ; preds = %2
+  call void @exc_Throw(i8* noundef getelementptr inbounds ([22 x i8], [22 x i8]* @.str.18, i64 0, i64 0))
+  br label %6
+
+6:                                                ; preds = %5, %2
+  %7 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 2
+  %8 = load %struct.class_Any**, %struct.class_Any*** %7, align 8
+  %9 = sext i32 %3 to i64
+  %10 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %8, i64 %9
+  %11 = load %struct.class_Any*, %struct.class_Any** %10, align 8
+  ret %struct.class_Any* %11
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @Map_public_Set(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1, %struct.class_Any* noundef %2) #0 {
+  %4 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  %5 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 2
+  %6 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  %7 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 4
+  %8 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  %9 = call i32 @Map_Find(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1)
+  %10 = icmp ne i32 %9, -1
+  br i1 %10, label %11, label %15
+
+11:                                               ; preds = %3
+  %12 = load %struct.class_Any**, %struct.class_Any*** %5, align 8
+  %13 = sext i32 %9 to i64
+  %14 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %12, i64 %13
+  store %struct.class_Any* %2, %struct.class_Any** %14, align 8
+  ret void
+
+15:                                               ; preds = %3
+  %16 = load i32, i32* %6, align 8
+  %17 = load i32, i32* %7, align 4
+  %18 = icmp eq i32 %16, %17
+  br i1 %18, label %19, label %31
+
+19:                                               ; preds = %15
+  %20 = mul nsw i32 %17, 2
+  store i32 %20, i32* %7, align 4
+  %21 = sext i32 %20 to i64
+  %22 = mul i64 %21, 8
+  %23 = load %struct.class_Any**, %struct.class_Any*** %4, align 8
+  %24 = bitcast %struct.class_Any** %23 to i8*
+  %25 = call i8* @GC_realloc(i8* noundef %24, i64 noundef %22) #11
+  %26 = bitcast i8* %25 to %struct.class_Any**
+  store %struct.class_Any** %26, %struct.class_Any*** %4, align 8
+  %27 = load %struct.class_Any**, %struct.class_Any*** %5, align 8
+  %28 = bitcast %struct.class_Any** %27 to i8*
+  %29 = call i8* @GC_realloc(i8* noundef %28, i64 noundef %22) #11
+  %30 = bitcast i8* %29 to %struct.class_Any**
+  store %struct.class_Any** %30, %struct.class_Any*** %5, align 8
+  br label %31
+
+31:                                               ; preds = %19, %15
+  %32 = load %struct.class_Any**, %struct.class_Any*** %4, align 8
+  %33 = load %struct.class_Any**, %struct.class_Any*** %5, align 8
+  %34 = sext i32 %16 to i64
+  %35 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %32, i64 %34
+  store %struct.class_Any* %1, %struct.class_Any** %35, align 8
+  %36 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %33, i64 %34
+  store %struct.class_Any* %2, %struct.class_Any** %36, align 8
+  %37 = add nsw i32 %16, 1
+  store i32 %37, i32* %6, align 8
+  %38 = mul nsw i32 %37, 2
+  %39 = load i32, i32* %8, align 8
+  %40 = icmp sgt i32 %38, %39
+  br i1 %40, label %41, label %43
+
+41:                                               ; preds = %31
+  %42 = mul nsw i32 %39, 2
+  call void @Map_Reindex(%struct.class_Map* noundef %0, i32 noundef %42)
+  ret void
+
+43:                                               ; preds = %31
+  call void @Map_Index(%struct.class_Map* noundef %0, i32 noundef %16)
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local zeroext i1 @Map_public_Has(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1) #0 {
+  %3 = call i32 @Map_Find(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1)
+  %4 = icmp ne i32 %3, -1
+  ret i1 %4
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @Map_public_Remove(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1) #0 {
+  %3 = call i32 @Map_Find(%struct.class_Map* noundef %0, %struct.class_Any* noundef %1)
+  %4 = icmp eq i32 %3, -1
+  br i1 %4, label %29, label %5
+
+5:                                                ; preds = %2
+  %6 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  %7 = load %struct.class_Any**, %struct.class_Any*** %6, align 8
+  %8 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 2
+  %9 = load %struct.class_Any**, %struct.class_Any*** %8, align 8
+  %10 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  %11 = load i32, i32* %10, align 8
+  %12 = sub nsw i32 %11, 1
+  br label %13
+
+13:                                               ; preds = %16, %5
+  %14 = phi i32 [ %3, %5 ], [ %17, %16 ]
+  %15 = icmp slt i32 %14, %12
+  br i1 %15, label %16, label %26
+
+16:                                               ; preds = %13
+  %17 = add nsw i32 %14, 1
+  %18 = sext i32 %14 to i64
+  %19 = sext i32 %17 to i64
+  %20 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %7, i64 %19
+  %21 = load %struct.class_Any*, %struct.class_Any** %20, align 8
+  %22 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %7, i64 %18
+  store %struct.class_Any* %21, %struct.class_Any** %22, align 8
+  %23 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %9, i64 %19
+  %24 = load %struct.class_Any*, %struct.class_Any** %23, align 8
+  %25 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %9, i64 %18
+  store %struct.class_Any* %24, %struct.class_Any** %25, align 8
+  br label %13
+
+26:                                               ; preds = %13
+  store i32 %12, i32* %10, align 8
+  %27 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 6
+  %28 = load i32, i32* %27, align 8
+  call void @Map_Reindex(%struct.class_Map* noundef %0, i32 noundef %28)
+  br label %29
+
+29:                                               ; preds = %26, %2
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local i32 @Map_public_GetLength(%struct.class_Map* noundef %0) #0 {
+  %2 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  %3 = load i32, i32* %2, align 8
+  ret i32 %3
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local %struct.class_Any* @Map_public_GetKey(%struct.class_Map* noundef %0, i32 noundef %1) #0 {
+  %3 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 3
+  %4 = load i32, i32* %3, align 8
+  %5 = icmp slt i32 %1, 0
+  %6 = icmp sge i32 %1, %4
+  %7 = or i1 %5, %6
+  br i1 %7, label %8, label %9
+
+8:                                                ; preds = %2
+  call void @exc_Throw(i8* noundef getelementptr inbounds ([24 x i8], [24 x i8]* @.str.19, i64 0, i64 0))
+  br label %9
+
+9:                                                ; preds = %8, %2
+  %10 = getelementptr inbounds %struct.class_Map, %struct.class_Map* %0, i32 0, i32 1
+  %11 = load %struct.class_Any**, %struct.class_Any*** %10, align 8
+  %12 = sext i32 %1 to i64
+  %13 = getelementptr inbounds %struct.class_Any*, %struct.class_Any** %11, i64 %12
+  %14 = load %struct.class_Any*, %struct.class_Any** %13, align 8
+  ret %struct.class_Any* %14
+}
+
 ; Function Attrs: noinline nounwind optnone sspstrong uwtable
 define dso_local void @Thread_public_Constructor(%struct.class_Thread* noundef %0, i8* (i8*)* noundef %1, %struct.class_Array* noundef %2) #0 {
   %4 = alloca %struct.class_Thread*, align 8
@@ -1149,88 +1791,148 @@ define dso_local void @Thread_public_Kill(%struct.class_Thread* noundef %0) #0 {
 
 declare i32 @GC_pthread_cancel(i64 noundef) #4
 
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @exc_PushHandler(%struct.exc_Handler* noundef %0) #0 {
+  %2 = alloca %struct.exc_Handler*, align 8
+  store %struct.exc_Handler* %0, %struct.exc_Handler** %2, align 8
+  %3 = load %struct.exc_Handler*, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  %4 = load %struct.exc_Handler*, %struct.exc_Handler** %2, align 8
+  %5 = getelementptr inbounds %struct.exc_Handler, %struct.exc_Handler* %4, i32 0, i32 0
+  store %struct.exc_Handler* %3, %struct.exc_Handler** %5, align 8
+  %6 = load %struct.exc_Handler*, %struct.exc_Handler** %2, align 8
+  store %struct.exc_Handler* %6, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local void @exc_PopHandler() #0 {
+  %1 = load %struct.exc_Handler*, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  %2 = icmp ne %struct.exc_Handler* %1, null
+  br i1 %2, label %3, label %7
+
+3:                                                ; preds = %0
+  %4 = load %struct.exc_Handler*, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  %5 = getelementptr inbounds %struct.exc_Handler, %struct.exc_Handler* %4, i32 0, i32 0
+  %6 = load %struct.exc_Handler*, %struct.exc_Handler** %5, align 8
+  store %struct.exc_Handler* %6, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  br label %7
+
+7:                                                ; preds = %3, %0
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone sspstrong uwtable
+define dso_local i8* @exc_GetMessage() #0 {
+  %1 = load i8*, i8** @exc_CurrentMessage, align 8
+  ret i8* %1
+}
+
 ; Function Attrs: noinline nounwind optnone sspstrong uwtable
 define dso_local void @exc_Throw(i8* noundef %0) #0 {
   %2 = alloca i8*, align 8
-  %3 = alloca [128 x i8*], align 16
-  %4 = alloca i32, align 4
-  %5 = alloca i8**, align 8
-  %6 = alloca i32, align 4
-  %7 = alloca i8*, align 8
+  %3 = alloca %struct.exc_Handler*, align 8
+  %4 = alloca [128 x i8*], align 16
+  %5 = alloca i32, align 4
+  %6 = alloca i8**, align 8
+  %7 = alloca i32, align 4
   %8 = alloca i8*, align 8
+  %9 = alloca i8*, align 8
   store i8* %0, i8** %2, align 8
-  %9 = load i8*, i8** %2, align 8
-  %10 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([45 x i8], [45 x i8]* @.str.15, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.1.16, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.2.17, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.1.16, i64 0, i64 0), i8* noundef %9)
-  %11 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([19 x i8], [19 x i8]* @.str.3.18, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.4.19, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.5.20, i64 0, i64 0))
-  %12 = getelementptr inbounds [128 x i8*], [128 x i8*]* %3, i64 0, i64 0
-  %13 = call i32 @backtrace(i8** noundef %12, i32 noundef 128)
-  store i32 %13, i32* %4, align 4
-  %14 = getelementptr inbounds [128 x i8*], [128 x i8*]* %3, i64 0, i64 0
-  %15 = load i32, i32* %4, align 4
-  %16 = call i8** @backtrace_symbols(i8** noundef %14, i32 noundef %15) #10
-  store i8** %16, i8*** %5, align 8
-  store i32 1, i32* %6, align 4
-  br label %17
-
-17:                                               ; preds = %48, %1
-  %18 = load i32, i32* %6, align 4
-  %19 = load i32, i32* %4, align 4
-  %20 = icmp slt i32 %18, %19
-  br i1 %20, label %21, label %51
-
-21:                                               ; preds = %17
-  %22 = load i8**, i8*** %5, align 8
-  %23 = load i32, i32* %6, align 4
-  %24 = sext i32 %23 to i64
-  %25 = getelementptr inbounds i8*, i8** %22, i64 %24
-  %26 = load i8*, i8** %25, align 8
-  %27 = call i8* @strstr(i8* noundef %26, i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.6.21, i64 0, i64 0)) #8
-  store i8* %27, i8** %7, align 8
-  %28 = load i8**, i8*** %5, align 8
-  %29 = load i32, i32* %6, align 4
-  %30 = sext i32 %29 to i64
-  %31 = getelementptr inbounds i8*, i8** %28, i64 %30
-  %32 = load i8*, i8** %31, align 8
-  %33 = call i8* @strstr(i8* noundef %32, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.7.22, i64 0, i64 0)) #8
-  store i8* %33, i8** %8, align 8
-  %34 = load i8*, i8** %7, align 8
-  %35 = icmp ne i8* %34, null
-  br i1 %35, label %36, label %37
+  %10 = load i8*, i8** %2, align 8
+  store i8* %10, i8** @exc_CurrentMessage, align 8
+  %11 = load %struct.exc_Handler*, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  %12 = icmp ne %struct.exc_Handler* %11, null
+  br i1 %12, label %13, label %21
+
+13:                                               ; preds = %1
+  %14 = load %struct.exc_Handler*, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  store %struct.exc_Handler* %14, %struct.exc_Handler** %3, align 8
+  %15 = load %struct.exc_Handler*, %struct.exc_Handler** %3, align 8
+  %16 = getelementptr inbounds %struct.exc_Handler, %struct.exc_Handler* %15, i32 0, i32 0
+  %17 = load %struct.exc_Handler*, %struct.exc_Handler** %16, align 8
+  store %struct.exc_Handler* %17, %struct.exc_Handler** @exc_CurrentHandler, align 8
+  %18 = load %struct.exc_Handler*, %struct.exc_Handler** %3, align 8
+  %19 = getelementptr inbounds %struct.exc_Handler, %struct.exc_Handler* %18, i32 0, i32 1
+  %20 = getelementptr inbounds [1 x %struct.__jmp_buf_tag], [1 x %struct.__jmp_buf_tag]* %19, i64 0, i64 0
+  call void @longjmp(%struct.__jmp_buf_tag* noundef %20, i32 noundef 1) #12
+  unreachable
 
-36:                                               ; preds = %21
-  br label %51
+21:                                               ; preds = %1
+  %22 = load i8*, i8** %2, align 8
+  %23 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([45 x i8], [45 x i8]* @.str.20, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.1.21, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.2.22, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.1.21, i64 0, i64 0), i8* noundef %22)
+  %24 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([19 x i8], [19 x i8]* @.str.3.23, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.4.24, i64 0, i64 0), i8* noundef getelementptr inbounds ([8 x i8], [8 x i8]* @.str.5.25, i64 0, i64 0))
+  %25 = getelementptr inbounds [128 x i8*], [128 x i8*]* %4, i64 0, i64 0
+  %26 = call i32 @backtrace(i8** noundef %25, i32 noundef 128)
+  store i32 %26, i32* %5, align 4
+  %27 = getelementptr inbounds [128 x i8*], [128 x i8*]* %4, i64 0, i64 0
+  %28 = load i32, i32* %5, align 4
+  %29 = call i8** @backtrace_symbols(i8** noundef %27, i32 noundef %28) #10
+  store i8** %29, i8*** %6, align 8
+  store i32 1, i32* %7, align 4
+  br label %30
+
+30:                                               ; preds = %61, %21
+  %31 = load i32, i32* %7, align 4
+  %32 = load i32, i32* %5, align 4
+  %33 = icmp slt i32 %31, %32
+  br i1 %33, label %34, label %64
+
+34:                                               ; preds = %30
+  %35 = load i8**, i8*** %6, align 8
+  %36 = load i32, i32* %7, align 4
+  %37 = sext i32 %36 to i64
+  %38 = getelementptr inbounds i8*, i8** %35, i64 %37
+  %39 = load i8*, i8** %38, align 8
+  %40 = call i8* @strstr(i8* noundef %39, i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.6.26, i64 0, i64 0)) #8
+  store i8* %40, i8** %8, align 8
+  %41 = load i8**, i8*** %6, align 8
+  %42 = load i32, i32* %7, align 4
+  %43 = sext i32 %42 to i64
+  %44 = getelementptr inbounds i8*, i8** %41, i64 %43
+  %45 = load i8*, i8** %44, align 8
+  %46 = call i8* @strstr(i8* noundef %45, i8* noundef getelementptr inbounds ([5 x i8], [5 x i8]* @.str.7.27, i64 0, i64 0)) #8
+  store i8* %46, i8** %9, align 8
+  %47 = load i8*, i8** %8, align 8
+  %48 = icmp ne i8* %47, null
+  br i1 %48, label %49, label %50
+
+49:                                               ; preds = %34
+  br label %64
 
-37:                                               ; preds = %21
-  %38 = load i8*, i8** %8, align 8
-  %39 = icmp ne i8* %38, null
-  br i1 %39, label %40, label %41
+50:                                               ; preds = %34
+  %51 = load i8*, i8** %9, align 8
+  %52 = icmp ne i8* %51, null
+  br i1 %52, label %53, label %54
 
-40:                                               ; preds = %37
-  br label %51
+53:                                               ; preds = %50
+  br label %64
 
-41:                                               ; preds = %37
-  %42 = load i8**, i8*** %5, align 8
-  %43 = load i32, i32* %6, align 4
-  %44 = sext i32 %43 to i64
-  %45 = getelementptr inbounds i8*, i8** %42, i64 %44
-  %46 = load i8*, i8** %45, align 8
-  %47 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.8.23, i64 0, i64 0), i8* noundef %46)
-  br label %48
-
-48:                                               ; preds = %41
-  %49 = load i32, i32* %6, align 4
-  %50 = add nsw i32 %49, 1
-  store i32 %50, i32* %6, align 4
-  br label %17, !llvm.loop !8
-
-51:                                               ; preds = %40, %36, %17
-  %52 = load i8**, i8*** %5, align 8
-  %53 = bitcast i8** %52 to i8*
-  call void @free(i8* noundef %53) #10
+54:                                               ; preds = %50
+  %55 = load i8**, i8*** %6, align 8
+  %56 = load i32, i32* %7, align 4
+  %57 = sext i32 %56 to i64
+  %58 = getelementptr inbounds i8*, i8** %55, i64 %57
+  %59 = load i8*, i8** %58, align 8
+  %60 = call i32 (i8*, ...) @printf(i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.8.28, i64 0, i64 0), i8* noundef %59)
+  br label %61
+
+61:                                               ; preds = %54
+  %62 = load i32, i32* %7, align 4
+  %63 = add nsw i32 %62, 1
+  store i32 %63, i32* %7, align 4
+  br label %30, !llvm.loop !8
+
+64:                                               ; preds = %53, %49, %30
+  %65 = load i8**, i8*** %6, align 8
+  %66 = bitcast i8** %65 to i8*
+  call void @free(i8* noundef %66) #10
   call void @exit(i32 noundef -1) #12
   unreachable
 }
 
+; Function Attrs: noreturn nounwind
+declare void @longjmp(%struct.__jmp_buf_tag* noundef, i32 noundef) #7
+
 declare i32 @printf(i8* noundef, ...) #4
 
 declare i32 @backtrace(i8** noundef, i32 noundef) #4
@@ -1253,7 +1955,7 @@ define dso_local void @exc_ThrowIfNull(i8* noundef %0) #0 {
   br i1 %4, label %5, label %6
 
 5:                                                ; preds = %1
-  call void @exc_Throw(i8* noundef getelementptr inbounds ([54 x i8], [54 x i8]* @.str.9.24, i64 0, i64 0))
+  call void @exc_Throw(i8* noundef getelementptr inbounds ([54 x i8], [54 x i8]* @.str.9.29, i64 0, i64 0))
   br label %6
 
 6:                                                ; preds = %5, %1
@@ -1278,7 +1980,7 @@ define dso_local void @exc_ThrowIfInvalidCast(%struct.class_Any* noundef %0, %st
   br i1 %13, label %14, label %15
 
 14:                                               ; preds = %3
-  br label %139
+  br label %117
 
 15:                                               ; preds = %3
   %16 = load %struct.class_Any*, %struct.class_Any** %4, align 8
@@ -1291,7 +1993,7 @@ define dso_local void @exc_ThrowIfInvalidCast(%struct.class_Any* noundef %0, %st
   br i1 %21, label %22, label %23
 
 22:                                               ; preds = %15
-  call void @exc_Throw(i8* noundef getelementptr inbounds ([90 x i8], [90 x i8]* @.str.10.25, i64 0, i64 0))
+  call void @exc_Throw(i8* noundef getelementptr inbounds ([90 x i8], [90 x i8]* @.str.10.30, i64 0, i64 0))
   br label %23
 
 23:                                               ; preds = %22, %15
@@ -1303,18 +2005,18 @@ define dso_local void @exc_ThrowIfInvalidCast(%struct.class_Any* noundef %0, %st
   br i1 %28, label %29, label %30
 
 29:                                               ; preds = %23
-  br label %139
+  br label %117
 
 30:                                               ; preds = %23
   %31 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
   %32 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %31, i32 0, i32 1
   %33 = load i8*, i8** %32, align 8
-  %34 = call i32 @strcmp(i8* noundef %33, i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.11.26, i64 0, i64 0)) #8
+  %34 = call i32 @strcmp(i8* noundef %33, i8* noundef getelementptr inbounds ([4 x i8], [4 x i8]* @.str.11.31, i64 0, i64 0)) #8
   %35 = icmp eq i32 %34, 0
   br i1 %35, label %36, label %37
 
 36:                                               ; preds = %30
-  br label %139
+  br label %117
 
 37:                                               ; preds = %30
   %38 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 0
@@ -1340,7 +2042,7 @@ define dso_local void @exc_ThrowIfInvalidCast(%struct.class_Any* noundef %0, %st
   br i1 %52, label %53, label %54
 
 53:                                               ; preds = %44
-  br label %139
+  br label %117
 
 54:                                               ; preds = %44
   %55 = load %struct.Standard_vTable*, %struct.Standard_vTable** %8, align 8
@@ -1351,128 +2053,94 @@ define dso_local void @exc_ThrowIfInvalidCast(%struct.class_Any* noundef %0, %st
   br label %41, !llvm.loop !9
 
 59:                                               ; preds = %41
-  %60 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
-  %61 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %60, i32 0, i32 0
-  %62 = load i8*, i8** %61, align 8
-  %63 = bitcast i8* %62 to %struct.Standard_vTable*
-  store %struct.Standard_vTable* %63, %struct.Standard_vTable** %8, align 8
-  br label %64
+  %60 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
+  %61 = load i8*, i8** %60, align 8
+  %62 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
+  %63 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %62, i32 0, i32 1
+  %64 = load i8*, i8** %63, align 8
+  %65 = call i32 @strcmp(i8* noundef %61, i8* noundef %64) #8
+  %66 = icmp eq i32 %65, 0
+  %67 = zext i1 %66 to i8
+  store i8 %67, i8* %9, align 1
+  store i8* getelementptr inbounds ([50 x i8], [50 x i8]* @.str.12.32, i64 0, i64 0), i8** %10, align 8
+  %68 = load i8*, i8** %10, align 8
+  %69 = load i8, i8* %9, align 1
+  %70 = trunc i8 %69 to i1
+  br i1 %70, label %71, label %74
+
+71:                                               ; preds = %59
+  %72 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 2
+  %73 = load i8*, i8** %72, align 8
+  br label %77
+
+74:                                               ; preds = %59
+  %75 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
+  %76 = load i8*, i8** %75, align 8
+  br label %77
+
+77:                                               ; preds = %74, %71
+  %78 = phi i8* [ %73, %71 ], [ %76, %74 ]
+  %79 = load i8, i8* %9, align 1
+  %80 = trunc i8 %79 to i1
+  br i1 %80, label %81, label %83
+
+81:                                               ; preds = %77
+  %82 = load i8*, i8** %6, align 8
+  br label %87
 
-64:                                               ; preds = %76, %59
-  %65 = load %struct.Standard_vTable*, %struct.Standard_vTable** %8, align 8
-  %66 = icmp ne %struct.Standard_vTable* %65, null
-  br i1 %66, label %67, label %81
-
-67:                                               ; preds = %64
-  %68 = load %struct.Standard_vTable*, %struct.Standard_vTable** %8, align 8
-  %69 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %68, i32 0, i32 1
-  %70 = load i8*, i8** %69, align 8
-  %71 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
-  %72 = load i8*, i8** %71, align 8
-  %73 = call i32 @strcmp(i8* noundef %70, i8* noundef %72) #8
-  %74 = icmp eq i32 %73, 0
-  br i1 %74, label %75, label %76
-
-75:                                               ; preds = %67
-  br label %139
-
-76:                                               ; preds = %67
-  %77 = load %struct.Standard_vTable*, %struct.Standard_vTable** %8, align 8
-  %78 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %77, i32 0, i32 0
-  %79 = load i8*, i8** %78, align 8
-  %80 = bitcast i8* %79 to %struct.Standard_vTable*
-  store %struct.Standard_vTable* %80, %struct.Standard_vTable** %8, align 8
-  br label %64, !llvm.loop !10
-
-81:                                               ; preds = %64
-  %82 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
-  %83 = load i8*, i8** %82, align 8
+83:                                               ; preds = %77
   %84 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
   %85 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %84, i32 0, i32 1
   %86 = load i8*, i8** %85, align 8
-  %87 = call i32 @strcmp(i8* noundef %83, i8* noundef %86) #8
-  %88 = icmp eq i32 %87, 0
-  %89 = zext i1 %88 to i8
-  store i8 %89, i8* %9, align 1
-  store i8* getelementptr inbounds ([50 x i8], [50 x i8]* @.str.12.27, i64 0, i64 0), i8** %10, align 8
-  %90 = load i8*, i8** %10, align 8
-  %91 = load i8, i8* %9, align 1
-  %92 = trunc i8 %91 to i1
-  br i1 %92, label %93, label %96
-
-93:                                               ; preds = %81
-  %94 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 2
-  %95 = load i8*, i8** %94, align 8
-  br label %99
-
-96:                                               ; preds = %81
-  %97 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
-  %98 = load i8*, i8** %97, align 8
-  br label %99
-
-99:                                               ; preds = %96, %93
-  %100 = phi i8* [ %95, %93 ], [ %98, %96 ]
-  %101 = load i8, i8* %9, align 1
-  %102 = trunc i8 %101 to i1
-  br i1 %102, label %103, label %105
-
-103:                                              ; preds = %99
-  %104 = load i8*, i8** %6, align 8
-  br label %109
-
-105:                                              ; preds = %99
-  %106 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
-  %107 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %106, i32 0, i32 1
-  %108 = load i8*, i8** %107, align 8
-  br label %109
-
-109:                                              ; preds = %105, %103
-  %110 = phi i8* [ %104, %103 ], [ %108, %105 ]
-  %111 = call i32 (i8*, i64, i8*, ...) @snprintf(i8* noundef null, i64 noundef 0, i8* noundef %90, i8* noundef %100, i8* noundef %110) #10
-  %112 = add nsw i32 %111, 1
-  %113 = sext i32 %112 to i64
-  %114 = call noalias i8* @malloc(i64 noundef %113) #10
-  store i8* %114, i8** %11, align 8
-  %115 = load i8*, i8** %11, align 8
-  %116 = load i8*, i8** %10, align 8
-  %117 = load i8, i8* %9, align 1
-  %118 = trunc i8 %117 to i1
-  br i1 %118, label %119, label %122
-
-119:                                              ; preds = %109
-  %120 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 2
-  %121 = load i8*, i8** %120, align 8
-  br label %125
-
-122:                                              ; preds = %109
-  %123 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
-  %124 = load i8*, i8** %123, align 8
-  br label %125
-
-125:                                              ; preds = %122, %119
-  %126 = phi i8* [ %121, %119 ], [ %124, %122 ]
-  %127 = load i8, i8* %9, align 1
-  %128 = trunc i8 %127 to i1
-  br i1 %128, label %129, label %131
-
-129:                                              ; preds = %125
-  %130 = load i8*, i8** %6, align 8
-  br label %135
-
-131:                                              ; preds = %125
-  %132 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
-  %133 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %132, i32 0, i32 1
-  %134 = load i8*, i8** %133, align 8
-  br label %135
-
-135:                                              ; preds = %131, %129
-  %136 = phi i8* [ %130, %129 ], [ %134, %131 ]
-  %137 = call i32 (i8*, i8*, ...) @sprintf(i8* noundef %115, i8* noundef %116, i8* noundef %126, i8* noundef %136) #10
-  %138 = load i8*, i8** %11, align 8
-  call void @exc_Throw(i8* noundef %138)
-  br label %139
-
-139:                                              ; preds = %135, %75, %53, %36, %29, %14
+  br label %87
+
+87:                                               ; preds = %83, %81
+  %88 = phi i8* [ %82, %81 ], [ %86, %83 ]
+  %89 = call i32 (i8*, i64, i8*, ...) @snprintf(i8* noundef null, i64 noundef 0, i8* noundef %68, i8* noundef %78, i8* noundef %88) #10
+  %90 = add nsw i32 %89, 1
+  %91 = sext i32 %90 to i64
+  %92 = call noalias i8* @malloc(i64 noundef %91) #10
+  store i8* %92, i8** %11, align 8
+  %93 = load i8*, i8** %11, align 8
+  %94 = load i8*, i8** %10, align 8
+  %95 = load i8, i8* %9, align 1
+  %96 = trunc i8 %95 to i1
+  br i1 %96, label %97, label %100
+
+97:                                               ; preds = %87
+  %98 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 2
+  %99 = load i8*, i8** %98, align 8
+  br label %103
+
+100:                                              ; preds = %87
+  %101 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %7, i32 0, i32 1
+  %102 = load i8*, i8** %101, align 8
+  br label %103
+
+103:                                              ; preds = %100, %97
+  %104 = phi i8* [ %99, %97 ], [ %102, %100 ]
+  %105 = load i8, i8* %9, align 1
+  %106 = trunc i8 %105 to i1
+  br i1 %106, label %107, label %109
+
+107:                                              ; preds = %103
+  %108 = load i8*, i8** %6, align 8
+  br label %113
+
+109:                                              ; preds = %103
+  %110 = load %struct.Standard_vTable*, %struct.Standard_vTable** %5, align 8
+  %111 = getelementptr inbounds %struct.Standard_vTable, %struct.Standard_vTable* %110, i32 0, i32 1
+  %112 = load i8*, i8** %111, align 8
+  br label %113
+
+113:                                              ; preds = %109, %107
+  %114 = phi i8* [ %108, %107 ], [ %112, %109 ]
+  %115 = call i32 (i8*, i8*, ...) @sprintf(i8* noundef %93, i8* noundef %94, i8* noundef %104, i8* noundef %114) #10
+  %116 = load i8*, i8** %11, align 8
+  call void @exc_Throw(i8* noundef %116)
+  br label %117
+
+117:                                              ; preds = %113, %53, %36, %29, %14
   ret void
 }
 
@@ -1509,4 +2177,3 @@ attributes #12 = { noreturn nounwind }
 !7 = !{!"llvm.loop.mustprogress"}
 !8 = distinct !{!8, !7}
 !9 = distinct !{!9, !7}
-!10 = distinct !{!10, !7}
@@ -0,0 +1,57 @@
+#!/bin/sh
+# runs the benchmarks through the tree walking interpreter (rgoc -i) and the vm (rgoc -vm)
+# and shows how long each of them took
+#
+# usage: tests/benchmark.sh [path to rgoc] [benchmarks...]
+#
+# the times are the ones the programs measured themselves (so compiling them isnt included)
+# heads up: primeBenchmark takes about a minute with -i
+
+cd "$(dirname "$0")/.." || exit 1
+
+work=$(mktemp -d)
+trap 'rm -rf "$work"' EXIT
+
+# build a fresh compiler unless we were handed one
+rgoc=$1
+if [ -z "$rgoc" ]; then
+	rgoc="$work/rgoc"
+	go build -o "$rgoc" . || exit 1
+fi
+
+if [ $# -gt 1 ]; then
+	shift
+	benchmarks=$*
+else
+	benchmarks="callBenchmark stringBenchmark primeBenchmark"
+fi
+
+# the packager reads packages as LLVM IR, so make sure there is a sys.ll around
+packages=packages
+if [ ! -f packages/sys.ll ]; then
+	packages="$work/packages"
+	mkdir -p "$packages"
+	llvm-dis packages/sys.bc -o "$packages/sys.ll" || exit 1
+fi
+
+# just the number out of "TOOK: 123ms (~0s)"
+took() {
+	"$rgoc" "$1" -pi "$packages" "tests/$2.rct" | sed -n 's/^TOOK: \([0-9]*\)ms.*/\1/p'
+}
+
+printf "%-20s %12s %12s %10s\n" "benchmark" "-i (ms)" "-vm (ms)" "speedup"
+
+for benchmark in $benchmarks; do
+	tree=$(took -i "$benchmark")
+	machine=$(took -vm "$benchmark")
+
+	if [ -z "$tree" ] || [ -z "$machine" ]; then
+		echo "$benchmark didn't finish"
+		exit 1
+	fi
+
+	# dont divide by zero if the vm is just that fast
+	[ "$machine" -eq 0 ] && machine=1
+
+	printf "%-20s %12s %12s %9sx\n" "$benchmark" "$tree" "$machine" "$(awk "BEGIN { printf \"%.1f\", $tree / $machine }")"
+done
@@ -0,0 +1,134 @@
+#!/bin/sh
+# runs every test that has an expected output through the interpreter (rgoc -i) and the vm (rgoc -vm)
+# and compares it to what the compiled binary printed
+# if clang is around the tests get compiled and run as well, so the expected outputs can't go stale
+# every test also has to come back out of its concrete syntax tree (rgoc cst -check) exactly like it was written
+# (once as it is and once with windows line endings)
+#
+# usage: tests/conformance.sh [path to rgoc]
+#
+# expected outputs live in tests/expected/<test>.out, they're the output of the compiled
+# program with colors and everything from [STACKTRACE] onwards removed
+# (stacktraces are addresses in compiled programs, so they cant ever match)
+# tests that can't have one are listed in $skipped below, together with the reason why
+
+cd "$(dirname "$0")/.." || exit 1
+
+work=$(mktemp -d)
+trap 'rm -rf "$work"' EXIT
+
+# build a fresh compiler unless we were handed one
+rgoc=$1
+if [ -z "$rgoc" ]; then
+	rgoc="$work/rgoc"
+	go build -o "$rgoc" . || exit 1
+fi
+
+# the packager reads packages as LLVM IR, so make sure there is a sys.ll around
+packages="$PWD/packages"
+if [ ! -f packages/sys.ll ]; then
+	packages="$work/packages"
+	mkdir -p "$packages"
+	llvm-dis packages/sys.bc -o "$packages/sys.ll" || exit 1
+fi
+
+modes="-i -vm"
+if command -v clang > /dev/null; then
+	modes="$modes compiled"
+
+	# the compiler looks for the systemlib and its packages right next to itself
+	bin=$(dirname "$rgoc")
+	[ -e "$bin/systemlib" ] || ln -s "$PWD/systemlib" "$bin/systemlib"
+	[ -e "$bin/packages" ] || ln -s "$packages" "$bin/packages"
+fi
+
+# every test that doesn't have an expected output, and why
+skipped="
+arrayTest          doesn't compile, it uses sys functions without the sys:: prefix
+byteTest           doesn't compile, it uses sys functions without the sys:: prefix
+callBenchmark      benchmark, prints how long it took
+classTest          never stops, it prints in an endless loop
+classlambda        calls the external C function clock(), which the interpreter doesn't have, and prints its result
+customPackageTest  is the source of a package, running it as a program prints nothing
+dicTest            doesn't compile, the Dictionary package module can't be read by the packager
+fib                prints how long it took, and fib(47) takes minutes in the interpreter
+helloWorld         never stops, it prints in an endless loop
+konsoleTest        doesn't compile, it calls konsole::WriteBgGradient with the wrong number of arguments
+packageTest        doesn't compile, the test package module doesn't exist
+primeBenchmark     benchmark, prints how long it took
+stringBenchmark    benchmark, prints how long it took
+sysTest            doesn't compile on purpose, it imports a package that doesn't exist
+test1              doesn't compile on purpose, it has a typo the lexer is supposed to catch
+threadTest         a thread writes in an endless loop until it's killed, so the output depends on timing
+wedoalittlemeta    doesn't compile, it calls runtime functions with 'this' as a parameter name
+"
+
+normalize() {
+	sed 's/\x1b\[[0-9;]*m//g' | sed '/\[STACKTRACE\]/,$d'
+}
+
+passed=0
+failed=0
+
+for mode in $modes; do
+	for expected in tests/expected/*.out; do
+		name=$(basename "$expected" .out)
+
+		if [ "$mode" = compiled ]; then
+			"$rgoc" -pi "$packages" -o "$work/$name" "tests/$name.rct" > "$work/$name.out" 2>&1 &&
+				timeout 20 "$work/$name" 2>&1 | normalize > "$work/$name.out"
+		else
+			timeout 20 "$rgoc" "$mode" -pi "$packages" "tests/$name.rct" 2>&1 | normalize > "$work/$name.out"
+		fi
+
+		if diff -u "$expected" "$work/$name.out" > "$work/$name.diff"; then
+			passed=$((passed + 1))
+			echo "PASS $name ($mode)"
+		else
+			failed=$((failed + 1))
+			echo "FAIL $name ($mode)"
+			cat "$work/$name.diff"
+		fi
+	done
+done
+
+# this doesn't run anything, so every test gets checked (even the skipped ones)
+for test in tests/*.rct; do
+	name=$(basename "$test" .rct)
+
+	if "$rgoc" cst -check "$test" > /dev/null 2>&1; then
+		passed=$((passed + 1))
+		echo "PASS $name (cst)"
+	else
+		failed=$((failed + 1))
+		echo "FAIL $name (cst, the concrete syntax tree doesn't give back the exact source code)"
+	fi
+
+	# same thing with windows line endings, every \r has to come back too
+	awk '{ printf "%s\r\n", $0 }' "$test" > "$work/$name.rct"
+
+	if "$rgoc" cst -check "$work/$name.rct" > /dev/null 2>&1; then
+		passed=$((passed + 1))
+		echo "PASS $name (cst, crlf)"
+	else
+		failed=$((failed + 1))
+		echo "FAIL $name (cst, crlf, the concrete syntax tree doesn't give back the exact source code)"
+	fi
+done
+
+echo "$skipped" | while read -r name reason; do
+	[ -n "$name" ] && echo "SKIP $name ($reason)"
+done
+
+# new tests need an expected output or a reason why they dont have one
+for test in tests/*.rct; do
+	name=$(basename "$test" .rct)
+
+	if [ ! -f "tests/expected/$name.out" ] && ! echo "$skipped" | grep -q "^$name "; then
+		failed=$((failed + 1))
+		echo "FAIL $name (no expected output and not listed as skipped)"
+	fi
+done
+
+echo "$passed passed, $failed failed"
+[ "$failed" -eq 0 ]
@@ -0,0 +1,387 @@
+package vm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/print"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// the bytecode
+// every function gets its own list of instructions and constants, locals live in numbered slots
+// and everything else happens on a little operand stack that sits right on top of them
+
+type Opcode byte
+
+const (
+	// <STACK> ----------------------------------------------------------------
+	OpConstant     Opcode = iota // push Constants[A]
+	OpNull                       // push null
+	OpPop                        // throw away the top value
+	OpDup                        // push the top value again
+	OpDefault                    // push a fresh default value of the type in Constants[A] (for structs)
+	OpNativeString               // push a new native string buffer of Constants[A]
+
+	// <VARIABLES> ------------------------------------------------------------
+	OpLoadLocal     // push slot A
+	OpStoreLocal    // pop into slot A
+	OpLoadCell      // push the value in the cell in slot A (locals a lambda or a pointer can see)
+	OpStoreCell     // pop into the cell in slot A
+	OpDeclareCell   // pop into a new cell in slot A (captured locals get one every time they're declared)
+	OpLoadCaptured  // push the value in captured cell A
+	OpStoreCaptured // pop into captured cell A
+	OpLoadGlobal    // push global A
+	OpStoreGlobal   // pop into global A
+	OpLoadMember    // push field Constants[A] of this (or global B if there is no this)
+	OpStoreMember   // pop into field Constants[A] of this (or global B if there is no this)
+	OpThis          // push this
+
+	// <POINTERS> -------------------------------------------------------------
+	OpReferenceCell     // push a pointer to the cell in slot A
+	OpReferenceCaptured // push a pointer to captured cell A
+	OpReferenceGlobal   // push a pointer to global A
+	OpReferenceMember   // push a pointer to field Constants[A] of this (or global B if there is no this)
+	OpDereference       // pop a pointer, push what it points at
+
+	// <CONTROL FLOW> ---------------------------------------------------------
+	OpJump       // continue at A
+	OpBranch     // pop a bool, continue at A if its true and at B if its not
+	OpReturn     // return the top value
+	OpReturnVoid // return nothing
+	OpTryStart   // catch anything thrown from here on at A, continue at B
+	OpTryEnd     // stop catching
+	OpThrow      // pop a string and throw it
+	OpCaught     // push the exception that was caught last
+	OpDie        // stop the program with the message in Constants[A]
+
+	// <OPERATORS> ------------------------------------------------------------
+	OpNegate  // pop a number, push it negated
+	OpNot     // pop a bool, push the opposite
+	OpBinary  // pop two values, push the result of the operation in Constants[A]
+	OpConvert // pop a value, push it converted like Constants[A] says
+
+	// ints and longs are common enough to get operators of their own
+	// (these are in the same order as fastOperators, so the compiler can just add the index)
+	OpAddInt
+	OpSubtractInt
+	OpMultiplyInt
+	OpLessInt
+	OpLessOrEqualsInt
+	OpGreaterInt
+	OpGreaterOrEqualsInt
+	OpEqualsInt
+	OpNotEqualsInt
+
+	OpAddLong
+	OpSubtractLong
+	OpMultiplyLong
+	OpLessLong
+	OpLessOrEqualsLong
+	OpGreaterLong
+	OpGreaterOrEqualsLong
+	OpEqualsLong
+	OpNotEqualsLong
+
+	// <CALLS> ----------------------------------------------------------------
+	OpCall            // call the function in Constants[A] with the top B values
+	OpCallSelf        // call the function in the call site Constants[A] on this (if there is a this) with the top B values
+	OpCallMethod      // call the method in the call site Constants[A] on the object below the top B values
+	OpCallConstructor // call the constructor Constants[A] on the object below the top B values
+	OpCallPackage     // call the package function in Constants[A] with the top B values
+	OpCheckObject     // make sure the top value is an object
+	OpCheckNull       // make sure the top value isn't null
+
+	// <VALUES> ---------------------------------------------------------------
+	OpMake             // make a new object of the class in Constants[A], its constructor gets the top B values
+	OpMakeArray        // pop a length, push a new array like Constants[A]
+	OpMakeArrayLiteral // push a new array of type Constants[A] with the top B values in it
+	OpMakeMap          // push a new map of type Constants[A]
+	OpMakeStruct       // push a new struct of type Constants[A] with its first B fields set to the top B values
+	OpClosure          // push a new closure for the lambda in Constants[A]
+	OpFunction         // push a reference to the function in Constants[A]
+	OpConcat           // pop B strings, push them all stuck together
+	OpGetField         // pop an object or struct, push its field Constants[A]
+	OpSetField         // pop an object or struct and a value, store the value in field Constants[A] and push it again
+	OpGetElement       // pop an array or map and an index, push the element
+	OpSetElement       // pop an array or map, an index and a value, store the value and push it again
+	OpGetPointer       // pop a pointer and an offset, push whatever is there
+	OpSetPointer       // pop a pointer, an offset and a value, store the value and push it again
+
+	// <TYPECALLS> ------------------------------------------------------------
+	OpStringLength // string->GetLength()
+	OpStringBuffer // string->GetBuffer()
+	OpSubstring    // string->Substring(start, length)
+	OpArrayLength  // array->GetLength()
+	OpArrayPush    // array->Push(value)
+	OpMapLength    // map->GetLength()
+	OpMapKeys      // map->GetKeys(), the array is of type Constants[A]
+	OpMapHas       // map->Has(key)
+	OpMapRemove    // map->Remove(key)
+	OpRunAction    // action->Run(...) with the top B values
+	OpRunThread    // action->RunThread(...) with the top B values
+	OpThreadStart  // thread->Start()
+	OpThreadJoin   // thread->Join()
+	OpThreadKill   // thread->Kill()
+)
+
+var opcodeNames = [...]string{
+	"Constant", "Null", "Pop", "Dup", "Default", "NativeString",
+	"LoadLocal", "StoreLocal", "LoadCell", "StoreCell", "DeclareCell", "LoadCaptured", "StoreCaptured", "LoadGlobal", "StoreGlobal", "LoadMember", "StoreMember", "This",
+	"ReferenceCell", "ReferenceCaptured", "ReferenceGlobal", "ReferenceMember", "Dereference",
+	"Jump", "Branch", "Return", "ReturnVoid", "TryStart", "TryEnd", "Throw", "Caught", "Die",
+	"Negate", "Not", "Binary", "Convert",
+	"AddInt", "SubtractInt", "MultiplyInt", "LessInt", "LessOrEqualsInt", "GreaterInt", "GreaterOrEqualsInt", "EqualsInt", "NotEqualsInt",
+	"AddLong", "SubtractLong", "MultiplyLong", "LessLong", "LessOrEqualsLong", "GreaterLong", "GreaterOrEqualsLong", "EqualsLong", "NotEqualsLong",
+	"Call", "CallSelf", "CallMethod", "CallConstructor", "CallPackage", "CheckObject", "CheckNull",
+	"Make", "MakeArray", "MakeArrayLiteral", "MakeMap", "MakeStruct", "Closure", "Function", "Concat",
+	"GetField", "SetField", "GetElement", "SetElement", "GetPointer", "SetPointer",
+	"StringLength", "StringBuffer", "Substring", "ArrayLength", "ArrayPush", "MapLength", "MapKeys", "MapHas", "MapRemove",
+	"RunAction", "RunThread", "ThreadStart", "ThreadJoin", "ThreadKill",
+}
+
+func (op Opcode) String() string {
+	if int(op) < len(opcodeNames) {
+		return opcodeNames[op]
+	}
+
+	return fmt.Sprintf("Op(%d)", op)
+}
+
+type Instruction struct {
+	Op Opcode
+	A  int32
+	B  int32
+}
+
+// how much an instruction grows (or shrinks) the operand stack
+func (ins Instruction) StackEffect() int {
+	b := int(ins.B)
+
+	switch ins.Op {
+	case OpConstant, OpNull, OpDup, OpDefault, OpNativeString,
+		OpLoadLocal, OpLoadCell, OpLoadCaptured, OpLoadGlobal, OpLoadMember, OpThis,
+		OpReferenceCell, OpReferenceCaptured, OpReferenceGlobal, OpReferenceMember,
+		OpCaught, OpMakeMap, OpClosure, OpFunction:
+		return 1
+
+	case OpPop, OpStoreLocal, OpStoreCell, OpDeclareCell, OpStoreCaptured, OpStoreGlobal, OpStoreMember,
+		OpBranch, OpReturn, OpThrow, OpBinary, OpSetField, OpGetElement, OpGetPointer, OpArrayPush, OpMapHas, OpMapRemove:
+		return -1
+
+	case OpSetElement, OpSetPointer, OpSubstring:
+		return -2
+
+	case OpCall, OpCallSelf, OpCallPackage, OpMake, OpMakeArrayLiteral, OpMakeStruct, OpConcat:
+		return 1 - b
+
+	case OpCallMethod, OpCallConstructor, OpRunAction, OpRunThread:
+		return -b
+	}
+
+	// ints and longs
+	if ins.Op >= OpAddInt && ins.Op <= OpNotEqualsLong {
+		return -1
+	}
+
+	// everything else takes one and gives one back (or doesnt touch the stack at all)
+	return 0
+}
+
+// <FUNCTIONS> ----------------------------------------------------------------
+
+// a compiled function (or lambda, or the main program)
+type Function struct {
+	Name      string
+	Code      []Instruction
+	Constants []interface{}
+
+	Parameters int
+	Slots      int   // parameters + locals
+	MaxStack   int   // the most values the operand stack ever holds
+	Cells      []int // slots that hold a cell instead of a value (they get one every call)
+	Handlers   bool  // if theres any try blocks in here
+
+	Symbol symbols.FunctionSymbol
+}
+
+// a class and all of its compiled functions
+type Class struct {
+	Symbol      symbols.ClassSymbol
+	Methods     map[string]*Function
+	Constructor *Function
+}
+
+// a whole compiled program
+type Program struct {
+	Bound binder.BoundProgram
+
+	Main      *Function
+	Functions []*Function
+	Lookup    map[string]*Function // every function by fingerprint
+	Classes   map[string]*Class
+
+	// every global gets a slot
+	Globals     int
+	GlobalSlots map[string]int
+}
+
+// <CONSTANTS> ----------------------------------------------------------------
+
+// a call to a function that might be overridden, remembers the last class it saw so it usually doesnt have to look
+type callSite struct {
+	Symbol      symbols.FunctionSymbol
+	Fingerprint string
+	Static      *Function // what to call if theres no object (only for OpCallSelf)
+
+	class  string
+	method *Function
+	name   string
+}
+
+// a call into a package function, the binding gets looked up the first time its needed
+type packageSite struct {
+	Package  string
+	Function string
+
+	binding  evaluator.NativeBinding
+	resolved bool
+}
+
+// everything OpMake needs to know
+type makeSite struct {
+	Symbol symbols.ClassSymbol
+	Class  *Class // nil if theres nothing we can run (like package classes)
+}
+
+type arraySite struct {
+	Type     symbols.TypeSymbol
+	BaseType symbols.TypeSymbol
+}
+
+type lambda struct {
+	Function *Function
+	Captures []capture
+	This     bool // does it need the object it's created in?
+}
+
+// where a lambda gets a captured variable from
+type capture struct {
+	Captured bool // from our own captures (if we're a lambda too) or from a cell slot
+	Index    int
+}
+
+// a binary operator that isnt one of the fast ones
+type binaryOperation struct {
+	Kind    boundnodes.BoundBinaryOperatorType
+	String  bool   // the left side is a string
+	Pointer bool   // the left side is a pointer
+	Left    string // the left side's type (for when things go wrong)
+}
+
+type conversion struct {
+	From symbols.TypeSymbol
+	To   symbols.TypeSymbol
+}
+
+// </CONSTANTS> ---------------------------------------------------------------
+// <PRINTING> -----------------------------------------------------------------
+
+func (prg *Program) Print() {
+	for _, fnc := range prg.Functions {
+		fnc.Print()
+	}
+
+	// maps dont have an order, so make one up
+	names := make([]string, 0)
+	for name := range prg.Classes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		methods := make([]string, 0)
+		for fingerprint := range prg.Classes[name].Methods {
+			methods = append(methods, fingerprint)
+		}
+
+		sort.Strings(methods)
+
+		for _, fingerprint := range methods {
+			prg.Classes[name].Methods[fingerprint].Print()
+		}
+	}
+}
+
+func (fnc *Function) Print() {
+	print.PrintCF(print.Cyan, "%s (%d parameters, %d slots, %d stack)", fnc.Name, fnc.Parameters, fnc.Slots, fnc.MaxStack)
+
+	for i, ins := range fnc.Code {
+		fmt.Printf("  %04d %-20s %d %d", i, ins.Op, ins.A, ins.B)
+
+		if usesConstant(ins.Op) {
+			print.WriteCF(print.Gray, "  ; %s", describeConstant(fnc.Constants[ins.A]))
+		}
+
+		fmt.Println()
+	}
+
+	fmt.Println()
+
+	// lambdas live in here too
+	for _, constant := range fnc.Constants {
+		if lmb, ok := constant.(*lambda); ok {
+			lmb.Function.Print()
+		}
+	}
+}
+
+func usesConstant(op Opcode) bool {
+	switch op {
+	case OpConstant, OpDefault, OpNativeString, OpLoadMember, OpStoreMember, OpReferenceMember, OpDie, OpBinary, OpConvert,
+		OpCall, OpCallSelf, OpCallMethod, OpCallConstructor, OpCallPackage, OpMake, OpMakeArray, OpMakeArrayLiteral, OpMakeMap,
+		OpMakeStruct, OpClosure, OpFunction, OpGetField, OpSetField, OpMapKeys:
+		return true
+	}
+
+	return false
+}
+
+func describeConstant(constant interface{}) string {
+	switch constant := constant.(type) {
+	case string:
+		return fmt.Sprintf("%q", constant)
+	case symbols.TypeSymbol:
+		return constant.Fingerprint()
+	case binaryOperation:
+		return string(constant.Kind)
+	case conversion:
+		return constant.From.Fingerprint() + " -> " + constant.To.Fingerprint()
+	case *callSite:
+		return constant.Fingerprint
+	case *packageSite:
+		return constant.Package + "::" + constant.Function
+	case *makeSite:
+		return constant.Symbol.Name
+	case *arraySite:
+		return constant.Type.Fingerprint()
+	case *lambda:
+		return constant.Function.Name
+	case *Function:
+		return constant.Name
+	case *Class:
+		return constant.Symbol.Name
+	case *evaluator.FunctionReference:
+		return constant.Function.Fingerprint()
+	case symbols.StructSymbol:
+		return constant.Name
+	}
+
+	return fmt.Sprintf("%v", constant)
+}
+
+// </PRINTING> ----------------------------------------------------------------
@@ -0,0 +1,927 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/builtins"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// turns the lowered bound tree into bytecode
+// the lowerer already broke everything down into labels and gotos, so this is mostly just writing things down in order
+
+type compiler struct {
+	program  *Program
+	function *Function
+
+	// class functions find globals that arent in main in the object they're called on
+	inClass bool
+
+	locals   map[string]int  // the slot of every local and parameter
+	cells    map[string]bool // locals that live in a cell (because a lambda or a pointer can see them)
+	captured map[string]int  // where every captured variable is in our closure (lambdas only)
+
+	labels  map[boundnodes.BoundLabel]int
+	targets []int
+	jumps   []jump
+
+	strings map[string]int
+
+	// how full the operand stack is right now
+	depth int
+
+	// we found a local that should have been in a cell all along, so everything needs doing again
+	recompile bool
+}
+
+// an instruction that still needs to know where a label is
+type jump struct {
+	Instruction int
+	Label       int
+	IsB         bool
+}
+
+// the binary operators that get an int and a long version of their own (same order as the opcodes)
+var fastOperators = []boundnodes.BoundBinaryOperatorType{
+	boundnodes.Addition,
+	boundnodes.Subtraction,
+	boundnodes.Multiplication,
+	boundnodes.Less,
+	boundnodes.LessOrEquals,
+	boundnodes.Greater,
+	boundnodes.GreaterOrEquals,
+	boundnodes.Equals,
+	boundnodes.NotEquals,
+}
+
+// nothing but structs needs the program to figure out what they start out as
+var defaults = &evaluator.Evaluator{}
+
+// compile!
+func Compile(program binder.BoundProgram) *Program {
+	prg := &Program{
+		Bound:       program,
+		Functions:   make([]*Function, 0),
+		Lookup:      make(map[string]*Function),
+		Classes:     make(map[string]*Class),
+		GlobalSlots: make(map[string]int),
+	}
+
+	// everything has to exist before anything can call it
+	for _, fnc := range program.Functions {
+		function := &Function{Name: fnc.Symbol.Name, Symbol: fnc.Symbol}
+		prg.Functions = append(prg.Functions, function)
+		prg.Lookup[fnc.Symbol.Fingerprint()] = function
+
+		if fnc.Symbol.Fingerprint() == program.MainFunction.Fingerprint() {
+			prg.Main = function
+		}
+	}
+
+	for _, cls := range program.Classes {
+		class := &Class{Symbol: cls.Symbol, Methods: make(map[string]*Function)}
+
+		for _, fnc := range cls.Functions {
+			function := &Function{Name: fnc.Symbol.Name, Symbol: fnc.Symbol}
+			class.Methods[fnc.Symbol.Fingerprint()] = function
+
+			if fnc.Symbol.Name == "Constructor" {
+				class.Constructor = function
+			}
+		}
+
+		prg.Classes[cls.Symbol.Name] = class
+	}
+
+	if program.GlobalScope != nil {
+		for _, global := range program.GlobalScope.Variables {
+			prg.GlobalSlot(global.Fingerprint())
+		}
+	}
+
+	// now for the actual code
+	for i, fnc := range program.Functions {
+		prg.CompileFunction(prg.Functions[i], fnc.Symbol.Parameters, fnc.Body, false, nil)
+	}
+
+	for _, cls := range program.Classes {
+		for _, fnc := range cls.Functions {
+			prg.CompileFunction(prg.Classes[cls.Symbol.Name].Methods[fnc.Symbol.Fingerprint()], fnc.Symbol.Parameters, fnc.Body, true, nil)
+		}
+	}
+
+	return prg
+}
+
+// every global gets a slot the first time anyone asks for it
+func (prg *Program) GlobalSlot(fingerprint string) int {
+	slot, ok := prg.GlobalSlots[fingerprint]
+	if !ok {
+		slot = prg.Globals
+		prg.GlobalSlots[fingerprint] = slot
+		prg.Globals++
+	}
+
+	return slot
+}
+
+func (prg *Program) CompileFunction(fnc *Function, parameters []symbols.ParameterSymbol, body boundnodes.BoundBlockStatementNode, inClass bool, captures []symbols.VariableSymbol) {
+	cells := make(map[string]bool)
+
+	for {
+		cmp := &compiler{
+			program:  prg,
+			function: &Function{Name: fnc.Name, Symbol: fnc.Symbol, Parameters: len(parameters)},
+			inClass:  inClass,
+			locals:   make(map[string]int),
+			cells:    cells,
+			captured: make(map[string]int),
+			labels:   make(map[boundnodes.BoundLabel]int),
+			strings:  make(map[string]int),
+		}
+
+		cmp.CompileBody(parameters, body, captures)
+
+		if !cmp.recompile {
+			*fnc = *cmp.function
+			return
+		}
+	}
+}
+
+func (cmp *compiler) CompileBody(parameters []symbols.ParameterSymbol, body boundnodes.BoundBlockStatementNode, captures []symbols.VariableSymbol) {
+	// parameters come first, the caller puts them right there
+	for _, param := range parameters {
+		cmp.Local(param.Fingerprint())
+	}
+
+	for i, captured := range captures {
+		cmp.captured[captured.Fingerprint()] = i
+	}
+
+	for _, stmt := range body.Statements {
+		cmp.CompileStatement(stmt)
+	}
+
+	// falling off the end returns nothing
+	cmp.Emit(OpReturnVoid, 0, 0)
+
+	for _, jmp := range cmp.jumps {
+		target := int32(cmp.targets[jmp.Label])
+
+		if jmp.IsB {
+			cmp.function.Code[jmp.Instruction].B = target
+		} else {
+			cmp.function.Code[jmp.Instruction].A = target
+		}
+	}
+}
+
+// <HELPERS> ------------------------------------------------------------------
+
+func (cmp *compiler) Emit(op Opcode, a int, b int) int {
+	instruction := Instruction{Op: op, A: int32(a), B: int32(b)}
+	cmp.function.Code = append(cmp.function.Code, instruction)
+
+	cmp.depth += instruction.StackEffect()
+	if cmp.depth > cmp.function.MaxStack {
+		cmp.function.MaxStack = cmp.depth
+	}
+
+	return len(cmp.function.Code) - 1
+}
+
+func (cmp *compiler) Constant(value interface{}) int {
+	// strings show up a lot (field names mostly), no need to have them twice
+	if str, ok := value.(string); ok {
+		if index, ok := cmp.strings[str]; ok {
+			return index
+		}
+
+		cmp.strings[str] = len(cmp.function.Constants)
+	}
+
+	cmp.function.Constants = append(cmp.function.Constants, value)
+	return len(cmp.function.Constants) - 1
+}
+
+// stops the program once (and if) we get here, for things the tree walker only dies on when it sees them
+func (cmp *compiler) Die(message string, args ...interface{}) {
+	cmp.Emit(OpDie, cmp.Constant(fmt.Sprintf(message, args...)), 0)
+}
+
+// a new label, somewhere
+func (cmp *compiler) Label() int {
+	cmp.targets = append(cmp.targets, -1)
+	return len(cmp.targets) - 1
+}
+
+func (cmp *compiler) BoundLabel(label boundnodes.BoundLabel) int {
+	id, ok := cmp.labels[label]
+	if !ok {
+		id = cmp.Label()
+		cmp.labels[label] = id
+	}
+
+	return id
+}
+
+// the label is right here
+func (cmp *compiler) Mark(label int) {
+	cmp.targets[label] = len(cmp.function.Code)
+}
+
+func (cmp *compiler) Jump(label int) {
+	instruction := cmp.Emit(OpJump, 0, 0)
+	cmp.jumps = append(cmp.jumps, jump{Instruction: instruction, Label: label})
+}
+
+func (cmp *compiler) Branch(ifLabel int, elseLabel int) {
+	instruction := cmp.Emit(OpBranch, 0, 0)
+	cmp.jumps = append(cmp.jumps, jump{Instruction: instruction, Label: ifLabel})
+	cmp.jumps = append(cmp.jumps, jump{Instruction: instruction, Label: elseLabel, IsB: true})
+}
+
+// the slot of a local, it gets one the first time we see it
+func (cmp *compiler) Local(fingerprint string) int {
+	slot, ok := cmp.locals[fingerprint]
+	if !ok {
+		slot = cmp.function.Slots
+		cmp.locals[fingerprint] = slot
+		cmp.function.Slots++
+
+		// anything a lambda can see has to live somewhere it can share
+		if cmp.cells[fingerprint] || cmp.program.Bound.CapturedVariables[fingerprint] {
+			cmp.cells[fingerprint] = true
+			cmp.function.Cells = append(cmp.function.Cells, slot)
+		}
+	}
+
+	return slot
+}
+
+// </HELPERS> -----------------------------------------------------------------
+// <VARIABLES> ----------------------------------------------------------------
+
+func (cmp *compiler) Load(variable symbols.VariableSymbol, inMain bool) {
+	fingerprint := variable.Fingerprint()
+
+	if variable.IsGlobal() {
+		global := cmp.program.GlobalSlot(fingerprint)
+
+		// globals inside of a class are the fields of the object we're in
+		if cmp.inClass && !inMain {
+			cmp.Emit(OpLoadMember, cmp.Constant(fingerprint), global)
+			return
+		}
+
+		cmp.Emit(OpLoadGlobal, global, 0)
+		return
+	}
+
+	if index, ok := cmp.captured[fingerprint]; ok {
+		cmp.Emit(OpLoadCaptured, index, 0)
+		return
+	}
+
+	slot := cmp.Local(fingerprint)
+	if cmp.cells[fingerprint] {
+		cmp.Emit(OpLoadCell, slot, 0)
+		return
+	}
+
+	cmp.Emit(OpLoadLocal, slot, 0)
+}
+
+func (cmp *compiler) Store(variable symbols.VariableSymbol, inMain bool) {
+	fingerprint := variable.Fingerprint()
+
+	if variable.IsGlobal() {
+		global := cmp.program.GlobalSlot(fingerprint)
+
+		if cmp.inClass && !inMain {
+			cmp.Emit(OpStoreMember, cmp.Constant(fingerprint), global)
+			return
+		}
+
+		cmp.Emit(OpStoreGlobal, global, 0)
+		return
+	}
+
+	if index, ok := cmp.captured[fingerprint]; ok {
+		cmp.Emit(OpStoreCaptured, index, 0)
+		return
+	}
+
+	slot := cmp.Local(fingerprint)
+	if cmp.cells[fingerprint] {
+		cmp.Emit(OpStoreCell, slot, 0)
+		return
+	}
+
+	cmp.Emit(OpStoreLocal, slot, 0)
+}
+
+// like Store, but captured locals get a new cell (lambdas created before this keep the old one)
+func (cmp *compiler) Declare(variable symbols.VariableSymbol) {
+	fingerprint := variable.Fingerprint()
+
+	if variable.IsGlobal() {
+		cmp.Store(variable, true)
+		return
+	}
+
+	slot := cmp.Local(fingerprint)
+	if cmp.cells[fingerprint] {
+		cmp.Emit(OpDeclareCell, slot, 0)
+		return
+	}
+
+	cmp.Emit(OpStoreLocal, slot, 0)
+}
+
+// pushes a pointer to a variable (references always look for globals in the object we're in, just like the tree walker)
+func (cmp *compiler) Reference(variable symbols.VariableSymbol) {
+	fingerprint := variable.Fingerprint()
+
+	if variable.IsGlobal() {
+		global := cmp.program.GlobalSlot(fingerprint)
+
+		if cmp.inClass {
+			cmp.Emit(OpReferenceMember, cmp.Constant(fingerprint), global)
+			return
+		}
+
+		cmp.Emit(OpReferenceGlobal, global, 0)
+		return
+	}
+
+	if index, ok := cmp.captured[fingerprint]; ok {
+		cmp.Emit(OpReferenceCaptured, index, 0)
+		return
+	}
+
+	// pointers need somewhere to point, so this variable needs a cell after all
+	slot := cmp.Local(fingerprint)
+	if !cmp.cells[fingerprint] {
+		cmp.cells[fingerprint] = true
+		cmp.recompile = true
+	}
+
+	cmp.Emit(OpReferenceCell, slot, 0)
+}
+
+// </VARIABLES> ---------------------------------------------------------------
+// <STATEMENTS> ---------------------------------------------------------------
+
+func (cmp *compiler) CompileStatement(stmt boundnodes.BoundStatementNode) {
+	switch stmt.NodeType() {
+	case boundnodes.BoundVariableDeclaration:
+		declaration := stmt.(boundnodes.BoundVariableDeclarationStatementNode)
+
+		if declaration.Initializer != nil {
+			cmp.CompileExpression(declaration.Initializer)
+		} else {
+			cmp.CompileDefault(declaration.Variable.VarType())
+		}
+
+		cmp.Declare(declaration.Variable)
+
+	case boundnodes.BoundExpressionStatement:
+		expr := stmt.(boundnodes.BoundExpressionStatementNode).Expression
+
+		// assignments dont need to keep their value around if nobody wants it
+		if expr.NodeType() == boundnodes.BoundAssignmentExpression {
+			assignment := expr.(boundnodes.BoundAssignmentExpressionNode)
+			cmp.CompileExpression(assignment.Expression)
+			cmp.Store(assignment.Variable, assignment.InMain)
+			return
+		}
+
+		cmp.CompileExpression(expr)
+		cmp.Emit(OpPop, 0, 0)
+
+	case boundnodes.BoundGotoStatement:
+		cmp.Jump(cmp.BoundLabel(stmt.(boundnodes.BoundGotoStatementNode).Label))
+
+	case boundnodes.BoundConditionalGotoStatement:
+		gotoStatement := stmt.(boundnodes.BoundConditionalGotoStatementNode)
+		cmp.CompileExpression(gotoStatement.Condition)
+		cmp.Branch(cmp.BoundLabel(gotoStatement.IfLabel), cmp.BoundLabel(gotoStatement.ElseLabel))
+
+	case boundnodes.BoundLabelStatement:
+		cmp.Mark(cmp.BoundLabel(stmt.(boundnodes.BoundLabelStatementNode).Label))
+
+	case boundnodes.BoundReturnStatement:
+		returnStatement := stmt.(boundnodes.BoundReturnStatementNode)
+
+		if returnStatement.Expression != nil {
+			cmp.CompileExpression(returnStatement.Expression)
+			cmp.Emit(OpReturn, 0, 0)
+		} else {
+			cmp.Emit(OpReturnVoid, 0, 0)
+		}
+
+	case boundnodes.BoundTryStartStatement:
+		tryStatement := stmt.(boundnodes.BoundTryStartStatementNode)
+		instruction := cmp.Emit(OpTryStart, 0, 0)
+		cmp.jumps = append(cmp.jumps, jump{Instruction: instruction, Label: cmp.BoundLabel(tryStatement.CatchLabel)})
+		cmp.jumps = append(cmp.jumps, jump{Instruction: instruction, Label: cmp.BoundLabel(tryStatement.BodyLabel), IsB: true})
+		cmp.function.Handlers = true
+
+	case boundnodes.BoundTryEndStatement:
+		cmp.Emit(OpTryEnd, 0, 0)
+
+	case boundnodes.BoundThrowStatement:
+		cmp.CompileExpression(stmt.(boundnodes.BoundThrowStatementNode).Expression)
+		cmp.Emit(OpThrow, 0, 0)
+
+	default:
+		cmp.Die("Unknown statement! [%s]", stmt.NodeType())
+	}
+}
+
+// pushes the value a variable of the given type starts out with
+func (cmp *compiler) CompileDefault(typ symbols.TypeSymbol) {
+	// structs need a new one every time
+	if typ.IsUserDefined && !typ.IsObject && !typ.IsEnum {
+		cmp.Emit(OpDefault, cmp.Constant(typ), 0)
+		return
+	}
+
+	cmp.Emit(OpConstant, cmp.Constant(defaults.DefaultValue(typ)), 0)
+}
+
+// </STATEMENTS> --------------------------------------------------------------
+// <EXPRESSIONS> --------------------------------------------------------------
+
+func (cmp *compiler) CompileExpression(expr boundnodes.BoundExpressionNode) {
+	switch expr.NodeType() {
+	case boundnodes.BoundLiteralExpression:
+		cmp.CompileLiteralExpression(expr.(boundnodes.BoundLiteralExpressionNode))
+
+	case boundnodes.BoundVariableExpression:
+		variable := expr.(boundnodes.BoundVariableExpressionNode)
+		cmp.Load(variable.Variable, variable.InMain)
+
+	case boundnodes.BoundAssignmentExpression:
+		assignment := expr.(boundnodes.BoundAssignmentExpressionNode)
+		cmp.CompileExpression(assignment.Expression)
+		cmp.Emit(OpDup, 0, 0)
+		cmp.Store(assignment.Variable, assignment.InMain)
+
+	case boundnodes.BoundUnaryExpression:
+		cmp.CompileUnaryExpression(expr.(boundnodes.BoundUnaryExpressionNode))
+
+	case boundnodes.BoundBinaryExpression:
+		cmp.CompileBinaryExpression(expr.(boundnodes.BoundBinaryExpressionNode))
+
+	case boundnodes.BoundCallExpression:
+		cmp.CompileCallExpression(expr.(boundnodes.BoundCallExpressionNode))
+
+	case boundnodes.BoundPackageCallExpression:
+		call := expr.(boundnodes.BoundPackageCallExpressionNode)
+		cmp.CompileArguments(call.Arguments)
+		cmp.Emit(OpCallPackage, cmp.Constant(&packageSite{Package: evaluator.PackageName(call.Package), Function: call.Function.Name}), len(call.Arguments))
+
+	case boundnodes.BoundTypeCallExpression:
+		cmp.CompileTypeCallExpression(expr.(boundnodes.BoundTypeCallExpressionNode))
+
+	case boundnodes.BoundClassCallExpression:
+		cmp.CompileClassCallExpression(expr.(boundnodes.BoundClassCallExpressionNode))
+
+	case boundnodes.BoundClassFieldAccessExpression:
+		access := expr.(boundnodes.BoundClassFieldAccessExpressionNode)
+		cmp.CompileExpression(access.Base)
+		cmp.Emit(OpGetField, cmp.Constant(access.Field.Fingerprint()), 0)
+
+	case boundnodes.BoundClassFieldAssignmentExpression:
+		// the value comes first, just like in the tree walker
+		assignment := expr.(boundnodes.BoundClassFieldAssignmentExpressionNode)
+		cmp.CompileExpression(assignment.Value)
+		cmp.CompileExpression(assignment.Base)
+		cmp.Emit(OpSetField, cmp.Constant(assignment.Field.Fingerprint()), 0)
+
+	case boundnodes.BoundConversionExpression:
+		conv := expr.(boundnodes.BoundConversionExpressionNode)
+		cmp.CompileExpression(conv.Expression)
+		cmp.Emit(OpConvert, cmp.Constant(conversion{From: conv.Expression.Type(), To: conv.ToType}), 0)
+
+	case boundnodes.BoundLambdaExpression:
+		cmp.CompileLambdaExpression(expr.(boundnodes.BoundLambdaExpressionNode))
+
+	case boundnodes.BoundFunctionExpression:
+		function := expr.(boundnodes.BoundFunctionExpressionNode)
+		reference := &evaluator.FunctionReference{Function: function.Function}
+
+		// class functions need to know where to find themselves
+		if function.InClass.Exists && !function.Function.BuiltIn {
+			cls := function.InClass
+			reference.Class = &cls
+		}
+
+		cmp.Emit(OpFunction, cmp.Constant(reference), 0)
+
+	case boundnodes.BoundCaughtExceptionExpression:
+		cmp.Emit(OpCaught, 0, 0)
+
+	case boundnodes.BoundMakeExpression:
+		cmp.CompileMakeExpression(expr.(boundnodes.BoundMakeExpressionNode))
+
+	case boundnodes.BoundMakeArrayExpression:
+		cmp.CompileMakeArrayExpression(expr.(boundnodes.BoundMakeArrayExpressionNode))
+
+	case boundnodes.BoundMakeMapExpression:
+		cmp.Emit(OpMakeMap, cmp.Constant(expr.(boundnodes.BoundMakeMapExpressionNode).MapType), 0)
+
+	case boundnodes.BoundMakeStructExpression:
+		cmp.CompileMakeStructExpression(expr.(boundnodes.BoundMakeStructExpressionNode))
+
+	case boundnodes.BoundArrayAccessExpression:
+		access := expr.(boundnodes.BoundArrayAccessExpressionNode)
+		cmp.CompileExpression(access.Base)
+		cmp.CompileExpression(access.Index)
+
+		// is this actually a sneaky pointer access?
+		if access.IsPointer {
+			cmp.Emit(OpGetPointer, 0, 0)
+		} else {
+			cmp.Emit(OpGetElement, 0, 0)
+		}
+
+	case boundnodes.BoundArrayAssignmentExpression:
+		assignment := expr.(boundnodes.BoundArrayAssignmentExpressionNode)
+		cmp.CompileExpression(assignment.Base)
+		cmp.CompileExpression(assignment.Index)
+		cmp.CompileExpression(assignment.Value)
+
+		if assignment.IsPointer {
+			cmp.Emit(OpSetPointer, 0, 0)
+		} else {
+			cmp.Emit(OpSetElement, 0, 0)
+		}
+
+	case boundnodes.BoundTernaryExpression:
+		cmp.CompileTernaryExpression(expr.(boundnodes.BoundTernaryExpressionNode))
+
+	case boundnodes.BoundMatchExpression:
+		cmp.CompileMatchExpression(expr.(boundnodes.BoundMatchExpressionNode))
+
+	case boundnodes.BoundInterpolatedStringExpression:
+		parts := expr.(boundnodes.BoundInterpolatedStringExpressionNode).Parts
+		cmp.CompileArguments(parts)
+		cmp.Emit(OpConcat, 0, len(parts))
+
+	case boundnodes.BoundReferenceExpression:
+		cmp.Reference(expr.(boundnodes.BoundReferenceExpressionNode).Expression.(boundnodes.BoundVariableExpressionNode).Variable)
+
+	case boundnodes.BoundDereferenceExpression:
+		cmp.CompileExpression(expr.(boundnodes.BoundDereferenceExpressionNode).Expression)
+		cmp.Emit(OpDereference, 0, 0)
+
+	case boundnodes.BoundThisExpression:
+		cmp.Emit(OpThis, 0, 0)
+
+	case boundnodes.BoundEnumExpression:
+		cmp.Emit(OpConstant, cmp.Constant(int32(expr.(boundnodes.BoundEnumExpressionNode).Value)), 0)
+
+	default:
+		cmp.Die("Unknown expression! [%s]", expr.NodeType())
+		cmp.depth++
+	}
+}
+
+func (cmp *compiler) CompileArguments(arguments []boundnodes.BoundExpressionNode) {
+	for _, arg := range arguments {
+		cmp.CompileExpression(arg)
+	}
+}
+
+func (cmp *compiler) CompileLiteralExpression(expr boundnodes.BoundLiteralExpressionNode) {
+	switch value := expr.Value.(type) {
+	case nil:
+		cmp.Emit(OpNull, 0, 0)
+		return
+
+	case int:
+		cmp.Emit(OpConstant, cmp.Constant(int32(value)), 0)
+		return
+
+	case string:
+		// native strings (aka byte pointers) are a new buffer every time
+		if expr.Type().Name == builtins.Pointer.Name {
+			cmp.Emit(OpNativeString, cmp.Constant(value), 0)
+			return
+		}
+	}
+
+	cmp.Emit(OpConstant, cmp.Constant(expr.Value), 0)
+}
+
+func (cmp *compiler) CompileUnaryExpression(expr boundnodes.BoundUnaryExpressionNode) {
+	cmp.CompileExpression(expr.Expression)
+
+	switch expr.Op.OperatorKind {
+	case boundnodes.Identity:
+		// nothing to do
+
+	case boundnodes.Negation:
+		cmp.Emit(OpNegate, 0, 0)
+
+	case boundnodes.LogicalNegation:
+		cmp.Emit(OpNot, 0, 0)
+
+	default:
+		cmp.Die("Unknown unary operation! [%s]", expr.Op.OperatorKind)
+	}
+}
+
+func (cmp *compiler) CompileBinaryExpression(expr boundnodes.BoundBinaryExpressionNode) {
+	// both sides always get evaluated, even for && and || (just like in compiled programs)
+	cmp.CompileExpression(expr.Left)
+	cmp.CompileExpression(expr.Right)
+
+	left := expr.Left.Type().Fingerprint()
+	right := expr.Right.Type().Fingerprint()
+
+	// ints and longs get their own instructions
+	if left == right && (left == builtins.Int.Fingerprint() || left == builtins.Long.Fingerprint()) {
+		for i, kind := range fastOperators {
+			if kind != expr.Op.OperatorKind {
+				continue
+			}
+
+			if left == builtins.Int.Fingerprint() {
+				cmp.Emit(OpAddInt+Opcode(i), 0, 0)
+			} else {
+				cmp.Emit(OpAddLong+Opcode(i), 0, 0)
+			}
+
+			return
+		}
+	}
+
+	cmp.Emit(OpBinary, cmp.Constant(binaryOperation{
+		Kind:    expr.Op.OperatorKind,
+		String:  left == builtins.String.Fingerprint(),
+		Pointer: expr.Left.Type().Name == builtins.Pointer.Name,
+		Left:    left,
+	}), 0)
+}
+
+func (cmp *compiler) CompileCallExpression(expr boundnodes.BoundCallExpressionNode) {
+	cmp.CompileArguments(expr.Arguments)
+	fingerprint := expr.Function.Fingerprint()
+
+	// inside of a class, calls go to the object we're in
+	if cmp.inClass && !expr.InMain && !expr.Function.BuiltIn {
+		site := &callSite{Symbol: expr.Function, Fingerprint: fingerprint, Static: cmp.program.Lookup[fingerprint]}
+		cmp.Emit(OpCallSelf, cmp.Constant(site), len(expr.Arguments))
+		return
+	}
+
+	fnc, ok := cmp.program.Lookup[fingerprint]
+	if expr.Function.External || !ok {
+		cmp.DieUnavailable(expr.Function)
+		cmp.depth += 1 - len(expr.Arguments)
+		return
+	}
+
+	cmp.Emit(OpCall, cmp.Constant(fnc), len(expr.Arguments))
+}
+
+// functions we dont have any code for
+func (cmp *compiler) DieUnavailable(fnc symbols.FunctionSymbol) {
+	if fnc.External {
+		cmp.Die("External function '%s' is not available in interpreter mode!", fnc.Name)
+		return
+	}
+
+	cmp.Die("Unknown function! [%s]", fnc.Fingerprint())
+}
+
+func (cmp *compiler) CompileClassCallExpression(expr boundnodes.BoundClassCallExpressionNode) {
+	// run a null check on the base before any of the arguments
+	cmp.CompileExpression(expr.Base)
+	cmp.Emit(OpCheckObject, 0, 0)
+	cmp.CompileArguments(expr.Arguments)
+
+	// calls to a base class' constructor
+	if expr.Function.Name == "Constructor" {
+		cmp.Emit(OpCallConstructor, cmp.Constant(cmp.program.Classes[expr.Base.Type().Name]), len(expr.Arguments))
+		return
+	}
+
+	site := &callSite{Symbol: expr.Function, Fingerprint: expr.Function.Fingerprint()}
+	cmp.Emit(OpCallMethod, cmp.Constant(site), len(expr.Arguments))
+}
+
+func (cmp *compiler) CompileMakeExpression(expr boundnodes.BoundMakeExpressionNode) {
+	cmp.CompileArguments(expr.Arguments)
+
+	site := &makeSite{Symbol: expr.BaseType, Class: cmp.program.Classes[expr.BaseType.Name]}
+	cmp.Emit(OpMake, cmp.Constant(site), len(expr.Arguments))
+}
+
+func (cmp *compiler) CompileMakeArrayExpression(expr boundnodes.BoundMakeArrayExpressionNode) {
+	if expr.IsLiteral {
+		cmp.CompileArguments(expr.Literals)
+		cmp.Emit(OpMakeArrayLiteral, cmp.Constant(expr.Type()), len(expr.Literals))
+		return
+	}
+
+	cmp.CompileExpression(expr.Length)
+	cmp.Emit(OpMakeArray, cmp.Constant(&arraySite{Type: expr.Type(), BaseType: expr.BaseType}), 0)
+}
+
+func (cmp *compiler) CompileMakeStructExpression(expr boundnodes.BoundMakeStructExpressionNode) {
+	var stc symbols.StructSymbol
+	for _, candidate := range cmp.program.Bound.Structs {
+		if candidate.Name == expr.StructType.Name {
+			stc = candidate
+		}
+	}
+
+	// fields get filled in order, anything we dont have a value for keeps its default
+	literals := expr.Literals
+	if len(literals) > len(stc.Fields) {
+		literals = literals[:len(stc.Fields)]
+	}
+
+	cmp.CompileArguments(literals)
+	cmp.Emit(OpMakeStruct, cmp.Constant(stc), len(literals))
+}
+
+func (cmp *compiler) CompileTernaryExpression(expr boundnodes.BoundTernaryExpressionNode) {
+	ifLabel, elseLabel, endLabel := cmp.Label(), cmp.Label(), cmp.Label()
+
+	cmp.CompileExpression(expr.Condition)
+	cmp.Branch(ifLabel, elseLabel)
+	depth := cmp.depth
+
+	cmp.Mark(ifLabel)
+	cmp.CompileExpression(expr.If)
+	cmp.Jump(endLabel)
+
+	cmp.depth = depth
+	cmp.Mark(elseLabel)
+	cmp.CompileExpression(expr.Else)
+
+	cmp.Mark(endLabel)
+}
+
+func (cmp *compiler) CompileMatchExpression(expr boundnodes.BoundMatchExpressionNode) {
+	cmp.CompileExpression(expr.Expression)
+	cmp.Store(expr.Variable, false)
+
+	endLabel := cmp.Label()
+	valueLabels := make([]int, 0)
+
+	// the first case that fits gets to decide the value
+	for _, condition := range expr.Conditions {
+		valueLabel, nextLabel := cmp.Label(), cmp.Label()
+		valueLabels = append(valueLabels, valueLabel)
+
+		cmp.CompileExpression(condition)
+		cmp.Branch(valueLabel, nextLabel)
+		cmp.Mark(nextLabel)
+	}
+
+	depth := cmp.depth
+	cmp.CompileExpression(expr.DefaultValue)
+	cmp.Jump(endLabel)
+
+	for i, value := range expr.Values {
+		cmp.depth = depth
+		cmp.Mark(valueLabels[i])
+		cmp.CompileExpression(value)
+		cmp.Jump(endLabel)
+	}
+
+	cmp.Mark(endLabel)
+}
+
+func (cmp *compiler) CompileLambdaExpression(expr boundnodes.BoundLambdaExpressionNode) {
+	fnc := &Function{Name: "lambda", Symbol: expr.Function}
+	cmp.program.CompileFunction(fnc, expr.Function.Parameters, expr.Body, expr.CapturesThis, expr.Captures)
+
+	// remember where each captured variable is right now
+	captures := make([]capture, 0)
+	for _, captured := range expr.Captures {
+		fingerprint := captured.Fingerprint()
+
+		// if we captured it ourselves, pass it along
+		if index, ok := cmp.captured[fingerprint]; ok {
+			captures = append(captures, capture{Captured: true, Index: index})
+			continue
+		}
+
+		slot := cmp.Local(fingerprint)
+		if !cmp.cells[fingerprint] {
+			cmp.cells[fingerprint] = true
+			cmp.recompile = true
+		}
+
+		captures = append(captures, capture{Index: slot})
+	}
+
+	cmp.Emit(OpClosure, cmp.Constant(&lambda{Function: fnc, Captures: captures, This: expr.CapturesThis}), 0)
+}
+
+// </EXPRESSIONS> -------------------------------------------------------------
+// <TYPECALLS> ----------------------------------------------------------------
+
+func (cmp *compiler) CompileTypeCallExpression(expr boundnodes.BoundTypeCallExpressionNode) {
+	cmp.CompileExpression(expr.Base)
+
+	// if this is an object type -> do a null check before calling
+	if expr.Base.Type().IsObject {
+		cmp.Emit(OpCheckNull, 0, 0)
+	}
+
+	switch expr.Function.Fingerprint() {
+	case builtins.GetLength.Fingerprint():
+		cmp.Emit(OpStringLength, 0, 0)
+		return
+
+	case builtins.GetBuffer.Fingerprint():
+		cmp.Emit(OpStringBuffer, 0, 0)
+		return
+
+	case builtins.GetMessage.Fingerprint():
+		// the exception already is its message
+		return
+
+	case builtins.Substring.Fingerprint():
+		cmp.CompileArguments(expr.Arguments[:2])
+		cmp.Emit(OpSubstring, 0, 0)
+		return
+
+	case builtins.GetArrayLength.Fingerprint():
+		cmp.Emit(OpArrayLength, 0, 0)
+		return
+
+	case builtins.Push.Fingerprint(), builtins.PPush.Fingerprint():
+		cmp.CompileExpression(expr.Arguments[0])
+		cmp.Emit(OpArrayPush, 0, 0)
+		return
+
+	case builtins.MapGetLength.Fingerprint():
+		cmp.Emit(OpMapLength, 0, 0)
+		return
+
+	case builtins.Kill.Fingerprint():
+		cmp.Emit(OpThreadKill, 0, 0)
+		return
+
+	case builtins.Start.Fingerprint():
+		cmp.Emit(OpThreadStart, 0, 0)
+		return
+
+	case builtins.Join.Fingerprint():
+		cmp.Emit(OpThreadJoin, 0, 0)
+		return
+	}
+
+	// the funky ones:
+	// (these cant be identified by their fingerprint because its generated procedurally)
+	if expr.Function.OriginType.Fingerprint() == builtins.Map.Fingerprint() {
+		switch expr.Function.Name {
+		case builtins.Keys.Name:
+			cmp.Emit(OpMapKeys, cmp.Constant(expr.Type()), 0)
+
+		case builtins.Has.Name:
+			cmp.CompileExpression(expr.Arguments[0])
+			cmp.Emit(OpMapHas, 0, 0)
+
+		case builtins.Remove.Name:
+			cmp.CompileExpression(expr.Arguments[0])
+			cmp.Emit(OpMapRemove, 0, 0)
+
+		default:
+			cmp.Die("Unknown map function! [%s]", expr.Function.Fingerprint())
+		}
+
+		return
+	}
+
+	switch expr.Function.Name {
+	case builtins.Run.Name:
+		arguments := expr.Arguments[:len(expr.Function.Parameters)]
+		cmp.CompileArguments(arguments)
+		cmp.Emit(OpRunAction, 0, len(arguments))
+
+	case builtins.RunThread.Name:
+		arguments := expr.Arguments[:len(expr.Function.Parameters)]
+		cmp.CompileArguments(arguments)
+		cmp.Emit(OpRunThread, 0, len(arguments))
+
+	default:
+		cmp.Die("Unknown type function! [%s]", expr.Function.Fingerprint())
+	}
+}
+
+// </TYPECALLS> ---------------------------------------------------------------
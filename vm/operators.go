@@ -0,0 +1,122 @@
+package vm
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/nodes/boundnodes"
+)
+
+// <UNARY> --------------------------------------------------------------------
+
+func negate(value interface{}) interface{} {
+	switch number := value.(type) {
+	case int32:
+		return -number
+	case float32:
+		return -number
+	case float64:
+		return -number
+	}
+
+	return evaluator.WrapInteger(-evaluator.IntegerValue(value), value)
+}
+
+// </UNARY> -------------------------------------------------------------------
+// <BINARY> -------------------------------------------------------------------
+
+// same thing the evaluator does, we just already know what the left side is supposed to be
+func (vm *VM) binary(op binaryOperation, left interface{}, right interface{}) interface{} {
+	evl := vm.Evaluator
+
+	if op.String {
+		return evl.StringOperation(op.Kind, left, right)
+	}
+
+	if op.Pointer {
+		return evl.PointerOperation(op.Kind, left, right)
+	}
+
+	switch l := left.(type) {
+	case bool:
+		return evl.BoolOperation(op.Kind, l, right.(bool))
+	case float32:
+		return evl.FloatOperation(op.Kind, float64(l), float64(right.(float32)), func(result float64) interface{} { return float32(result) })
+	case float64:
+		return evl.FloatOperation(op.Kind, l, right.(float64), func(result float64) interface{} { return result })
+	case byte, int32, uint32, int64, uint64:
+		return evl.IntegerOperation(op.Kind, left, right)
+	}
+
+	// everything else (objects, arrays, ...) can only be compared
+	switch op.Kind {
+	case boundnodes.Equals:
+		return left == right
+	case boundnodes.NotEquals:
+		return left != right
+	}
+
+	evl.Die("How did this even happen..? (invalid type on operator evaluation) [%s %s]", op.Left, op.Kind)
+	return nil
+}
+
+func (vm *VM) intOperation(op Opcode, left interface{}, right interface{}) interface{} {
+	a, ok := left.(int32)
+	b, ok2 := right.(int32)
+
+	// should never happen, but the slow way always works
+	if !ok || !ok2 {
+		return vm.Evaluator.IntegerOperation(fastOperators[op-OpAddInt], left, right)
+	}
+
+	switch op {
+	case OpAddInt:
+		return a + b
+	case OpSubtractInt:
+		return a - b
+	case OpMultiplyInt:
+		return a * b
+	case OpLessInt:
+		return a < b
+	case OpLessOrEqualsInt:
+		return a <= b
+	case OpGreaterInt:
+		return a > b
+	case OpGreaterOrEqualsInt:
+		return a >= b
+	case OpEqualsInt:
+		return a == b
+	}
+
+	return a != b
+}
+
+func (vm *VM) longOperation(op Opcode, left interface{}, right interface{}) interface{} {
+	a, ok := left.(int64)
+	b, ok2 := right.(int64)
+
+	if !ok || !ok2 {
+		return vm.Evaluator.IntegerOperation(fastOperators[op-OpAddLong], left, right)
+	}
+
+	switch op {
+	case OpAddLong:
+		return a + b
+	case OpSubtractLong:
+		return a - b
+	case OpMultiplyLong:
+		return a * b
+	case OpLessLong:
+		return a < b
+	case OpLessOrEqualsLong:
+		return a <= b
+	case OpGreaterLong:
+		return a > b
+	case OpGreaterOrEqualsLong:
+		return a >= b
+	case OpEqualsLong:
+		return a == b
+	}
+
+	return a != b
+}
+
+// </BINARY> ------------------------------------------------------------------
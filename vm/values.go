@@ -0,0 +1,88 @@
+package vm
+
+import (
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+)
+
+// the fields of an object or a struct
+func (vm *VM) fields(base interface{}) map[string]interface{} {
+	switch value := base.(type) {
+	case *evaluator.Object:
+		return value.Fields
+	case *evaluator.Struct:
+		return value.Fields
+	}
+
+	vm.Evaluator.Throw(evaluator.NullPointerMessage)
+	return nil
+}
+
+func (vm *VM) element(base interface{}, index interface{}) interface{} {
+	switch base := base.(type) {
+	case *evaluator.Map:
+		value, ok := base.Values[index]
+		if !ok {
+			vm.Evaluator.Throw("Key not found in map!")
+		}
+
+		return value
+
+	case *evaluator.Array:
+		return base.Elements[vm.Evaluator.ArrayIndex(base, index)]
+	}
+
+	vm.Evaluator.Throw(evaluator.NullPointerMessage)
+	return nil
+}
+
+func (vm *VM) setElement(base interface{}, index interface{}, value interface{}) {
+	switch base := base.(type) {
+	case *evaluator.Map:
+		// new keys go onto the end
+		if _, ok := base.Values[index]; !ok {
+			base.Keys = append(base.Keys, index)
+		}
+
+		base.Values[index] = evaluator.CopyValue(value)
+		return
+
+	case *evaluator.Array:
+		base.Elements[vm.Evaluator.ArrayIndex(base, index)] = evaluator.CopyValue(value)
+		return
+	}
+
+	vm.Evaluator.Throw(evaluator.NullPointerMessage)
+}
+
+func (vm *VM) substring(str string, start int64, length int64) string {
+	// make sure the substring is valid
+	if start < 0 {
+		vm.Evaluator.Throw("Substring start-index cannot be negative!")
+	} else if length < 0 {
+		vm.Evaluator.Throw("Substring length cannot be negative!")
+	} else if start+length > int64(len(str)) {
+		vm.Evaluator.Throw("Substring out of range!")
+	}
+
+	return str[start : start+length]
+}
+
+func removeKey(mp *evaluator.Map, key interface{}) {
+	if _, ok := mp.Values[key]; !ok {
+		return
+	}
+
+	delete(mp.Values, key)
+
+	for i, k := range mp.Keys {
+		if k == key {
+			mp.Keys = append(mp.Keys[:i], mp.Keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// a pointer straight at a global's slot
+func (vm *VM) globalPointer(slot int) evaluator.Pointer {
+	return evaluator.Pointer{Memory: &evaluator.Memory{Cells: vm.globals[slot : slot+1 : slot+1]}}
+}
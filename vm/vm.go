@@ -0,0 +1,747 @@
+package vm
+
+import (
+	"strings"
+
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/binder"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/evaluator"
+	"github.com/ReCT-Lang/ReCT-Go-Compiler/symbols"
+)
+
+// the virtual machine
+// values, threads, packages and errors all work exactly like they do in the evaluator (it even keeps its call stack
+// up to date), the only thing thats different is how we get around the program
+
+type VM struct {
+	Program   *Program
+	Evaluator *evaluator.Evaluator
+
+	// globals are shared between all threads
+	globals []interface{}
+
+	// every call gets its slots and its operand stack on here, right after the ones of its caller
+	stack []interface{}
+}
+
+// a lambda value, holds on to the cells of everything it captured
+type Closure struct {
+	Function *Function
+	Cells    []*evaluator.Memory
+	This     *evaluator.Object // the object it was created in (if it uses it)
+}
+
+// an active try block
+type handler struct {
+	Catch int
+	Depth int // how many frames were on the stack when we entered
+}
+
+// how many values the stack starts out with (it grows if it has to)
+const initialStack = 1024
+
+func CreateVM(program *Program) *VM {
+	machine := &VM{
+		Program:   program,
+		Evaluator: evaluator.CreateEvaluator(program.Bound),
+		globals:   make([]interface{}, program.Globals),
+		stack:     make([]interface{}, initialStack),
+	}
+
+	if program.Bound.GlobalScope != nil {
+		for _, global := range program.Bound.GlobalScope.Variables {
+			machine.globals[program.GlobalSlots[global.Fingerprint()]] = machine.Evaluator.DefaultValue(global.VarType())
+		}
+	}
+
+	return machine
+}
+
+// compiles and runs a program, anything that goes wrong takes the whole process down (just like Evaluate)
+func Run(program binder.BoundProgram, printBytecode bool) {
+	compiled := Compile(program)
+
+	if printBytecode {
+		compiled.Print()
+	}
+
+	machine := CreateVM(compiled)
+	evaluator.RunProgram(machine.Evaluator, machine.RunMain)
+}
+
+func (vm *VM) RunMain() {
+	vm.Invoke(vm.Program.Main, 0, nil, nil, vm.Program.Main.Name)
+}
+
+// <CALLS> --------------------------------------------------------------------
+
+// runs a function whose arguments are already on the stack at bp
+func (vm *VM) Invoke(fnc *Function, bp int, this *evaluator.Object, captured []*evaluator.Memory, name string) interface{} {
+	evl := vm.Evaluator
+
+	// make sure everything fits
+	if need := bp + fnc.Slots + fnc.MaxStack; need > len(vm.stack) {
+		size := len(vm.stack) * 2
+		if size < need {
+			size = need
+		}
+
+		stack := make([]interface{}, size)
+		copy(stack, vm.stack)
+		vm.stack = stack
+	}
+
+	stack := vm.stack
+	for i := bp; i < bp+fnc.Parameters; i++ {
+		stack[i] = evaluator.CopyValue(stack[i])
+	}
+
+	for i := bp + fnc.Parameters; i < bp+fnc.Slots; i++ {
+		stack[i] = nil
+	}
+
+	// every call gets new cells (parameters bring their value with them)
+	for _, slot := range fnc.Cells {
+		stack[bp+slot] = &evaluator.Memory{Cells: []interface{}{stack[bp+slot]}}
+	}
+
+	evl.PushFrame(evaluator.Frame{Name: name, This: this})
+	evl.Step()
+
+	var result interface{}
+	if fnc.Handlers {
+		result = vm.executeProtected(fnc, bp, this, captured)
+	} else {
+		result = vm.execute(fnc, bp, this, captured, 0, nil)
+	}
+
+	evl.Frames = evl.Frames[:len(evl.Frames)-1]
+	return result
+}
+
+// runs a function that has try blocks in it, catching whatever gets thrown
+func (vm *VM) executeProtected(fnc *Function, bp int, this *evaluator.Object, captured []*evaluator.Memory) interface{} {
+	evl := vm.Evaluator
+	handlers := make([]handler, 0)
+	pc := 0
+
+	for {
+		result, exception := vm.tryExecute(fnc, bp, this, captured, pc, &handlers)
+		if exception == nil {
+			return result
+		}
+
+		// no try block in here -> someone further up has to deal with this
+		if len(handlers) == 0 {
+			panic(*exception)
+		}
+
+		handler := handlers[len(handlers)-1]
+		handlers = handlers[:len(handlers)-1]
+
+		// throw away the frames of any calls that never got to finish
+		evl.Frames = evl.Frames[:handler.Depth]
+
+		evl.CaughtException = exception.Message
+		pc = handler.Catch
+	}
+}
+
+func (vm *VM) tryExecute(fnc *Function, bp int, this *evaluator.Object, captured []*evaluator.Memory, pc int, handlers *[]handler) (result interface{}, exception *evaluator.Exception) {
+	// if anything gets thrown, hand it back to executeProtected
+	defer func() {
+		if r := recover(); r != nil {
+			thrown, ok := r.(evaluator.Exception)
+			if !ok {
+				panic(r)
+			}
+
+			exception = &thrown
+		}
+	}()
+
+	return vm.execute(fnc, bp, this, captured, pc, handlers), nil
+}
+
+// finds the method an object actually uses for a call (and remembers it for next time)
+func (vm *VM) method(site *callSite, object *evaluator.Object) (*Function, string) {
+	if site.method != nil && site.class == object.Class.Name {
+		return site.method, site.name
+	}
+
+	for current := &object.Class; current != nil; current = current.Parent {
+		class, ok := vm.Program.Classes[current.Name]
+		if !ok {
+			continue
+		}
+
+		if method, ok := class.Methods[site.Fingerprint]; ok {
+			site.class, site.method, site.name = object.Class.Name, method, object.Class.Name+"->"+method.Name
+			return site.method, site.name
+		}
+	}
+
+	vm.Evaluator.Die("Unknown class function! [%s]", site.Fingerprint)
+	return nil, ""
+}
+
+// calls whatever is stored in an action, its arguments are on the stack at bp
+func (vm *VM) callAction(action interface{}, bp int, count int) interface{} {
+	evl := vm.Evaluator
+
+	switch action := action.(type) {
+	case *Closure:
+		// lambdas are never class members, but they can use the object they were created in
+		return vm.Invoke(action.Function, bp, action.This, action.Cells, "lambda")
+
+	case *evaluator.FunctionReference:
+		// class functions get the object they're called on as their first argument
+		if action.Class != nil {
+			var this *evaluator.Object
+			if count > 0 {
+				this, _ = vm.stack[bp].(*evaluator.Object)
+			}
+
+			if this == nil {
+				evl.Throw(evaluator.NullPointerMessage)
+			}
+
+			fingerprint := action.Function.Fingerprint()
+			for current := action.Class; current != nil; current = current.Parent {
+				class, ok := vm.Program.Classes[current.Name]
+				if !ok {
+					continue
+				}
+
+				if method, ok := class.Methods[fingerprint]; ok {
+					return vm.Invoke(method, bp+1, this, nil, this.Class.Name+"->"+method.Name)
+				}
+			}
+
+			evl.Die("Unknown class function! [%s]", fingerprint)
+		}
+
+		if action.Function.External {
+			evl.Die("External function '%s' is not available in interpreter mode!", action.Function.Name)
+		}
+
+		fnc, ok := vm.Program.Lookup[action.Function.Fingerprint()]
+		if !ok {
+			evl.Die("Unknown function! [%s]", action.Function.Fingerprint())
+		}
+
+		return vm.Invoke(fnc, bp, nil, nil, fnc.Name)
+	}
+
+	evl.Throw(evaluator.NullPointerMessage)
+	return nil
+}
+
+// </CALLS> -------------------------------------------------------------------
+// <THREADS> ------------------------------------------------------------------
+
+func (vm *VM) StartThread(thread *evaluator.Thread) {
+	vm.Evaluator.SpawnThread(thread, func(child *evaluator.Evaluator) {
+		// same program, same globals, but a stack of its own
+		machine := &VM{Program: vm.Program, Evaluator: child, globals: vm.globals, stack: make([]interface{}, initialStack)}
+
+		count := len(thread.Arguments)
+		if count > len(machine.stack) {
+			machine.stack = make([]interface{}, count)
+		}
+
+		copy(machine.stack, thread.Arguments)
+		machine.callAction(thread.Action, 0, count)
+	})
+}
+
+// </THREADS> -----------------------------------------------------------------
+// <EXECUTION> ----------------------------------------------------------------
+
+// the actual interpreter loop
+func (vm *VM) execute(fnc *Function, bp int, this *evaluator.Object, captured []*evaluator.Memory, pc int, handlers *[]handler) interface{} {
+	evl := vm.Evaluator
+	globals := vm.globals
+	code := fnc.Code
+	constants := fnc.Constants
+
+	stack := vm.stack
+	sp := bp + fnc.Slots
+
+	for {
+		ins := code[pc]
+		pc++
+
+		switch ins.Op {
+		// <STACK> ------------------------------------------------------------
+		case OpConstant:
+			stack[sp] = constants[ins.A]
+			sp++
+
+		case OpNull:
+			stack[sp] = nil
+			sp++
+
+		case OpPop:
+			sp--
+
+		case OpDup:
+			stack[sp] = stack[sp-1]
+			sp++
+
+		case OpDefault:
+			stack[sp] = evl.DefaultValue(constants[ins.A].(symbols.TypeSymbol))
+			sp++
+
+		case OpNativeString:
+			stack[sp] = evaluator.NativeString(constants[ins.A].(string))
+			sp++
+
+		// <VARIABLES> --------------------------------------------------------
+		case OpLoadLocal:
+			stack[sp] = stack[bp+int(ins.A)]
+			sp++
+
+		case OpStoreLocal:
+			sp--
+			stack[bp+int(ins.A)] = evaluator.CopyValue(stack[sp])
+
+		case OpLoadCell:
+			stack[sp] = stack[bp+int(ins.A)].(*evaluator.Memory).Cells[0]
+			sp++
+
+		case OpStoreCell:
+			sp--
+			stack[bp+int(ins.A)].(*evaluator.Memory).Cells[0] = evaluator.CopyValue(stack[sp])
+
+		case OpDeclareCell:
+			sp--
+			stack[bp+int(ins.A)] = &evaluator.Memory{Cells: []interface{}{evaluator.CopyValue(stack[sp])}}
+
+		case OpLoadCaptured:
+			stack[sp] = captured[ins.A].Cells[0]
+			sp++
+
+		case OpStoreCaptured:
+			sp--
+			captured[ins.A].Cells[0] = evaluator.CopyValue(stack[sp])
+
+		case OpLoadGlobal:
+			stack[sp] = globals[ins.A]
+			sp++
+
+		case OpStoreGlobal:
+			sp--
+			globals[ins.A] = evaluator.CopyValue(stack[sp])
+
+		case OpLoadMember:
+			if this != nil {
+				stack[sp] = this.Fields[constants[ins.A].(string)]
+			} else {
+				stack[sp] = globals[ins.B]
+			}
+			sp++
+
+		case OpStoreMember:
+			sp--
+			if this != nil {
+				this.Fields[constants[ins.A].(string)] = evaluator.CopyValue(stack[sp])
+			} else {
+				globals[ins.B] = evaluator.CopyValue(stack[sp])
+			}
+
+		case OpThis:
+			// a nil *Object is not the same as nil
+			if this != nil {
+				stack[sp] = this
+			} else {
+				stack[sp] = nil
+			}
+			sp++
+
+		// <POINTERS> ---------------------------------------------------------
+		case OpReferenceCell:
+			stack[sp] = evaluator.Pointer{Memory: stack[bp+int(ins.A)].(*evaluator.Memory)}
+			sp++
+
+		case OpReferenceCaptured:
+			stack[sp] = evaluator.Pointer{Memory: captured[ins.A]}
+			sp++
+
+		case OpReferenceGlobal:
+			stack[sp] = vm.globalPointer(int(ins.A))
+			sp++
+
+		case OpReferenceMember:
+			if this != nil {
+				stack[sp] = evaluator.Pointer{Memory: &evaluator.Memory{Locals: this.Fields, Name: constants[ins.A].(string)}}
+			} else {
+				stack[sp] = vm.globalPointer(int(ins.B))
+			}
+			sp++
+
+		case OpDereference:
+			stack[sp-1] = evl.Load(evaluator.AsPointer(stack[sp-1]))
+
+		// <CONTROL FLOW> -----------------------------------------------------
+		case OpJump:
+			// going backwards means we're in a loop, let the other threads have a go every now and then
+			if int(ins.A) < pc {
+				evl.Step()
+			}
+			pc = int(ins.A)
+
+		case OpBranch:
+			sp--
+			target := int(ins.B)
+			if stack[sp].(bool) {
+				target = int(ins.A)
+			}
+
+			if target < pc {
+				evl.Step()
+			}
+			pc = target
+
+		case OpReturn:
+			return evaluator.CopyValue(stack[sp-1])
+
+		case OpReturnVoid:
+			return nil
+
+		case OpTryStart:
+			*handlers = append(*handlers, handler{Catch: int(ins.A), Depth: len(evl.Frames)})
+			pc = int(ins.B)
+
+		case OpTryEnd:
+			if len(*handlers) > 0 {
+				*handlers = (*handlers)[:len(*handlers)-1]
+			}
+
+		case OpThrow:
+			sp--
+			evl.Throw(evl.StringValue(stack[sp]))
+
+		case OpCaught:
+			stack[sp] = evl.CaughtException
+			sp++
+
+		case OpDie:
+			evl.Die("%s", constants[ins.A].(string))
+
+		// <OPERATORS> --------------------------------------------------------
+		case OpNegate:
+			stack[sp-1] = negate(stack[sp-1])
+
+		case OpNot:
+			stack[sp-1] = !stack[sp-1].(bool)
+
+		case OpBinary:
+			sp--
+			stack[sp-1] = vm.binary(constants[ins.A].(binaryOperation), stack[sp-1], stack[sp])
+
+		case OpConvert:
+			conv := constants[ins.A].(conversion)
+			stack[sp-1] = evl.Convert(stack[sp-1], conv.From, conv.To)
+
+		case OpAddInt, OpSubtractInt, OpMultiplyInt, OpLessInt, OpLessOrEqualsInt, OpGreaterInt, OpGreaterOrEqualsInt, OpEqualsInt, OpNotEqualsInt:
+			sp--
+			stack[sp-1] = vm.intOperation(ins.Op, stack[sp-1], stack[sp])
+
+		case OpAddLong, OpSubtractLong, OpMultiplyLong, OpLessLong, OpLessOrEqualsLong, OpGreaterLong, OpGreaterOrEqualsLong, OpEqualsLong, OpNotEqualsLong:
+			sp--
+			stack[sp-1] = vm.longOperation(ins.Op, stack[sp-1], stack[sp])
+
+		// <CALLS> ------------------------------------------------------------
+		case OpCall:
+			callee := constants[ins.A].(*Function)
+			base := sp - int(ins.B)
+
+			result := vm.Invoke(callee, base, nil, nil, callee.Name)
+			stack = vm.stack
+			stack[base] = result
+			sp = base + 1
+
+		case OpCallSelf:
+			site := constants[ins.A].(*callSite)
+			base := sp - int(ins.B)
+
+			var result interface{}
+			if this != nil {
+				method, name := vm.method(site, this)
+				result = vm.Invoke(method, base, this, nil, name)
+			} else {
+				if site.Symbol.External {
+					evl.Die("External function '%s' is not available in interpreter mode!", site.Symbol.Name)
+				} else if site.Static == nil {
+					evl.Die("Unknown function! [%s]", site.Fingerprint)
+				}
+
+				result = vm.Invoke(site.Static, base, nil, nil, site.Static.Name)
+			}
+
+			stack = vm.stack
+			stack[base] = result
+			sp = base + 1
+
+		case OpCallMethod:
+			base := sp - int(ins.B)
+			object := stack[base-1].(*evaluator.Object)
+			method, name := vm.method(constants[ins.A].(*callSite), object)
+
+			result := vm.Invoke(method, base, object, nil, name)
+			stack = vm.stack
+			stack[base-1] = result
+			sp = base
+
+		case OpCallConstructor:
+			class := constants[ins.A].(*Class)
+			base := sp - int(ins.B)
+			object := stack[base-1].(*evaluator.Object)
+
+			var result interface{}
+			if class != nil && class.Constructor != nil {
+				result = vm.Invoke(class.Constructor, base, object, nil, object.Class.Name+"->"+class.Constructor.Name)
+			}
+
+			stack = vm.stack
+			stack[base-1] = result
+			sp = base
+
+		case OpCallPackage:
+			site := constants[ins.A].(*packageSite)
+			if !site.resolved {
+				binding, ok := evl.LookupNativeFunction(site.Package, site.Function)
+				if !ok {
+					evl.Die("Package function '%s::%s' has no interpreter binding! (it only works in compiled programs)", site.Package, site.Function)
+				}
+
+				site.binding, site.resolved = binding, true
+			}
+
+			base := sp - int(ins.B)
+			arguments := make([]interface{}, ins.B)
+			copy(arguments, stack[base:sp])
+
+			result := site.binding.Function(evl, arguments)
+			stack = vm.stack
+			stack[base] = result
+			sp = base + 1
+
+		case OpCheckObject:
+			if _, ok := stack[sp-1].(*evaluator.Object); !ok {
+				evl.Throw(evaluator.NullPointerMessage)
+			}
+
+		case OpCheckNull:
+			if stack[sp-1] == nil {
+				evl.Throw(evaluator.NullPointerMessage)
+			}
+
+		// <VALUES> -----------------------------------------------------------
+		case OpMake:
+			site := constants[ins.A].(*makeSite)
+			if site.Class == nil {
+				// package classes live in LLVM modules, there's nothing for us to run
+				if site.Symbol.Package.Exists {
+					evl.Die("Package class '%s::%s' has no interpreter binding! (it only works in compiled programs)", evaluator.PackageName(site.Symbol.Package), site.Symbol.Name)
+				}
+
+				evl.Die("Unknown class! [%s]", site.Symbol.Name)
+			}
+
+			base := sp - int(ins.B)
+			object := evl.CreateObject(site.Class.Symbol)
+
+			if site.Class.Constructor != nil {
+				vm.Invoke(site.Class.Constructor, base, object, nil, object.Class.Name+"->"+site.Class.Constructor.Name)
+				stack = vm.stack
+			}
+
+			stack[base] = object
+			sp = base + 1
+
+		case OpMakeArray:
+			site := constants[ins.A].(*arraySite)
+
+			length := evaluator.IntegerValue(stack[sp-1])
+			if length < 0 {
+				evl.Die("Array length cannot be negative!")
+			}
+
+			elements := make([]interface{}, length)
+			for i := range elements {
+				elements[i] = evl.DefaultValue(site.BaseType)
+			}
+
+			stack[sp-1] = evaluator.CreateArray(site.Type, elements)
+
+		case OpMakeArrayLiteral:
+			base := sp - int(ins.B)
+
+			elements := make([]interface{}, ins.B)
+			for i := range elements {
+				elements[i] = evaluator.CopyValue(stack[base+i])
+			}
+
+			stack[base] = evaluator.CreateArray(constants[ins.A].(symbols.TypeSymbol), elements)
+			sp = base + 1
+
+		case OpMakeMap:
+			stack[sp] = evaluator.CreateMap(constants[ins.A].(symbols.TypeSymbol))
+			sp++
+
+		case OpMakeStruct:
+			symbol := constants[ins.A].(symbols.StructSymbol)
+			base := sp - int(ins.B)
+
+			stc := evl.CreateStruct(symbol)
+			for i := 0; i < int(ins.B); i++ {
+				stc.Fields[symbol.Fields[i].Fingerprint()] = evaluator.CopyValue(stack[base+i])
+			}
+
+			stack[base] = stc
+			sp = base + 1
+
+		case OpClosure:
+			lambda := constants[ins.A].(*lambda)
+
+			// the cells are shared, so the lambda sees any changes made after this (and the other way around)
+			cells := make([]*evaluator.Memory, len(lambda.Captures))
+			for i, capture := range lambda.Captures {
+				if capture.Captured {
+					cells[i] = captured[capture.Index]
+				} else {
+					cells[i] = stack[bp+capture.Index].(*evaluator.Memory)
+				}
+			}
+
+			closure := &Closure{Function: lambda.Function, Cells: cells}
+			if lambda.This {
+				closure.This = this
+			}
+
+			stack[sp] = closure
+			sp++
+
+		case OpFunction:
+			reference := *constants[ins.A].(*evaluator.FunctionReference)
+			stack[sp] = &reference
+			sp++
+
+		case OpConcat:
+			base := sp - int(ins.B)
+
+			// all parts are strings already, the binder made sure of that
+			var builder strings.Builder
+			for _, part := range stack[base:sp] {
+				builder.WriteString(evl.StringValue(part))
+			}
+
+			stack[base] = builder.String()
+			sp = base + 1
+
+		case OpGetField:
+			stack[sp-1] = vm.fields(stack[sp-1])[constants[ins.A].(string)]
+
+		case OpSetField:
+			// the value is below the base
+			sp--
+			vm.fields(stack[sp])[constants[ins.A].(string)] = evaluator.CopyValue(stack[sp-1])
+
+		case OpGetElement:
+			sp--
+			stack[sp-1] = vm.element(stack[sp-1], stack[sp])
+
+		case OpSetElement:
+			sp -= 2
+			vm.setElement(stack[sp-1], stack[sp], stack[sp+1])
+			stack[sp-1] = stack[sp+1]
+
+		case OpGetPointer:
+			sp--
+			stack[sp-1] = evl.Load(evaluator.Offset(evaluator.AsPointer(stack[sp-1]), stack[sp]))
+
+		case OpSetPointer:
+			sp -= 2
+			evl.Store(evaluator.Offset(evaluator.AsPointer(stack[sp-1]), stack[sp]), stack[sp+1])
+			stack[sp-1] = stack[sp+1]
+
+		// <TYPECALLS> --------------------------------------------------------
+		case OpStringLength:
+			stack[sp-1] = int32(len(stack[sp-1].(string)))
+
+		case OpStringBuffer:
+			stack[sp-1] = evaluator.NativeString(stack[sp-1].(string))
+
+		case OpSubstring:
+			sp -= 2
+			stack[sp-1] = vm.substring(stack[sp-1].(string), evaluator.IntegerValue(stack[sp]), evaluator.IntegerValue(stack[sp+1]))
+
+		case OpArrayLength:
+			stack[sp-1] = int32(len(stack[sp-1].(*evaluator.Array).Elements))
+
+		case OpArrayPush:
+			sp--
+			array := stack[sp-1].(*evaluator.Array)
+			array.Elements = append(array.Elements, evaluator.CopyValue(stack[sp]))
+			stack[sp-1] = nil
+
+		case OpMapLength:
+			stack[sp-1] = int32(len(stack[sp-1].(*evaluator.Map).Keys))
+
+		case OpMapKeys:
+			// hand out a copy so nobody messes with our order
+			mp := stack[sp-1].(*evaluator.Map)
+			keys := make([]interface{}, len(mp.Keys))
+			copy(keys, mp.Keys)
+			stack[sp-1] = evaluator.CreateArray(constants[ins.A].(symbols.TypeSymbol), keys)
+
+		case OpMapHas:
+			sp--
+			_, ok := stack[sp-1].(*evaluator.Map).Values[stack[sp]]
+			stack[sp-1] = ok
+
+		case OpMapRemove:
+			sp--
+			removeKey(stack[sp-1].(*evaluator.Map), stack[sp])
+			stack[sp-1] = nil
+
+		case OpRunAction:
+			base := sp - int(ins.B)
+
+			result := vm.callAction(stack[base-1], base, int(ins.B))
+			stack = vm.stack
+			stack[base-1] = result
+			sp = base
+
+		case OpRunThread:
+			base := sp - int(ins.B)
+
+			arguments := make([]interface{}, ins.B)
+			copy(arguments, stack[base:sp])
+
+			thread := evaluator.CreateThread(stack[base-1], arguments)
+			vm.StartThread(thread)
+
+			stack[base-1] = thread
+			sp = base
+
+		case OpThreadStart:
+			vm.StartThread(stack[sp-1].(*evaluator.Thread))
+			stack[sp-1] = nil
+
+		case OpThreadJoin:
+			evl.JoinThread(stack[sp-1].(*evaluator.Thread))
+			stack[sp-1] = nil
+
+		case OpThreadKill:
+			evl.KillThread(stack[sp-1].(*evaluator.Thread))
+			stack[sp-1] = nil
+
+		default:
+			evl.Die("Unknown instruction! [%s]", ins.Op)
+		}
+	}
+}
+
+// </EXECUTION> ---------------------------------------------------------------